@@ -1,17 +1,18 @@
 package assets
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"hash/fnv"
 	"reflect"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/atlanhq/atlan-go/atlan"
 
 	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
 	"github.com/atlanhq/atlan-go/atlan/model/structs"
 )
 
@@ -1151,32 +1152,102 @@ func NewWorkflowRunFields() *WorkflowRunFields {
 
 // Methods on assets
 
+// errDefaultClientNotInitialized is returned by the package-level functions when no default client is set.
+var errDefaultClientNotInitialized = fmt.Errorf("default AtlanClient not initialized")
+
+// newAtlanObject creates a new, empty instance of the asset type T.
+func newAtlanObject[T AtlanObject]() T {
+	var asset T
+	assetType := reflect.TypeOf(asset).Elem()
+	return reflect.New(assetType).Interface().(T)
+}
+
+// assetTypeName returns the Atlan type name of the asset type T.
+func assetTypeName[T AtlanObject]() string {
+	var asset T
+	return reflect.TypeOf(asset).Elem().Name()
+}
+
 // GetbyGuid retrieves an asset by guid
 func GetByGuid[T AtlanObject](guid string) (T, error) {
+	return GetByGuidWithContext[T](context.Background(), guid)
+}
+
+// GetByGuidWithContext retrieves an asset by guid, bound to the provided context.
+func GetByGuidWithContext[T AtlanObject](ctx context.Context, guid string) (T, error) {
 	var asset T
 
 	if DefaultAtlanClient == nil {
-		return asset, fmt.Errorf("default AtlanClient not initialized")
+		return asset, errDefaultClientNotInitialized
+	}
+
+	newAsset := newAtlanObject[T]()
+	if err := DefaultAtlanClient.GetByGuidWithContext(ctx, guid, newAsset); err != nil {
+		return asset, err
 	}
+	return newAsset, nil
+}
 
-	api := &GET_ENTITY_BY_GUID
-	api.Path = fmt.Sprintf("entity/guid/%s", guid) // Adjust to the actual API path structure
+// GetByGuid retrieves an asset by guid, decoding it into the provided asset.
+func (ac *AtlanClient) GetByGuid(guid string, asset AtlanObject) error {
+	return ac.GetByGuidWithContext(context.Background(), guid, asset)
+}
 
-	response, err := DefaultAtlanClient.CallAPI(api, nil, nil)
+// GetByGuidWithContext is like GetByGuid, but bound to the provided context.
+func (ac *AtlanClient) GetByGuidWithContext(ctx context.Context, guid string, asset AtlanObject) error {
+	api, err := GET_ENTITY_BY_GUID.FormatPathWithParams(guid)
 	if err != nil {
-		return asset, err
+		return err
 	}
 
-	// Create a new instance of T using reflection
-	assetType := reflect.TypeOf(asset).Elem()
-	newAsset := reflect.New(assetType).Interface().(T)
+	response, err := ac.CallAPIWithContext(ctx, api, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return asset.FromJSON(response)
+}
+
+// GetEntityByGuid retrieves an asset by guid, decoded into the type generated for its typeName
+// (see entities.Decode), for when the type of the asset isn't known in advance.
+func GetEntityByGuid(guid string) (entities.Object, error) {
+	return GetEntityByGuidWithContext(context.Background(), guid)
+}
 
-	err = newAsset.FromJSON(response)
+// GetEntityByGuidWithContext retrieves an asset by guid, bound to the provided context.
+func GetEntityByGuidWithContext(ctx context.Context, guid string) (entities.Object, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.GetEntityByGuidWithContext(ctx, guid)
+}
+
+// GetEntityByGuid retrieves an asset by guid, decoded into the type generated for its typeName,
+// with its custom metadata by human-readable names (see entities.Entity.GetCustomMetadata).
+func (ac *AtlanClient) GetEntityByGuid(guid string) (entities.Object, error) {
+	return ac.GetEntityByGuidWithContext(context.Background(), guid)
+}
+
+// GetEntityByGuidWithContext is like GetEntityByGuid, but bound to the provided context.
+func (ac *AtlanClient) GetEntityByGuidWithContext(ctx context.Context, guid string) (entities.Object, error) {
+	api, err := GET_ENTITY_BY_GUID.FormatPathWithParams(guid)
 	if err != nil {
-		return asset, err
+		return nil, err
 	}
 
-	return newAsset, nil
+	response, err := ac.CallAPIWithContext(ctx, api, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := entities.Decode(response)
+	if err != nil {
+		return nil, err
+	}
+	if err := ac.nameCustomMetadata(object.GetEntity()); err != nil {
+		return nil, err
+	}
+	return object, nil
 }
 
 func ModifyTags(api API,
@@ -1187,11 +1258,36 @@ func ModifyTags(api API,
 	removePropagationOnDelete bool,
 	restrictLineagePropagation bool,
 	restrictPropagationThroughHierarchy bool,
+) error {
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.ModifyTags(
+		api,
+		assetType.Name(),
+		qualifiedName,
+		atlanTagNames,
+		propagate,
+		removePropagationOnDelete,
+		restrictLineagePropagation,
+		restrictPropagationThroughHierarchy,
+	)
+}
+
+// ModifyTags adds or replaces the Atlan tags on the asset of the given type and qualified name.
+func (ac *AtlanClient) ModifyTags(api API,
+	typeName string,
+	qualifiedName string,
+	atlanTagNames []string,
+	propagate bool,
+	removePropagationOnDelete bool,
+	restrictLineagePropagation bool,
+	restrictPropagationThroughHierarchy bool,
 ) error {
 	var atlanTags []structs.AtlanTag
 
 	for _, name := range atlanTagNames {
-		TagName, _ := GetAtlanTagIDForName(name)
+		TagName, _ := ac.GetAtlanTagIDForName(name)
 		atlanTags = append(atlanTags, structs.AtlanTag{
 			TypeName:                            &TagName,
 			Propagate:                           &propagate,
@@ -1205,9 +1301,9 @@ func ModifyTags(api API,
 		"attr:qualifiedName": qualifiedName,
 	}
 
-	API, _ := api.FormatPathWithParams(assetType.Name(), "classifications")
+	API, _ := api.FormatPathWithParams(typeName, "classifications")
 
-	_, err := DefaultAtlanClient.CallAPI(
+	_, err := ac.CallAPI(
 		API,
 		queryParams,
 		atlanTags,
@@ -1227,12 +1323,33 @@ func AddAtlanTags[T AtlanObject](
 	restrictLineagePropagation bool,
 	restrictPropagationThroughHierarchy bool,
 ) error {
-	var asset T
-	assetType := reflect.TypeOf(asset).Elem()
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.AddAtlanTags(
+		assetTypeName[T](),
+		qualifiedName,
+		atlanTagNames,
+		propagate,
+		removePropagationOnDelete,
+		restrictLineagePropagation,
+		restrictPropagationThroughHierarchy,
+	)
+}
 
-	err := ModifyTags(
+// AddAtlanTags adds Atlan tags to the asset of the given type and qualified name.
+func (ac *AtlanClient) AddAtlanTags(
+	typeName string,
+	qualifiedName string,
+	atlanTagNames []string,
+	propagate bool,
+	removePropagationOnDelete bool,
+	restrictLineagePropagation bool,
+	restrictPropagationThroughHierarchy bool,
+) error {
+	err := ac.ModifyTags(
 		UPDATE_ENTITY_BY_ATTRIBUTE,
-		assetType,
+		typeName,
 		qualifiedName,
 		atlanTagNames,
 		propagate,
@@ -1254,12 +1371,33 @@ func UpdateAtlanTags[T AtlanObject](
 	restrictLineagePropagation bool,
 	restrictPropagationThroughHierarchy bool,
 ) error {
-	var asset T
-	assetType := reflect.TypeOf(asset).Elem()
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.UpdateAtlanTags(
+		assetTypeName[T](),
+		qualifiedName,
+		atlanTagNames,
+		propagate,
+		removePropagationOnDelete,
+		restrictLineagePropagation,
+		restrictPropagationThroughHierarchy,
+	)
+}
 
-	err := ModifyTags(
+// UpdateAtlanTags updates the Atlan tags on the asset of the given type and qualified name.
+func (ac *AtlanClient) UpdateAtlanTags(
+	typeName string,
+	qualifiedName string,
+	atlanTagNames []string,
+	propagate bool,
+	removePropagationOnDelete bool,
+	restrictLineagePropagation bool,
+	restrictPropagationThroughHierarchy bool,
+) error {
+	err := ac.ModifyTags(
 		PARTIAL_UPDATE_ENTITY_BY_ATTRIBUTE,
-		assetType,
+		typeName,
 		qualifiedName,
 		atlanTagNames,
 		propagate,
@@ -1276,13 +1414,23 @@ func UpdateAtlanTags[T AtlanObject](
 func RemoveAtlanTag[T AtlanObject](
 	qualifiedName string,
 	atlanTagName string,
+) error {
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.RemoveAtlanTag(assetTypeName[T](), qualifiedName, atlanTagName)
+}
+
+// RemoveAtlanTag removes an Atlan tag from the asset of the given type and qualified name.
+func (ac *AtlanClient) RemoveAtlanTag(
+	typeName string,
+	qualifiedName string,
+	atlanTagName string,
 ) error {
 	var api API = DELETE_ENTITY_BY_ATTRIBUTE
-	var asset T
-	assetType := reflect.TypeOf(asset).Elem()
 
 	// Get the internal ID for the tag name
-	classificationID, err := GetAtlanTagIDForName(atlanTagName)
+	classificationID, err := ac.GetAtlanTagIDForName(atlanTagName)
 	if err != nil {
 		return fmt.Errorf("failed to get Atlan tag ID for name %s: %w", atlanTagName, err)
 	}
@@ -1298,10 +1446,10 @@ func RemoveAtlanTag[T AtlanObject](
 	}
 
 	// Construct the API path for deleting the tag
-	API, _ := api.FormatPathWithParams(assetType.Name(), "classification", classificationID)
+	API, _ := api.FormatPathWithParams(typeName, "classification", classificationID)
 
 	// Call the Atlan API to remove the tag
-	_, err = DefaultAtlanClient.CallAPI(API, queryParams, nil)
+	_, err = ac.CallAPI(API, queryParams, nil)
 	if err != nil {
 		return fmt.Errorf("failed to remove Atlan tag: %w", err)
 	}
@@ -1311,45 +1459,68 @@ func RemoveAtlanTag[T AtlanObject](
 
 // GetByQualifiedName retrieves an asset by guid
 func GetByQualifiedName[T AtlanObject](qualifiedName string) (T, error) {
+	return GetByQualifiedNameWithContext[T](context.Background(), qualifiedName)
+}
+
+// GetByQualifiedNameWithContext retrieves an asset by qualified name, bound to the provided context.
+func GetByQualifiedNameWithContext[T AtlanObject](ctx context.Context, qualifiedName string) (T, error) {
 	var asset T
 
 	if DefaultAtlanClient == nil {
-		return asset, fmt.Errorf("default AtlanClient not initialized")
+		return asset, errDefaultClientNotInitialized
+	}
+
+	newAsset := newAtlanObject[T]()
+	if err := DefaultAtlanClient.GetByQualifiedNameWithContext(ctx, qualifiedName, newAsset); err != nil {
+		return asset, err
 	}
+	return newAsset, nil
+}
+
+// GetByQualifiedName retrieves an asset by qualified name, decoding it into the provided asset.
+// The type of the provided asset (for example *Table) determines the Atlan type that is looked up.
+func (ac *AtlanClient) GetByQualifiedName(qualifiedName string, asset AtlanObject) error {
+	return ac.GetByQualifiedNameWithContext(context.Background(), qualifiedName, asset)
+}
 
-	api := &GET_ENTITY_BY_UNIQUE_ATTRIBUTE
+// GetByQualifiedNameWithContext is like GetByQualifiedName, but bound to the provided context.
+func (ac *AtlanClient) GetByQualifiedNameWithContext(ctx context.Context, qualifiedName string, asset AtlanObject) error {
+	api := GET_ENTITY_BY_UNIQUE_ATTRIBUTE
 	api.Path += reflect.TypeOf(asset).Elem().Name()
 
 	queryParams := map[string]string{
 		"attr:qualifiedName": qualifiedName,
 	}
 
-	response, err := DefaultAtlanClient.CallAPI(api, queryParams, nil)
-	if err != nil {
-		return asset, err
-	}
-
-	// Create a new instance of T using reflection
-	assetType := reflect.TypeOf(asset).Elem()
-	newAsset := reflect.New(assetType).Interface().(T)
-
-	err = newAsset.FromJSON(response)
+	response, err := ac.CallAPIWithContext(ctx, &api, queryParams, nil)
 	if err != nil {
-		return asset, err
+		return err
 	}
 
-	return newAsset, nil
+	return asset.FromJSON(response)
 }
 
 // RetrieveMinimal retrieves an asset by its GUID, without any of its relationships.
 func RetrieveMinimal(guid string) (*structs.Asset, error) {
+	return RetrieveMinimalWithContext(context.Background(), guid)
+}
+
+// RetrieveMinimalWithContext retrieves an asset by its GUID, without any of its relationships, bound to the provided context.
+func RetrieveMinimalWithContext(ctx context.Context, guid string) (*structs.Asset, error) {
 	if DefaultAtlanClient == nil {
-		return nil, fmt.Errorf("default AtlanClient not initialized")
+		return nil, errDefaultClientNotInitialized
 	}
+	return DefaultAtlanClient.RetrieveMinimalWithContext(ctx, guid)
+}
 
-	api := &GET_ENTITY_BY_GUID
-	originalPath := api.Path
+// RetrieveMinimal retrieves an asset by its GUID, without any of its relationships.
+func (ac *AtlanClient) RetrieveMinimal(guid string) (*structs.Asset, error) {
+	return ac.RetrieveMinimalWithContext(context.Background(), guid)
+}
 
+// RetrieveMinimalWithContext is like RetrieveMinimal, but bound to the provided context.
+func (ac *AtlanClient) RetrieveMinimalWithContext(ctx context.Context, guid string) (*structs.Asset, error) {
+	api := GET_ENTITY_BY_GUID
 	api.Path += guid
 
 	// Add query parameters to ignore relationships
@@ -1357,24 +1528,43 @@ func RetrieveMinimal(guid string) (*structs.Asset, error) {
 	queryParams["min_ext_info"] = "true"
 	queryParams["ignore_relationships"] = "true"
 
-	response, err := DefaultAtlanClient.CallAPI(api, queryParams, nil)
+	response, err := ac.CallAPIWithContext(ctx, &api, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the response into asset json structure
-	var assetresponse structs.Asset
+	// Unmarshal the entity of the response into asset json structure
+	var assetresponse struct {
+		Entity structs.Asset `json:"entity"`
+	}
 	err = json.Unmarshal(response, &assetresponse)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshalling asset response: %v", err)
 	}
 
-	api.Path = originalPath // Reset the api.Path to its original value
-	return &assetresponse, nil
+	return &assetresponse.Entity, nil
 }
 
 // PurgeByGuid HARD deletes assets by their GUIDs.
 func PurgeByGuid(guids []string) (*model.AssetMutationResponse, error) {
+	return PurgeByGuidWithContext(context.Background(), guids)
+}
+
+// PurgeByGuidWithContext HARD deletes assets by their GUIDs, bound to the provided context.
+func PurgeByGuidWithContext(ctx context.Context, guids []string) (*model.AssetMutationResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.PurgeByGuidWithContext(ctx, guids)
+}
+
+// PurgeByGuid HARD deletes assets by their GUIDs.
+func (ac *AtlanClient) PurgeByGuid(guids []string) (*model.AssetMutationResponse, error) {
+	return ac.PurgeByGuidWithContext(context.Background(), guids)
+}
+
+// PurgeByGuidWithContext is like PurgeByGuid, but bound to the provided context.
+func (ac *AtlanClient) PurgeByGuidWithContext(ctx context.Context, guids []string) (*model.AssetMutationResponse, error) {
 	if len(guids) == 0 {
 		return nil, fmt.Errorf("no GUIDs provided for deletion")
 	}
@@ -1392,7 +1582,7 @@ func PurgeByGuid(guids []string) (*model.AssetMutationResponse, error) {
 	queryParams["guid"] = guidString
 
 	// Call the API
-	resp, err := DefaultAtlanClient.CallAPI(api, queryParams, nil)
+	resp, err := ac.CallAPIWithContext(ctx, api, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1409,12 +1599,30 @@ func PurgeByGuid(guids []string) (*model.AssetMutationResponse, error) {
 
 // DeleteByGuid SOFT deletes assets by their GUIDs.
 func DeleteByGuid(guids []string) (*model.AssetMutationResponse, error) {
+	return DeleteByGuidWithContext(context.Background(), guids)
+}
+
+// DeleteByGuidWithContext SOFT deletes assets by their GUIDs, bound to the provided context.
+func DeleteByGuidWithContext(ctx context.Context, guids []string) (*model.AssetMutationResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.DeleteByGuidWithContext(ctx, guids)
+}
+
+// DeleteByGuid SOFT deletes assets by their GUIDs.
+func (ac *AtlanClient) DeleteByGuid(guids []string) (*model.AssetMutationResponse, error) {
+	return ac.DeleteByGuidWithContext(context.Background(), guids)
+}
+
+// DeleteByGuidWithContext is like DeleteByGuid, but bound to the provided context.
+func (ac *AtlanClient) DeleteByGuidWithContext(ctx context.Context, guids []string) (*model.AssetMutationResponse, error) {
 	if len(guids) == 0 {
 		return nil, fmt.Errorf("no GUIDs provided for deletion")
 	}
 
 	for _, guid := range guids {
-		asset, err := RetrieveMinimal(guid)
+		asset, err := ac.RetrieveMinimalWithContext(ctx, guid)
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving asset: %v", err)
 		}
@@ -1437,12 +1645,10 @@ func DeleteByGuid(guids []string) (*model.AssetMutationResponse, error) {
 	// Add the comma-separated string of GUIDs to the query parameters
 	queryParams["guid"] = guidString
 
-	fmt.Println("Query Params:", queryParams)
-
 	// Call the API
-	resp, err := DefaultAtlanClient.CallAPI(api, queryParams, nil)
+	resp, err := ac.CallAPIWithContext(ctx, api, queryParams, nil)
 	if err != nil {
-		DefaultAtlanClient.logger.Errorf("Error soft deleting assets: %v", err)
+		ac.logger.Errorf("Error soft deleting assets: %v", err)
 		return nil, err
 	}
 
@@ -1455,7 +1661,7 @@ func DeleteByGuid(guids []string) (*model.AssetMutationResponse, error) {
 
 	// Wait until each asset is deleted
 	for _, guid := range guids {
-		err = WaitTillDeleted(guid)
+		err = ac.WaitTillDeletedWithContext(ctx, guid)
 		if err != nil {
 			return nil, err
 		}
@@ -1466,8 +1672,26 @@ func DeleteByGuid(guids []string) (*model.AssetMutationResponse, error) {
 
 // WaitTillDeleted waits for an asset to be deleted.
 func WaitTillDeleted(guid string) error {
+	return WaitTillDeletedWithContext(context.Background(), guid)
+}
+
+// WaitTillDeletedWithContext waits for an asset to be deleted, giving up early if the context is cancelled.
+func WaitTillDeletedWithContext(ctx context.Context, guid string) error {
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.WaitTillDeletedWithContext(ctx, guid)
+}
+
+// WaitTillDeleted waits for an asset to be deleted.
+func (ac *AtlanClient) WaitTillDeleted(guid string) error {
+	return ac.WaitTillDeletedWithContext(context.Background(), guid)
+}
+
+// WaitTillDeletedWithContext is like WaitTillDeleted, but gives up early if the context is cancelled.
+func (ac *AtlanClient) WaitTillDeletedWithContext(ctx context.Context, guid string) error {
 	for i := 0; i < MaxRetries; i++ {
-		asset, err := RetrieveMinimal(guid)
+		asset, err := ac.RetrieveMinimalWithContext(ctx, guid)
 		if err != nil {
 			return fmt.Errorf("error retrieving asset: %v", err)
 		}
@@ -1477,7 +1701,9 @@ func WaitTillDeleted(guid string) error {
 		}
 
 		// If the asset is not deleted, wait for a while before retrying
-		time.Sleep(RetryInterval)
+		if err := sleepWithContext(ctx, RetryInterval); err != nil {
+			return err
+		}
 	}
 
 	// If the asset is still not deleted after all retries, return an error
@@ -1488,14 +1714,99 @@ type SaveRequest struct {
 	Entities []AtlanObject `json:"entities"`
 }
 
-// Save saves the assets in memory to the Atlas server.
+// SaveOptions control how saving assets treats the Atlan tags and custom metadata of the
+// assets that already exist. The zero value leaves both as they are.
+type SaveOptions struct {
+	// Replace the Atlan tags of each asset with its AtlanTags, removing any others.
+	ReplaceAtlanTags bool
+	// Add the AddOrUpdateAtlanTags of each asset and remove its RemoveAtlanTags, leaving any
+	// others as they are. Can't be combined with ReplaceAtlanTags.
+	AppendAtlanTags bool
+	// How to save the custom metadata of each asset (see entities.Entity.SetCustomMetadata):
+	// atlan.MERGE updates the attributes given, atlan.OVERWRITE replaces all the custom metadata
+	// of the asset with that given, and atlan.IGNORE (or the zero value) leaves it as it is.
+	CustomMetadataHandling atlan.CustomMetadataHandling
+}
+
+// queryParams returns the query parameters of the bulk entity API for the options.
+func (o SaveOptions) queryParams() (map[string]string, error) {
+	if o.ReplaceAtlanTags && o.AppendAtlanTags {
+		return nil, errors.New("Atlan tags can't be both replaced and appended")
+	}
+	return map[string]string{
+		"replaceClassifications":      strconv.FormatBool(o.ReplaceAtlanTags),
+		"appendTags":                  strconv.FormatBool(o.AppendAtlanTags),
+		"replaceBusinessAttributes":   strconv.FormatBool(o.savesCustomMetadata()),
+		"overwriteBusinessAttributes": strconv.FormatBool(o.CustomMetadataHandling == atlan.OVERWRITE),
+	}, nil
+}
+
+func (o SaveOptions) savesCustomMetadata() bool {
+	return o.CustomMetadataHandling == atlan.MERGE || o.CustomMetadataHandling == atlan.OVERWRITE
+}
+
+// Save saves the assets in memory to the Atlas server, leaving the Atlan tags and custom
+// metadata of existing assets as they are.
 func Save(assets ...AtlanObject) (*model.AssetMutationResponse, error) {
+	return SaveWithContext(context.Background(), assets...)
+}
+
+// SaveWithContext saves the assets in memory to the Atlas server, bound to the provided context.
+func SaveWithContext(ctx context.Context, assets ...AtlanObject) (*model.AssetMutationResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.SaveWithContext(ctx, assets...)
+}
+
+// Save saves the assets in memory to the Atlas server, leaving the Atlan tags and custom
+// metadata of existing assets as they are.
+func (ac *AtlanClient) Save(assets ...AtlanObject) (*model.AssetMutationResponse, error) {
+	return ac.SaveWithContext(context.Background(), assets...)
+}
+
+// SaveWithContext is like Save, but bound to the provided context.
+func (ac *AtlanClient) SaveWithContext(ctx context.Context, assets ...AtlanObject) (*model.AssetMutationResponse, error) {
+	return ac.SaveWithOptionsWithContext(ctx, SaveOptions{}, assets...)
+}
+
+// SaveWithOptions saves the assets in memory to the Atlas server, treating their Atlan tags
+// and custom metadata according to the options.
+func SaveWithOptions(options SaveOptions, assets ...AtlanObject) (*model.AssetMutationResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.SaveWithOptions(options, assets...)
+}
+
+// SaveWithOptions saves the assets in memory to the Atlas server, treating their Atlan tags
+// and custom metadata according to the options.
+func (ac *AtlanClient) SaveWithOptions(options SaveOptions, assets ...AtlanObject) (*model.AssetMutationResponse, error) {
+	return ac.SaveWithOptionsWithContext(context.Background(), options, assets...)
+}
+
+// SaveWithOptionsWithContext is like SaveWithOptions, but bound to the provided context.
+func (ac *AtlanClient) SaveWithOptionsWithContext(ctx context.Context, options SaveOptions, assets ...AtlanObject) (*model.AssetMutationResponse, error) {
+	queryParams, err := options.queryParams()
+	if err != nil {
+		return nil, err
+	}
+	if options.savesCustomMetadata() {
+		for _, asset := range assets {
+			if object, ok := asset.(entities.Object); ok {
+				if err := ac.identifyCustomMetadata(object.GetEntity()); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
 	request := SaveRequest{
 		Entities: assets,
 	}
 
 	api := &CREATE_ENTITIES
-	resp, err := DefaultAtlanClient.CallAPI(api, nil, request)
+	resp, err := ac.CallAPIWithContext(ctx, api, queryParams, request)
 	if err != nil {
 		return nil, err
 	}
@@ -1509,12 +1820,6 @@ func Save(assets ...AtlanObject) (*model.AssetMutationResponse, error) {
 	return &response, nil
 }
 
-func generateCacheKey(baseURL, apiKey string) string {
-	h := fnv.New32a()
-	_, _ = h.Write([]byte(fmt.Sprintf("%s/%s", baseURL, apiKey)))
-	return fmt.Sprintf("%d", h.Sum32())
-}
-
 // Used in End-to-end bulk update
 
 // TrimToRequired trims a SearchAsset to its required attributes and returns an SearchAsset Object.
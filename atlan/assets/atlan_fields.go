@@ -1,6 +1,8 @@
 package assets
 
 import (
+	"time"
+
 	"github.com/atlanhq/atlan-go/atlan"
 	"github.com/atlanhq/atlan-go/atlan/model"
 )
@@ -22,6 +24,12 @@ func (rf *RelationField) GetAtlanFieldName() string {
 	return rf.AtlanFieldName
 }
 
+// HasAnyValue Returns a query that will only match assets that have at least one asset related
+// to them through the relationship.
+func (rf *RelationField) HasAnyValue() model.Query {
+	return &model.Exists{Field: rf.AtlanFieldName}
+}
+
 // SearchableField represents a field that can be searched on depending upon the type of search
 type SearchableField struct {
 	AtlanFieldName    string
@@ -156,6 +164,66 @@ func (kf *KeywordField) Eq(value string) model.Query {
 	}
 }
 
+// Wildcard Returns a query that will match all assets whose field has a value that matches the
+// provided wildcard pattern, where * matches any characters and ? matches a single character.
+func (kf *KeywordField) Wildcard(pattern string, caseInsensitive *bool) model.Query {
+	return &model.WildcardQuery{
+		Field:           kf.KeywordFieldName,
+		Value:           pattern,
+		CaseInsensitive: caseInsensitive,
+	}
+}
+
+// Regexp Returns a query that will match all assets whose field has a value that matches the
+// provided regular expression (in Lucene syntax, anchored to the whole value).
+func (kf *KeywordField) Regexp(regexp string, caseInsensitive *bool) model.Query {
+	return &model.RegexpQuery{
+		Field:           kf.KeywordFieldName,
+		Value:           regexp,
+		CaseInsensitive: caseInsensitive,
+	}
+}
+
+// Fuzzy Returns a query that will match all assets whose field has a value within the provided
+// edit distance (fuzziness) of the provided value. A nil fuzziness uses Elastic's AUTO distance.
+func (kf *KeywordField) Fuzzy(value string, fuzziness *string) model.Query {
+	return &model.FuzzyQuery{
+		Field:     kf.KeywordFieldName,
+		Value:     value,
+		Fuzziness: fuzziness,
+	}
+}
+
+// Wildcard Returns a query that will match all assets whose field has a value that matches the
+// provided wildcard pattern, where * matches any characters and ? matches a single character.
+func (kf *KeywordTextField) Wildcard(pattern string, caseInsensitive *bool) model.Query {
+	return &model.WildcardQuery{
+		Field:           kf.KeywordFieldName,
+		Value:           pattern,
+		CaseInsensitive: caseInsensitive,
+	}
+}
+
+// Regexp Returns a query that will match all assets whose field has a value that matches the
+// provided regular expression (in Lucene syntax, anchored to the whole value).
+func (kf *KeywordTextField) Regexp(regexp string, caseInsensitive *bool) model.Query {
+	return &model.RegexpQuery{
+		Field:           kf.KeywordFieldName,
+		Value:           regexp,
+		CaseInsensitive: caseInsensitive,
+	}
+}
+
+// Fuzzy Returns a query that will match all assets whose field has a value within the provided
+// edit distance (fuzziness) of the provided value. A nil fuzziness uses Elastic's AUTO distance.
+func (kf *KeywordTextField) Fuzzy(value string, fuzziness *string) model.Query {
+	return &model.FuzzyQuery{
+		Field:     kf.KeywordFieldName,
+		Value:     value,
+		Fuzziness: fuzziness,
+	}
+}
+
 // KeywordTextField Represents any field in Atlan that can be searched by keyword or text-based search operations.
 type KeywordTextField struct {
 	*SearchableField
@@ -201,6 +269,18 @@ func (tf *TextField) Match(value string) model.Query {
 	}
 }
 
+// SpanTerm Returns a span query that will match the positions of the provided term in the field,
+// to be combined by span queries like SpanNear and SpanFirst.
+func (tf *TextField) SpanTerm(value string) *model.SpanTermQuery {
+	return &model.SpanTermQuery{Field: tf.TextFieldName, Value: value}
+}
+
+// SpanTerm Returns a span query that will match the positions of the provided term in the text
+// field, to be combined by span queries like SpanNear and SpanFirst.
+func (kf *KeywordTextField) SpanTerm(value string) *model.SpanTermQuery {
+	return &model.SpanTermQuery{Field: kf.TextFieldName, Value: value}
+}
+
 // NumericField Represents any field in Atlan that can be searched using only numeric search operations.
 type NumericField struct {
 	*SearchableField
@@ -275,6 +355,47 @@ func (nf *NumericField) Between(minimum, maximum *float64) model.Query {
 	}
 }
 
+// After Returns a query that will match all assets whose date field (such as the create or update
+// time of assets, held as epoch milliseconds) is strictly after the provided time.
+func (nf *NumericField) After(t time.Time) model.Query {
+	after := epochMillis(t)
+	return &model.RangeQuery{
+		Field: nf.NumericFieldName,
+		Gt:    &after,
+	}
+}
+
+// Before Returns a query that will match all assets whose date field (such as the create or update
+// time of assets, held as epoch milliseconds) is strictly before the provided time.
+func (nf *NumericField) Before(t time.Time) model.Query {
+	before := epochMillis(t)
+	return &model.RangeQuery{
+		Field: nf.NumericFieldName,
+		Lt:    &before,
+	}
+}
+
+// BetweenDates Returns a query that will match all assets whose date field (such as the create or
+// update time of assets, held as epoch milliseconds) is between the provided times, inclusive.
+func (nf *NumericField) BetweenDates(from, to time.Time) model.Query {
+	minimum, maximum := epochMillis(from), epochMillis(to)
+	return nf.Between(&minimum, &maximum)
+}
+
+// WithinLast Returns a query that will match all assets whose date field (such as the create or
+// update time of assets, held as epoch milliseconds) is within the provided duration before now.
+func (nf *NumericField) WithinLast(d time.Duration) model.Query {
+	since := epochMillis(time.Now().Add(-d))
+	return &model.RangeQuery{
+		Field: nf.NumericFieldName,
+		Gte:   &since,
+	}
+}
+
+func epochMillis(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
 // InternalKeywordTextField Represents any field in Atlan that can be searched by keyword or text-based search operations, and can also
 // be searched against a special internal field directly within Atlan
 type InternalKeywordTextField struct {
@@ -324,8 +445,24 @@ type CustomMetadataField struct {
 	AttributeDef  model.AttributeDef
 }
 
+// NewCustomMetadataField returns a field to search the custom metadata attribute of the given set,
+// resolved through the custom metadata of the default AtlanClient.
 func NewCustomMetadataField(setName, attributeName string) (*CustomMetadataField, error) {
-	elasticFieldName, err := GetCustomMetadataCache().GetAttrIDForName(setName, attributeName)
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.NewCustomMetadataField(setName, attributeName)
+}
+
+// NewCustomMetadataField returns a field to search the custom metadata attribute of the given set,
+// resolved through the custom metadata of this client's tenant.
+func (ac *AtlanClient) NewCustomMetadataField(setName, attributeName string) (*CustomMetadataField, error) {
+	cache := ac.CustomMetadataCache()
+	elasticFieldName, err := cache.GetAttrIDForName(setName, attributeName)
+	if err != nil {
+		return nil, err
+	}
+	attributeDef, err := cache.GetAttributeDef(elasticFieldName)
 	if err != nil {
 		return nil, err
 	}
@@ -334,7 +471,7 @@ func NewCustomMetadataField(setName, attributeName string) (*CustomMetadataField
 		SearchableField: searchableField,
 		SetName:         setName,
 		AttributeName:   attributeName,
-		AttributeDef:    GetAttributeDef(elasticFieldName),
+		AttributeDef:    attributeDef,
 	}, nil
 }
 
@@ -391,7 +528,7 @@ func (cmf *CustomMetadataField) Lt(value *float64) model.Query {
 func (cmf *CustomMetadataField) Lte(value *float64) model.Query {
 	return &model.RangeQuery{
 		Field: cmf.ElasticFieldName,
-		Lt:    value,
+		Lte:   value,
 	}
 }
 
@@ -403,46 +540,167 @@ func (cmf *CustomMetadataField) Between(minimum, maximum *float64) model.Query {
 	}
 }
 
-/*
-// Needs to Implement Custom Metadata and Aggregations
+// BucketBy Returns criteria to bucket results based on the provided field, with at most
+// size buckets (the most frequent values of the field).
+func (kf *KeywordField) BucketBy(size int) *model.TermsAggregation {
+	return bucketBy(kf.KeywordFieldName, size)
+}
 
-func (nf *NumericField) Avg() Aggregation {
-	return Aggregation{
-		Root: map[string]interface{}{
-			"avg": map[string]interface{}{
-				"field": nf.NumericFieldName,
-			},
-		},
-	}
+// Distinct Returns criteria to calculate the approximate number of distinct values in the field
+// across all results.
+func (kf *KeywordField) Distinct() *model.CardinalityAggregation {
+	return &model.CardinalityAggregation{Field: kf.KeywordFieldName}
 }
 
-func (nf *NumericField) Sum() Aggregation {
-	return Aggregation{
-		Root: map[string]interface{}{
-			"sum": map[string]interface{}{
-				"field": nf.NumericFieldName,
-			},
-		},
-	}
+// BucketBy Returns criteria to bucket results based on the keyword index of the provided field,
+// with at most size buckets (the most frequent values of the field).
+func (kf *KeywordTextField) BucketBy(size int) *model.TermsAggregation {
+	return bucketBy(kf.KeywordFieldName, size)
 }
 
-func (nf *NumericField) Min() Aggregation {
-	return Aggregation{
-		Root: map[string]interface{}{
-			"min": map[string]interface{}{
-				"field": nf.NumericFieldName,
-			},
-		},
-	}
+// Distinct Returns criteria to calculate the approximate number of distinct values in the field
+// across all results.
+func (kf *KeywordTextField) Distinct() *model.CardinalityAggregation {
+	return &model.CardinalityAggregation{Field: kf.KeywordFieldName}
 }
 
-func (nf *NumericField) Max() Aggregation {
-	return Aggregation{
-		Root: map[string]interface{}{
-			"max": map[string]interface{}{
-				"field": nf.NumericFieldName,
-			},
-		},
+// BucketBy Returns criteria to bucket results based on the provided field (true and false).
+func (bf *BooleanField) BucketBy() *model.TermsAggregation {
+	return bucketBy(bf.BooleanFieldName, 2)
+}
+
+// BucketBy Returns criteria to bucket results based on the provided field, with at most
+// size buckets (the most frequent values of the field).
+func (nf *NumericField) BucketBy(size int) *model.TermsAggregation {
+	return bucketBy(nf.NumericFieldName, size)
+}
+
+// Distinct Returns criteria to calculate the approximate number of distinct values in the field
+// across all results.
+func (nf *NumericField) Distinct() *model.CardinalityAggregation {
+	return &model.CardinalityAggregation{Field: nf.NumericFieldName}
+}
+
+// DateHistogram Returns criteria to bucket results by a calendar interval (for example "day",
+// "week" or "month") of the date held in the field, such as the create or update time of assets.
+func (nf *NumericField) DateHistogram(calendarInterval string) *model.DateHistogramAggregation {
+	return &model.DateHistogramAggregation{
+		Field:            nf.NumericFieldName,
+		CalendarInterval: calendarInterval,
 	}
 }
-*/
+
+// Avg Returns criteria to calculate the average value of the field across all results.
+func (nf *NumericField) Avg() *model.MetricAggregation {
+	return &model.MetricAggregation{Type: model.MetricAvg, Field: nf.NumericFieldName}
+}
+
+// Sum Returns criteria to calculate the sum of the values of the field across all results.
+func (nf *NumericField) Sum() *model.MetricAggregation {
+	return &model.MetricAggregation{Type: model.MetricSum, Field: nf.NumericFieldName}
+}
+
+// Min Returns criteria to calculate the minimum value of the field across all results.
+func (nf *NumericField) Min() *model.MetricAggregation {
+	return &model.MetricAggregation{Type: model.MetricMin, Field: nf.NumericFieldName}
+}
+
+// Max Returns criteria to calculate the maximum value of the field across all results.
+func (nf *NumericField) Max() *model.MetricAggregation {
+	return &model.MetricAggregation{Type: model.MetricMax, Field: nf.NumericFieldName}
+}
+
+// BucketBy Returns criteria to bucket results based on the custom metadata attribute, with at most
+// size buckets (the most frequent values of the attribute).
+func (cmf *CustomMetadataField) BucketBy(size int) *model.TermsAggregation {
+	return bucketBy(cmf.ElasticFieldName, size)
+}
+
+// Nested Returns criteria to run the provided aggregations over the nested documents at path.
+func Nested(path string, aggregations map[string]model.Aggregation) *model.NestedAggregation {
+	return &model.NestedAggregation{Path: path, SubAggregations: aggregations}
+}
+
+// Filters Returns criteria to bucket results by the provided named queries, with one bucket per query.
+func Filters(filters map[string]model.Query) *model.FiltersAggregation {
+	return &model.FiltersAggregation{Filters: filters}
+}
+
+func bucketBy(field string, size int) *model.TermsAggregation {
+	return &model.TermsAggregation{Field: field, Size: &size}
+}
+
+// Boost Returns a query that will match the same assets as the provided query, with its
+// score multiplied by boost, to rank its matches higher or lower (for example, within WhereSome).
+func Boost(query model.Query, boost float64) model.Query {
+	return &model.BoolQuery{Must: []model.Query{query}, Boost: &boost}
+}
+
+// ConstantScore Returns a query that will match the assets of the provided filter without scoring
+// them, giving every match the provided score.
+func ConstantScore(filter model.Query, score float64) model.Query {
+	return &model.ConstantScoreQuery{Filter: filter, Boost: &score}
+}
+
+// FunctionScore Returns a query that will match the assets of the provided query, with their score
+// adjusted by the provided functions (for example, weighting verified assets).
+func FunctionScore(query model.Query, functions ...model.ScoreFunction) *model.FunctionScoreQuery {
+	return &model.FunctionScoreQuery{Query: query, Functions: functions}
+}
+
+// DisMax Returns a query that will match assets matching any of the provided queries, scoring each
+// by the single best matching query.
+func DisMax(queries ...model.Query) *model.DisMaxQuery {
+	return &model.DisMaxQuery{Queries: queries}
+}
+
+// Boosting Returns a query that will match the assets of the positive query, with the score of
+// those that also match the negative query multiplied by negativeBoost (between 0 and 1).
+func Boosting(positive, negative model.Query, negativeBoost float64) model.Query {
+	return &model.BoostingQuery{Positive: positive, Negative: negative, NegativeBoost: negativeBoost}
+}
+
+// NestedQuery Returns a query that will match assets with at least one nested document at path
+// that matches the provided query.
+func NestedQuery(path string, query model.Query) *model.NestedQuery {
+	return &model.NestedQuery{Path: path, Query: query}
+}
+
+// Script Returns a query that will match assets for which the provided (painless) script returns
+// true, with params available to the script as params.<name>.
+func Script(source string, params map[string]interface{}) *model.ScriptQuery {
+	return &model.ScriptQuery{Source: source, Params: params}
+}
+
+// SpanNear Returns a query that will match assets where spans of the provided span queries occur
+// at most slop positions apart (and in the provided order, when inOrder).
+func SpanNear(slop int, inOrder bool, clauses ...model.Query) *model.SpanNearQuery {
+	return &model.SpanNearQuery{Clauses: clauses, Slop: slop, InOrder: inOrder}
+}
+
+// SpanFirst Returns a query that will match assets where a span of the provided span query ends
+// within the first end positions of the field.
+func SpanFirst(match model.Query, end int) *model.SpanFirstQuery {
+	return &model.SpanFirstQuery{Match: match, End: end}
+}
+
+// SpanOr Returns a query that will match the spans of any of the provided span queries.
+func SpanOr(clauses ...model.Query) *model.SpanOrQuery {
+	return &model.SpanOrQuery{Clauses: clauses}
+}
+
+// SpanNot Returns a query that will match the spans of include that don't overlap a span of exclude.
+func SpanNot(include, exclude model.Query) *model.SpanNotQuery {
+	return &model.SpanNotQuery{Include: include, Exclude: exclude}
+}
+
+// SpanWithin Returns a query that will match the spans of little that are enclosed by a span of big.
+func SpanWithin(big, little model.Query) *model.SpanWithinQuery {
+	return &model.SpanWithinQuery{Big: big, Little: little}
+}
+
+// SpanMulti Returns a span query that will match the terms of the provided prefix, wildcard, regexp,
+// fuzzy or range query, to be combined by the other span queries.
+func SpanMulti(query model.Query) *model.SpanMultiQuery {
+	return &model.SpanMultiQuery{Match: query}
+}
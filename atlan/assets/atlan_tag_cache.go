@@ -23,7 +23,7 @@ type AtlanTagCache struct {
 // NewAtlanTagCache creates a new AtlanTagCache instance.
 func NewAtlanTagCache(atlanClient *AtlanClient) *AtlanTagCache {
 	return &AtlanTagCache{
-		atlanClient:  DefaultAtlanClient,
+		atlanClient:  atlanClient,
 		cacheByID:    make(map[string]model.AtlanTagDef),
 		mapIDToName:  make(map[string]string),
 		mapNameToID:  make(map[string]string),
@@ -32,42 +32,55 @@ func NewAtlanTagCache(atlanClient *AtlanClient) *AtlanTagCache {
 	}
 }
 
-var (
-	caches = make(map[string]*AtlanTagCache)
-	mu     sync.Mutex
-)
+// AtlanTagCache returns the AtlanTagCache owned by this client.
+func (ac *AtlanClient) AtlanTagCache() *AtlanTagCache {
+	ac.caches.mutex.Lock()
+	defer ac.caches.mutex.Unlock()
 
-// GetCache returns the AtlanTagCache for the default AtlanClient.
-func GetAtlanTagCache() *AtlanTagCache {
-	client := DefaultAtlanClient
-	cacheKey := generateCacheKey(client.host, client.ApiKey)
-
-	mu.Lock()
-	defer mu.Unlock()
-
-	if _, ok := caches[cacheKey]; !ok {
-		caches[cacheKey] = &AtlanTagCache{
-			atlanClient:  client,
-			cacheByID:    make(map[string]model.AtlanTagDef),
-			mapIDToName:  make(map[string]string),
-			mapNameToID:  make(map[string]string),
-			deletedIDs:   make(map[string]struct{}),
-			deletedNames: make(map[string]struct{}),
-		}
+	if ac.caches.atlanTag == nil {
+		ac.caches.atlanTag = NewAtlanTagCache(ac)
 	}
-	return caches[cacheKey]
+	return ac.caches.atlanTag
 }
 
-func RefreshCache() {
-	GetAtlanTagCache().RefreshCache()
+// GetAtlanTagCache returns the AtlanTagCache for the default AtlanClient.
+func GetAtlanTagCache() (*AtlanTagCache, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.AtlanTagCache(), nil
+}
+
+func RefreshCache() error {
+	cache, err := GetAtlanTagCache()
+	if err != nil {
+		return err
+	}
+	return cache.RefreshCache()
 }
 
 func GetAtlanTagIDForName(name string) (string, error) {
-	return GetAtlanTagCache().GetIDForName(name)
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.GetAtlanTagIDForName(name)
 }
 
 func GetAtlanTagNameForID(idstr string) (string, error) {
-	return GetAtlanTagCache().GetNameForID(idstr)
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.GetAtlanTagNameForID(idstr)
+}
+
+// GetAtlanTagIDForName translates the human-readable Atlan tag name to its Atlan-internal ID string.
+func (ac *AtlanClient) GetAtlanTagIDForName(name string) (string, error) {
+	return ac.AtlanTagCache().GetIDForName(name)
+}
+
+// GetAtlanTagNameForID translates the Atlan-internal Atlan tag ID string to its human-readable name.
+func (ac *AtlanClient) GetAtlanTagNameForID(idstr string) (string, error) {
+	return ac.AtlanTagCache().GetNameForID(idstr)
 }
 
 // RefreshCache ref	reshes the cache of Atlan tags by requesting the full set of Atlan tags from Atlan.
@@ -76,7 +89,7 @@ func (c *AtlanTagCache) RefreshCache() error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	response, err := Get(atlan.AtlanTypeCategoryClassification)
+	response, err := c.atlanClient.GetTypeDefs(atlan.AtlanTypeCategoryClassification)
 	if err != nil {
 		fmt.Printf("Error making API call: %v", err)
 		return err
@@ -1,10 +1,14 @@
 package assets
 
 import (
+	"encoding/json"
 	"errors"
+	"reflect"
+	"sort"
 
 	"github.com/atlanhq/atlan-go/atlan"
 	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
 )
 
 // FailedBatch is an internal struct to capture batch failures.
@@ -35,39 +39,113 @@ func (fb *FailedBatch) GetFailureReason() error {
 	return fb.FailureReason
 }
 
-// Batch is a utility class for managing bulk updates in batches.
+// Deduplication is how a Batch treats an asset that is added while another asset with the
+// same type and qualified name is waiting in the batch to be saved.
+type Deduplication int
+
+const (
+	// KeepDuplicates saves every asset added, including repeats of the same asset.
+	KeepDuplicates Deduplication = iota
+	// LastWriteWins replaces the asset waiting in the batch with the one added.
+	LastWriteWins
+	// MergeDuplicates merges the asset added into the one waiting in the batch: the attributes,
+	// relationship attributes and custom metadata that it sets replace those of the waiting
+	// asset, and the others are kept. Only assets of the generated types (see entities.Object)
+	// can be merged; others are treated as LastWriteWins.
+	MergeDuplicates
+)
+
+// Batch is a utility class for managing bulk updates in batches. It is not safe for concurrent
+// use: to add assets from several goroutines, use a ParallelBatch.
 type Batch struct {
-	client                 *AtlanClient
-	maxSize                int
-	replaceAtlanTags       bool
-	customMetadataHandling atlan.CustomMetadataHandling
-	captureFailures        bool
-	batch                  []AtlanObject
-	failures               []FailedBatch
-	created                []*model.MutatedAssets
-	updated                []*model.MutatedAssets
+	client          *AtlanClient
+	maxSize         int
+	options         SaveOptions
+	captureFailures bool
+	deduplication   Deduplication
+	trackRestores   bool
+	batch           []AtlanObject
+	positions       map[batchKey]int // position in the batch of each asset, when deduplicating
+	failures        []FailedBatch
+	created         []*model.MutatedAssets
+	updated         []*model.MutatedAssets
+	partialUpdated  []*model.MutatedAssets
+	deleted         []*model.MutatedAssets
+	restored        []*model.MutatedAssets
+	skipped         []AtlanObject
+	resolvedGuids   map[string]string
 }
 
 // NewBatch creates a new Batch for managing bulk updates.
 func NewBatch(client *AtlanClient, maxSize int, replaceAtlanTags bool, customMetadataHandling atlan.CustomMetadataHandling, captureFailures bool) *Batch {
+	return NewBatchWithOptions(client, maxSize, SaveOptions{
+		ReplaceAtlanTags:       replaceAtlanTags,
+		CustomMetadataHandling: customMetadataHandling,
+	}, captureFailures)
+}
+
+// NewBatchWithOptions creates a new Batch for managing bulk updates, which saves each batch
+// with the given options.
+func NewBatchWithOptions(client *AtlanClient, maxSize int, options SaveOptions, captureFailures bool) *Batch {
 	return &Batch{
-		client:                 client,
-		maxSize:                maxSize,
-		replaceAtlanTags:       replaceAtlanTags,
-		customMetadataHandling: customMetadataHandling,
-		captureFailures:        captureFailures,
-		batch:                  []AtlanObject{},
-		failures:               []FailedBatch{},
-		created:                []*model.MutatedAssets{},
-		updated:                []*model.MutatedAssets{},
+		client:          client,
+		maxSize:         maxSize,
+		options:         options,
+		captureFailures: captureFailures,
+		batch:           []AtlanObject{},
+		positions:       make(map[batchKey]int),
+		failures:        []FailedBatch{},
+		created:         []*model.MutatedAssets{},
+		updated:         []*model.MutatedAssets{},
+		partialUpdated:  []*model.MutatedAssets{},
+		deleted:         []*model.MutatedAssets{},
+		restored:        []*model.MutatedAssets{},
+		skipped:         []AtlanObject{},
+		resolvedGuids:   make(map[string]string),
 	}
 }
 
+// Deduplicate sets how the batch treats an asset added with the same type and qualified name
+// as one that is waiting in the batch to be saved (by default, KeepDuplicates). Assets that were
+// already saved are not deduplicated.
+func (b *Batch) Deduplicate(deduplication Deduplication) *Batch {
+	b.deduplication = deduplication
+	return b
+}
+
+// TrackRestores sets whether the batch reports the archived assets that saving restores as
+// Restored, rather than as created or updated (by default, it doesn't). Atlan doesn't report
+// restores itself, so the batch searches for which of its assets are archived before saving them,
+// at the cost of a search for each batch saved.
+func (b *Batch) TrackRestores(track bool) *Batch {
+	b.trackRestores = track
+	return b
+}
+
 // Failures returns a list of FailedBatch objects containing information about any failed batches.
+// When failures are captured and saving a batch fails because of some of its assets, the batch
+// is split to save the other assets, and each asset that fails is reported in its own FailedBatch.
 func (b *Batch) Failures() []FailedBatch {
 	return b.failures
 }
 
+// ResolvedGuids returns the GUIDs that Atlan assigned to the assets created by the batch, keyed by
+// the placeholder (negative) GUIDs the assets were added with.
+func (b *Batch) ResolvedGuids() map[string]string {
+	resolved := make(map[string]string, len(b.resolvedGuids))
+	for placeholder, guid := range b.resolvedGuids {
+		resolved[placeholder] = guid
+	}
+	return resolved
+}
+
+// ResolveGuid returns the GUID that Atlan assigned to the asset added with the given placeholder
+// GUID, or false if no asset saved by the batch was added with it.
+func (b *Batch) ResolveGuid(placeholder string) (string, bool) {
+	guid, ok := b.resolvedGuids[placeholder]
+	return guid, ok
+}
+
 // Created returns a list of Assets that were created.
 func (b *Batch) Created() []*model.MutatedAssets {
 	return b.created
@@ -78,6 +156,30 @@ func (b *Batch) Updated() []*model.MutatedAssets {
 	return b.updated
 }
 
+// PartiallyUpdated returns a list of Assets that were partially updated, such as those that
+// only had relationships added or removed.
+func (b *Batch) PartiallyUpdated() []*model.MutatedAssets {
+	return b.partialUpdated
+}
+
+// Deleted returns a list of Assets that were deleted, such as by saving relationships that
+// replace them.
+func (b *Batch) Deleted() []*model.MutatedAssets {
+	return b.deleted
+}
+
+// Restored returns a list of Assets that were archived and were restored by saving them, when
+// restores are tracked (see TrackRestores). They are not also reported as created or updated.
+func (b *Batch) Restored() []*model.MutatedAssets {
+	return b.restored
+}
+
+// Skipped returns the assets that were saved without any change, because they matched what
+// was already in Atlan.
+func (b *Batch) Skipped() []AtlanObject {
+	return b.skipped
+}
+
 /* In Case, We want a list of assets dereferenced with attributes
 
 // Created returns a list of Assets that were created (dereferenced).
@@ -104,7 +206,9 @@ func (b *Batch) Updated() []model.MutatedAssets {
 
 // Add adds an asset to the batch and processes it if the batch size is reached.
 func (b *Batch) Add(asset AtlanObject) error {
-	b.batch = append(b.batch, asset)
+	if err := b.add(asset); err != nil {
+		return err
+	}
 	if len(b.batch) >= b.maxSize {
 		_, err := b.Flush()
 		return err
@@ -112,6 +216,38 @@ func (b *Batch) Add(asset AtlanObject) error {
 	return nil
 }
 
+// add adds an asset to the batch, deduplicating it from those waiting in the batch.
+func (b *Batch) add(asset AtlanObject) error {
+	if b.deduplication != KeepDuplicates {
+		key, _, err := keyOf(asset)
+		if err != nil {
+			return err
+		}
+		if key.qualifiedName != "" {
+			if position, ok := b.positions[key]; ok {
+				if b.deduplication == MergeDuplicates {
+					if asset, err = mergeAssets(b.batch[position], asset); err != nil {
+						return err
+					}
+				}
+				b.batch[position] = asset
+				return nil
+			}
+			b.positions[key] = len(b.batch)
+		}
+	}
+	b.batch = append(b.batch, asset)
+	return nil
+}
+
+// take removes the assets waiting in the batch and returns them.
+func (b *Batch) take() []AtlanObject {
+	assets := b.batch
+	b.batch = []AtlanObject{}
+	b.positions = make(map[batchKey]int)
+	return assets
+}
+
 // process checks if the batch size is reached and flushes the batch if needed.
 func (b *Batch) process() (*model.AssetMutationResponse, error) {
 	if len(b.batch) == b.maxSize {
@@ -126,39 +262,389 @@ func (b *Batch) Flush() (*model.AssetMutationResponse, error) {
 		return nil, nil // No assets to process
 	}
 
-	response, err := Save(b.batch...)
+	response, err := b.save(b.batch)
 	if err != nil {
-		if b.captureFailures {
+		return nil, err
+	}
+	b.take() // Clear the batch after processing
+	return response, nil
+}
+
+// save saves the assets and tracks the response. When failures are captured, the assets that
+// fail are recorded as failures instead of returning an error.
+func (b *Batch) save(assets []AtlanObject) (*model.AssetMutationResponse, error) {
+	client := b.client
+	if client == nil {
+		client = DefaultAtlanClient
+	}
+	var archived map[batchKey]bool
+	if b.trackRestores {
+		var err error
+		if archived, err = client.archivedKeys(assets); err != nil {
+			if !b.captureFailures {
+				return nil, err
+			}
 			b.failures = append(b.failures, FailedBatch{
-				FailedAssets:  b.batch,
+				FailedAssets:  assets,
 				FailureReason: err,
 			})
-		} else {
+			return nil, nil
+		}
+	}
+	response, err := client.SaveWithOptions(b.options, assets...)
+	if err != nil {
+		if !b.captureFailures {
 			return nil, err
 		}
+		if len(assets) > 1 && isAssetError(err) {
+			response = &model.AssetMutationResponse{}
+			half := len(assets) / 2
+			b.saveIsolatingFailures(client, assets[:half], archived, response)
+			b.saveIsolatingFailures(client, assets[half:], archived, response)
+		} else {
+			b.failures = append(b.failures, FailedBatch{
+				FailedAssets:  assets,
+				FailureReason: err,
+			})
+		}
+		return response, nil
 	}
+	b.trackResponse(assets, archived, response)
+	return response, nil
+}
 
-	if response != nil {
-		b.trackResponse(response)
+// saveIsolatingFailures saves the assets, and when that fails because of some of them, splits
+// them in halves to save each half in turn, until the assets that fail are isolated and reported
+// as failures. The responses of the saves that succeed are combined into the given response.
+// References to the placeholder GUIDs of assets saved by an earlier half are resolved first, as
+// Atlan only resolves placeholders within a single request.
+func (b *Batch) saveIsolatingFailures(client *AtlanClient, assets []AtlanObject, archived map[batchKey]bool, combined *model.AssetMutationResponse) {
+	resolved, err := b.withResolvedPlaceholders(assets)
+	if err != nil {
+		b.failures = append(b.failures, FailedBatch{
+			FailedAssets:  assets,
+			FailureReason: err,
+		})
+		return
+	}
+	response, err := client.SaveWithOptions(b.options, resolved...)
+	if err == nil {
+		b.trackResponse(assets, archived, response)
+		combineResponses(combined, response)
+		return
 	}
+	if len(assets) == 1 || !isAssetError(err) {
+		b.failures = append(b.failures, FailedBatch{
+			FailedAssets:  assets,
+			FailureReason: err,
+		})
+		return
+	}
+	half := len(assets) / 2
+	b.saveIsolatingFailures(client, assets[:half], archived, combined)
+	b.saveIsolatingFailures(client, assets[half:], archived, combined)
+}
 
-	b.batch = []AtlanObject{} // Clear the batch after processing
-	return response, nil
+// isAssetError reports whether the error is caused by the assets being saved, rather than by
+// the connection to Atlan or its availability, so that saving other assets may succeed.
+func isAssetError(err error) bool {
+	return errors.Is(err, ErrInvalidRequest) || errors.Is(err, ErrNotFound)
+}
+
+// combineResponses adds the assets and GUID assignments of the response to the combined response.
+func combineResponses(combined, response *model.AssetMutationResponse) {
+	for placeholder, guid := range response.GuidAssignments {
+		if combined.GuidAssignments == nil {
+			combined.GuidAssignments = make(map[string]string)
+		}
+		combined.GuidAssignments[placeholder] = guid
+	}
+	if response.MutatedEntities != nil {
+		if combined.MutatedEntities == nil {
+			combined.MutatedEntities = &model.MutatedEntities{}
+		}
+		combined.MutatedEntities.CREATE = append(combined.MutatedEntities.CREATE, response.MutatedEntities.CREATE...)
+		combined.MutatedEntities.UPDATE = append(combined.MutatedEntities.UPDATE, response.MutatedEntities.UPDATE...)
+		combined.MutatedEntities.DELETE = append(combined.MutatedEntities.DELETE, response.MutatedEntities.DELETE...)
+		combined.MutatedEntities.PARTIAL_UPDATE = append(combined.MutatedEntities.PARTIAL_UPDATE, response.MutatedEntities.PARTIAL_UPDATE...)
+	}
+	combined.PartialUpdatedEntities = append(combined.PartialUpdatedEntities, response.PartialUpdatedEntities...)
 }
 
-// trackResponse processes the response and updates the created and updated assets.
-func (b *Batch) trackResponse(response *model.AssetMutationResponse) {
+// trackResponse records the assets that the response reports as changed, by how they were
+// changed, and the assets saved that it doesn't report, which were unchanged. The assets that
+// were archived (by key) and that the response reports as created or updated were restored.
+func (b *Batch) trackResponse(assets []AtlanObject, archived map[batchKey]bool, response *model.AssetMutationResponse) {
+	for placeholder, guid := range response.GuidAssignments {
+		b.resolvedGuids[placeholder] = guid
+	}
+	changedGuids := make(map[string]bool)
+	changedKeys := make(map[batchKey]bool)
+	trackAll := func(tracker *[]*model.MutatedAssets, mutated []*model.MutatedAssets, restorable bool) {
+		for _, asset := range mutated {
+			changedGuids[asset.Guid] = true
+			if asset.Attributes.QualifiedName == nil {
+				b.track(tracker, asset)
+				continue
+			}
+			key := batchKey{asset.TypeName, *asset.Attributes.QualifiedName}
+			changedKeys[key] = true
+			if restorable && archived[key] {
+				b.track(&b.restored, asset)
+			} else {
+				b.track(tracker, asset)
+			}
+		}
+	}
 	if response.MutatedEntities != nil {
-		for _, asset := range response.MutatedEntities.CREATE {
-			b.track(&b.created, asset)
+		trackAll(&b.created, response.MutatedEntities.CREATE, true)
+		trackAll(&b.updated, response.MutatedEntities.UPDATE, true)
+		trackAll(&b.partialUpdated, response.MutatedEntities.PARTIAL_UPDATE, false)
+		trackAll(&b.deleted, response.MutatedEntities.DELETE, false)
+	}
+	trackAll(&b.partialUpdated, response.PartialUpdatedEntities, false)
+
+	for _, asset := range assets {
+		key, guid, err := keyOf(asset)
+		if err != nil {
+			continue
+		}
+		if assigned, ok := response.GuidAssignments[guid]; ok {
+			guid = assigned
 		}
-		for _, asset := range response.MutatedEntities.UPDATE {
-			b.track(&b.updated, asset)
+		if (guid == "" || !changedGuids[guid]) && (key.qualifiedName == "" || !changedKeys[key]) {
+			b.skipped = append(b.skipped, asset)
 		}
 	}
 }
 
+// archivedKeys returns the keys of the assets that are archived in Atlan, found by searching for
+// the archived assets with their types and qualified names.
+func (ac *AtlanClient) archivedKeys(assets []AtlanObject) (map[batchKey]bool, error) {
+	archived := make(map[batchKey]bool)
+	wanted := make(map[batchKey]bool)
+	typeNames := make(map[string]bool)
+	var qualifiedNames []string
+	for _, asset := range assets {
+		key, _, err := keyOf(asset)
+		if err != nil {
+			return nil, err
+		}
+		if key.qualifiedName == "" || wanted[key] {
+			continue
+		}
+		wanted[key] = true
+		typeNames[key.typeName] = true
+		qualifiedNames = append(qualifiedNames, key.qualifiedName)
+	}
+	if len(wanted) == 0 {
+		return archived, nil
+	}
+	types := make([]string, 0, len(typeNames))
+	for typeName := range typeNames {
+		types = append(types, typeName)
+	}
+	sort.Strings(types)
+
+	iterator, err := ac.NewFluentSearch().
+		ArchivedAssets().
+		AssetTypes(types).
+		Where(&model.Terms{Field: QUALIFIED_NAME, Values: qualifiedNames}).
+		IncludeOnResults(QUALIFIED_NAME).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	found, errs := iterator.Iter()
+	for asset := range found {
+		if asset.TypeName == nil || asset.QualifiedName == nil {
+			continue
+		}
+		if key := (batchKey{*asset.TypeName, *asset.QualifiedName}); wanted[key] {
+			archived[key] = true
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
 // track adds an asset to the tracker.
 func (b *Batch) track(tracker *[]*model.MutatedAssets, asset *model.MutatedAssets) {
 	*tracker = append(*tracker, asset)
 }
+
+// batchKey identifies an asset within a batch, to deduplicate it.
+type batchKey struct {
+	typeName      string
+	qualifiedName string
+}
+
+// keyOf returns the type and qualified name of the asset, and its GUID, from its JSON.
+func keyOf(asset AtlanObject) (batchKey, string, error) {
+	data, err := asset.MarshalJSON()
+	if err != nil {
+		return batchKey{}, "", err
+	}
+	var header struct {
+		TypeName   string `json:"typeName"`
+		Guid       string `json:"guid"`
+		Attributes struct {
+			QualifiedName string `json:"qualifiedName"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return batchKey{}, "", err
+	}
+	return batchKey{header.TypeName, header.Attributes.QualifiedName}, header.Guid, nil
+}
+
+// mergeAssets returns a new asset, of the type of the later one, with the properties of the
+// earlier asset overridden by those that the later asset sets. Attributes, relationship
+// attributes and custom metadata are merged one by one.
+func mergeAssets(earlier, later AtlanObject) (AtlanObject, error) {
+	earlierObject, ok := earlier.(entities.Object)
+	if !ok {
+		return later, nil
+	}
+	laterObject, ok := later.(entities.Object)
+	if !ok {
+		return later, nil
+	}
+	var merged, updates map[string]interface{}
+	if err := remarshal(earlierObject, &merged); err != nil {
+		return nil, err
+	}
+	if err := remarshal(laterObject, &updates); err != nil {
+		return nil, err
+	}
+	for key, value := range updates {
+		switch key {
+		case "attributes", "relationshipAttributes", "businessAttributes":
+			values, _ := merged[key].(map[string]interface{})
+			if values == nil {
+				values = make(map[string]interface{})
+			}
+			updatedValues, _ := value.(map[string]interface{})
+			for name, update := range updatedValues {
+				if key == "businessAttributes" {
+					set, _ := values[name].(map[string]interface{})
+					updatedSet, _ := update.(map[string]interface{})
+					values[name] = mergeMaps(set, updatedSet)
+				} else {
+					values[name] = update
+				}
+			}
+			merged[key] = values
+		default:
+			merged[key] = value
+		}
+	}
+	return newObjectLike(laterObject, merged, earlierObject, laterObject)
+}
+
+// newObjectLike decodes the fields into a new asset of the type of like, with the custom metadata
+// of the sources merged in order (custom metadata is not part of the JSON of an asset).
+func newObjectLike(like entities.Object, fields map[string]interface{}, sources ...entities.Object) (entities.Object, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	object := reflect.New(reflect.TypeOf(like).Elem()).Interface().(entities.Object)
+	if err := object.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	for _, source := range sources {
+		for setName, attributes := range source.GetEntity().CustomMetadata {
+			object.GetEntity().SetCustomMetadata(setName, mergeMaps(object.GetEntity().GetCustomMetadata(setName), attributes))
+		}
+	}
+	return object, nil
+}
+
+// withResolvedPlaceholders returns the assets with the placeholder GUIDs they reference replaced by
+// the GUIDs that earlier saves of the batch assigned to them, so that an asset split from the
+// asset it references can still be saved. Assets that reference no such placeholder, or that
+// aren't generated entity types, are returned as they are.
+func (b *Batch) withResolvedPlaceholders(assets []AtlanObject) ([]AtlanObject, error) {
+	if len(b.resolvedGuids) == 0 {
+		return assets, nil
+	}
+	resolved := make([]AtlanObject, len(assets))
+	for i, asset := range assets {
+		resolved[i] = asset
+		object, ok := asset.(entities.Object)
+		if !ok {
+			continue
+		}
+		var fields map[string]interface{}
+		if err := remarshal(object, &fields); err != nil {
+			return nil, err
+		}
+		replaced := false
+		for _, key := range []string{"attributes", "relationshipAttributes", "appendRelationshipAttributes", "removeRelationshipAttributes"} {
+			if replacePlaceholders(fields[key], b.resolvedGuids) {
+				replaced = true
+			}
+		}
+		if !replaced {
+			continue
+		}
+		rebuilt, err := newObjectLike(object, fields, object)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = rebuilt
+	}
+	return resolved, nil
+}
+
+// replacePlaceholders replaces the placeholder GUIDs of the references within the decoded JSON
+// value by their resolved GUIDs, reporting whether any was replaced.
+func replacePlaceholders(value interface{}, resolvedGuids map[string]string) bool {
+	replaced := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if placeholder, ok := v["guid"].(string); ok {
+			if guid, ok := resolvedGuids[placeholder]; ok {
+				v["guid"] = guid
+				replaced = true
+			}
+			return replaced
+		}
+		for _, item := range v {
+			if replacePlaceholders(item, resolvedGuids) {
+				replaced = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if replacePlaceholders(item, resolvedGuids) {
+				replaced = true
+			}
+		}
+	}
+	return replaced
+}
+
+// remarshal encodes the object as JSON and decodes it into target.
+func remarshal(object json.Marshaler, target interface{}) error {
+	data, err := object.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// mergeMaps returns a new map with the values of base overridden by those of updates.
+func mergeMaps(base, updates map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(updates))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range updates {
+		merged[key] = value
+	}
+	return merged
+}
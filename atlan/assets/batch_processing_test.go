@@ -0,0 +1,261 @@
+package assets_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/assets"
+	"github.com/atlanhq/atlan-go/atlan/atlantest"
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+	"github.com/atlanhq/atlan-go/atlan/model/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBulkServer returns a fake tenant that rejects the bulk entity requests in which any entity
+// is named BAD.
+func newBulkServer(t *testing.T) (*atlantest.Server, *assets.AtlanClient) {
+	server, client := newTestServer(t)
+	server.RejectEntities(func(entity map[string]interface{}) error {
+		if entity["attributes"].(map[string]interface{})["name"] == "BAD" {
+			return errors.New("invalid attribute")
+		}
+		return nil
+	})
+	return server, client
+}
+
+// bulkEntities returns the entities of each request sent to the bulk entity API.
+func bulkEntities(t *testing.T, server *atlantest.Server) [][]map[string]interface{} {
+	var requests [][]map[string]interface{}
+	for _, received := range server.Received("POST /api/meta/entity/bulk") {
+		var request struct {
+			Entities []map[string]interface{} `json:"entities"`
+		}
+		require.NoError(t, received.DecodeBody(&request))
+		requests = append(requests, request.Entities)
+	}
+	return requests
+}
+
+func newTable(t *testing.T, name string) *entities.Table {
+	table := &entities.Table{}
+	require.NoError(t, table.Creator(name, "default/snowflake/123/DB/SCHEMA"))
+	guid := atlan.NextID()
+	table.Guid = &guid
+	return table
+}
+
+func TestBatchDeduplicatesAssets(t *testing.T) {
+	for _, tc := range []struct {
+		deduplication assets.Deduplication
+		want          []map[string]interface{}
+	}{
+		{assets.KeepDuplicates, []map[string]interface{}{
+			{"name": "ORDERS", "description": "All orders", "userDescription": nil},
+			{"name": "ORDERS", "description": nil, "userDescription": "Every order"},
+		}},
+		{assets.LastWriteWins, []map[string]interface{}{
+			{"name": "ORDERS", "description": nil, "userDescription": "Every order"},
+		}},
+		{assets.MergeDuplicates, []map[string]interface{}{
+			{"name": "ORDERS", "description": "All orders", "userDescription": "Every order"},
+		}},
+	} {
+		server, client := newTestServer(t)
+		server.AddTypeDefs(map[string]interface{}{"businessMetadataDefs": []interface{}{map[string]interface{}{
+			"name": "cm1", "displayName": "Governance", "attributeDefs": []interface{}{
+				map[string]interface{}{"name": "a1", "displayName": "owner", "options": map[string]interface{}{}},
+				map[string]interface{}{"name": "a2", "displayName": "tier", "options": map[string]interface{}{}},
+			},
+		}}})
+
+		first := newTable(t, "ORDERS")
+		first.Description = structs.StringPtr("All orders")
+		first.SetCustomMetadata("Governance", map[string]interface{}{"owner": "Ana"})
+		second := &entities.Table{}
+		require.NoError(t, second.Updater("ORDERS", *first.QualifiedName))
+		second.UserDescription = structs.StringPtr("Every order")
+		second.SetCustomMetadata("Governance", map[string]interface{}{"tier": 1})
+
+		batch := assets.NewBatch(client, 10, false, atlan.MERGE, false).Deduplicate(tc.deduplication)
+		require.NoError(t, batch.Add(first))
+		require.NoError(t, batch.Add(second))
+		_, err := batch.Flush()
+		require.NoError(t, err)
+
+		requests := bulkEntities(t, server)
+		require.Len(t, requests, 1)
+		require.Len(t, requests[0], len(tc.want))
+		for i, want := range tc.want {
+			attributes := requests[0][i]["attributes"].(map[string]interface{})
+			for name, value := range want {
+				assert.Equal(t, value, attributes[name], "%d: %s", tc.deduplication, name)
+			}
+		}
+		if tc.deduplication == assets.MergeDuplicates {
+			assert.Equal(t, *first.Guid, requests[0][0]["guid"])
+			assert.Equal(t, map[string]interface{}{"cm1": map[string]interface{}{"a1": "Ana", "a2": float64(1)}},
+				requests[0][0]["businessAttributes"])
+		}
+	}
+}
+
+func TestBatchIsolatesTheAssetsThatFail(t *testing.T) {
+	server, client := newBulkServer(t)
+
+	batch := assets.NewBatch(client, 5, false, atlan.IGNORE, true)
+	tables := []*entities.Table{newTable(t, "A"), newTable(t, "B"), newTable(t, "BAD"), newTable(t, "C"), newTable(t, "D")}
+	for _, table := range tables {
+		require.NoError(t, batch.Add(table))
+	}
+
+	// The batch fails and is split into A B and BAD C D, which fails and is split into BAD and C D
+	assert.Len(t, bulkEntities(t, server), 5)
+	require.Len(t, batch.Failures(), 1)
+	assert.Equal(t, []assets.AtlanObject{tables[2]}, batch.Failures()[0].FailedAssets)
+	assert.ErrorIs(t, batch.Failures()[0].FailureReason, assets.ErrInvalidRequest)
+	assert.Len(t, batch.Created(), 4)
+	assert.Len(t, server.Entities(), 4)
+
+	guid, ok := batch.ResolveGuid(*tables[3].Guid)
+	assert.True(t, ok)
+	assert.Equal(t, "C", server.Entity(guid)["attributes"].(map[string]interface{})["name"])
+	_, ok = batch.ResolveGuid(*tables[2].Guid)
+	assert.False(t, ok, "the asset that failed was not assigned a GUID")
+	assert.Len(t, batch.ResolvedGuids(), 4)
+}
+
+func TestBatchResolvesPlaceholdersAcrossTheSplit(t *testing.T) {
+	server, client := newBulkServer(t)
+
+	table := newTable(t, "ORDERS")
+	column := &entities.Column{}
+	require.NoError(t, column.Creator("ID", "Table", *table.QualifiedName, 1))
+	column.Guid = structs.StringPtr(atlan.NextID())
+	column.Table = entities.RefByGuid("Table", *table.Guid)
+	batch := assets.NewBatch(client, 4, false, atlan.IGNORE, true)
+	for _, asset := range []assets.AtlanObject{table, newTable(t, "BAD"), column, newTable(t, "ITEMS")} {
+		require.NoError(t, batch.Add(asset))
+	}
+
+	// The batch is split into ORDERS BAD and ID ITEMS, so the column is saved after its table
+	require.Len(t, batch.Failures(), 1)
+	assert.Len(t, batch.Failures()[0].FailedAssets, 1)
+	assert.Len(t, batch.Created(), 3)
+	tableGuid, ok := batch.ResolveGuid(*table.Guid)
+	require.True(t, ok)
+	columnGuid, ok := batch.ResolveGuid(*column.Guid)
+	require.True(t, ok)
+	assert.Equal(t, tableGuid, server.Entity(columnGuid)["relationshipAttributes"].(map[string]interface{})["table"].(map[string]interface{})["guid"])
+	assert.Equal(t, *table.Guid, column.Table.Guid, "the asset added is left unchanged")
+}
+
+func TestBatchDoesNotSplitOnOtherFailures(t *testing.T) {
+	server, client := newTestServer(t)
+	server.FailRequests("POST /api/meta/entity/bulk", http.StatusForbidden)
+
+	batch := assets.NewBatch(client, 3, false, atlan.IGNORE, true)
+	for _, name := range []string{"A", "B", "C"} {
+		require.NoError(t, batch.Add(newTable(t, name)))
+	}
+	assert.Len(t, bulkEntities(t, server), 1)
+	require.Len(t, batch.Failures(), 1)
+	assert.Len(t, batch.Failures()[0].FailedAssets, 3)
+}
+
+func TestBatchReportsEveryKindOfChange(t *testing.T) {
+	created := newTable(t, "A")
+	updated := newTable(t, "B")
+	updated.Guid = structs.StringPtr("b")
+	unchanged := &entities.Table{}
+	require.NoError(t, unchanged.Updater("C", "default/snowflake/123/DB/SCHEMA/C"))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Entities []map[string]interface{} `json:"entities"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if request.Entities[0]["attributes"].(map[string]interface{})["name"] == "BAD" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"mutatedEntities": {
+			"CREATE": [{"typeName": "Table", "guid": "a", "attributes": {"qualifiedName": %q}}],
+			"UPDATE": [{"typeName": "Table", "guid": "b"}],
+			"PARTIAL_UPDATE": [{"typeName": "Column", "guid": "c1"}],
+			"DELETE": [{"typeName": "Column", "guid": "c2"}]
+		}, "guidAssignments": {%q: "a"}}`, *created.QualifiedName, *created.Guid)
+	}))
+	defer ts.Close()
+	client, _ := assets.Context(ts.URL, "api_key")
+
+	batch := assets.NewBatch(client, 10, false, atlan.IGNORE, true)
+	for _, asset := range []*entities.Table{created, updated, unchanged} {
+		require.NoError(t, batch.Add(asset))
+	}
+	_, err := batch.Flush()
+	require.NoError(t, err)
+	require.NoError(t, batch.Add(newTable(t, "BAD")))
+	_, err = batch.Flush()
+	require.NoError(t, err)
+
+	assert.Len(t, batch.PartiallyUpdated(), 1)
+	assert.Len(t, batch.Deleted(), 1)
+	assert.Equal(t, []assets.AtlanObject{unchanged}, batch.Skipped())
+
+	data, err := batch.Report().ToJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"operations": {"CREATE": 1, "UPDATE": 1, "PARTIAL_UPDATE": 1, "DELETE": 1, "SKIPPED": 1, "FAILED": 1},
+		"types": {
+			"Table": {"CREATE": 1, "UPDATE": 1, "SKIPPED": 1, "FAILED": 1},
+			"Column": {"PARTIAL_UPDATE": 1, "DELETE": 1}
+		},
+		"guidAssignments": {%q: "a"}
+	}`, *created.Guid), string(data))
+}
+
+func TestBatchReportsTheArchivedAssetsItRestores(t *testing.T) {
+	restored := newTable(t, "A")
+	created := newTable(t, "B")
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/search/indexsearch") {
+			var request model.IndexSearchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			data, _ := json.Marshal(request.Dsl.Query)
+			query = string(data)
+			fmt.Fprintf(w, `{"searchParameters": {}, "approximateCount": 1, "entities": [
+				{"typeName": "Table", "guid": "a", "status": "DELETED", "attributes": {"qualifiedName": %q}}
+			]}`, *restored.QualifiedName)
+			return
+		}
+		fmt.Fprintf(w, `{"mutatedEntities": {
+			"UPDATE": [{"typeName": "Table", "guid": "a", "attributes": {"qualifiedName": %q}}],
+			"CREATE": [{"typeName": "Table", "guid": "b", "attributes": {"qualifiedName": %q}}]
+		}}`, *restored.QualifiedName, *created.QualifiedName)
+	}))
+	defer ts.Close()
+	client, _ := assets.Context(ts.URL, "api_key")
+
+	batch := assets.NewBatch(client, 10, false, atlan.IGNORE, false).TrackRestores(true)
+	require.NoError(t, batch.Add(restored))
+	require.NoError(t, batch.Add(created))
+	_, err := batch.Flush()
+	require.NoError(t, err)
+
+	assert.Contains(t, query, `{"term":{"__state":{"value":"DELETED"}}}`)
+	assert.Contains(t, query, `{"terms":{"qualifiedName":["default/snowflake/123/DB/SCHEMA/A","default/snowflake/123/DB/SCHEMA/B"]}}`)
+	require.Len(t, batch.Restored(), 1)
+	assert.Equal(t, "a", batch.Restored()[0].Guid)
+	assert.Empty(t, batch.Updated(), "restored assets are not also reported as updated")
+	assert.Len(t, batch.Created(), 1)
+	assert.Equal(t, map[string]int{"RESTORED": 1, "CREATE": 1}, batch.Report().Operations)
+}
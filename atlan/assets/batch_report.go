@@ -0,0 +1,89 @@
+package assets
+
+import (
+	"encoding/json"
+
+	"github.com/atlanhq/atlan-go/atlan/model"
+)
+
+// Operations by which a BatchReport counts the assets of a batch. The operations that Atlan
+// reports are named as in the mutatedEntities of its responses.
+const (
+	BatchOperationCreate        = "CREATE"
+	BatchOperationUpdate        = "UPDATE"
+	BatchOperationPartialUpdate = "PARTIAL_UPDATE"
+	BatchOperationDelete        = "DELETE"
+	// Archived and restored by saving it, when restores are tracked.
+	BatchOperationRestore = "RESTORED"
+	// Saved without any change.
+	BatchOperationSkip = "SKIPPED"
+	// Failed to be saved, when failures are captured.
+	BatchOperationFail = "FAILED"
+)
+
+// BatchReport summarizes what a Batch (or ParallelBatch) changed in Atlan, for example to
+// publish an audit of a sync. Encode it with ToJSON.
+type BatchReport struct {
+	// Number of assets by operation.
+	Operations map[string]int `json:"operations"`
+	// Number of assets by type name, and then by operation.
+	Types map[string]map[string]int `json:"types"`
+	// GUIDs that Atlan assigned to the assets created, keyed by the placeholder GUIDs the assets
+	// were added with.
+	GuidAssignments map[string]string `json:"guidAssignments"`
+}
+
+// Report returns a summary of what the batch has changed so far.
+func (b *Batch) Report() *BatchReport {
+	return newBatchReport(b)
+}
+
+// newBatchReport returns the combined report of the batches.
+func newBatchReport(batches ...*Batch) *BatchReport {
+	report := &BatchReport{
+		Operations:      make(map[string]int),
+		Types:           make(map[string]map[string]int),
+		GuidAssignments: make(map[string]string),
+	}
+	for _, b := range batches {
+		report.countMutated(BatchOperationCreate, b.created)
+		report.countMutated(BatchOperationUpdate, b.updated)
+		report.countMutated(BatchOperationPartialUpdate, b.partialUpdated)
+		report.countMutated(BatchOperationDelete, b.deleted)
+		report.countMutated(BatchOperationRestore, b.restored)
+		report.countAssets(BatchOperationSkip, b.skipped)
+		for _, failure := range b.failures {
+			report.countAssets(BatchOperationFail, failure.FailedAssets)
+		}
+		for placeholder, guid := range b.resolvedGuids {
+			report.GuidAssignments[placeholder] = guid
+		}
+	}
+	return report
+}
+
+func (r *BatchReport) countMutated(operation string, assets []*model.MutatedAssets) {
+	for _, asset := range assets {
+		r.count(asset.TypeName, operation)
+	}
+}
+
+func (r *BatchReport) countAssets(operation string, assets []AtlanObject) {
+	for _, asset := range assets {
+		key, _, _ := keyOf(asset)
+		r.count(key.typeName, operation)
+	}
+}
+
+func (r *BatchReport) count(typeName, operation string) {
+	r.Operations[operation]++
+	if r.Types[typeName] == nil {
+		r.Types[typeName] = make(map[string]int)
+	}
+	r.Types[typeName][operation]++
+}
+
+// ToJSON encodes the report as indented JSON.
+func (r *BatchReport) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
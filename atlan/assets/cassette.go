@@ -0,0 +1,271 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// CassetteMode controls whether a Cassette records live interactions or replays recorded ones.
+type CassetteMode int
+
+const (
+	// CassetteModeReplay serves every request from the cassette file, and fails
+	// requests that were not recorded. No request reaches Atlan.
+	CassetteModeReplay CassetteMode = iota
+	// CassetteModeRecord sends every request to Atlan and records it, replacing
+	// any interactions previously recorded in the cassette file.
+	CassetteModeRecord
+	// CassetteModeAuto replays the cassette file if it exists, and records it otherwise.
+	CassetteModeAuto
+)
+
+// ScrubbedValue replaces the value of scrubbed headers in recorded cassettes.
+const ScrubbedValue = "REDACTED"
+
+// ErrInteractionNotFound is returned when replaying a request that was not recorded in the cassette.
+var ErrInteractionNotFound = errors.New("no recorded interaction matches the request")
+
+// CassetteRequest is a recorded HTTP request.
+type CassetteRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded HTTP response.
+type CassetteResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Interaction is a request/response pair recorded in a cassette.
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// Cassette records the HTTP interactions of an AtlanClient to a file and replays them,
+// so that code calling Atlan can be tested deterministically and offline.
+// Requests are matched on their method, path, query and normalized JSON body;
+// the host is ignored so a cassette recorded against one tenant can be replayed anywhere.
+type Cassette struct {
+	// ScrubHeaders lists the request and response headers whose values are never written
+	// to the cassette file. It defaults to the Authorization header.
+	ScrubHeaders []string
+
+	path         string
+	mode         CassetteMode
+	mutex        sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewCassette opens the cassette file at the given path in the given mode.
+// In replay mode the file must exist; in record mode it is (re)written as requests are made.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{ScrubHeaders: []string{"Authorization"}, path: path, mode: mode}
+
+	if mode == CassetteModeRecord {
+		return c, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && mode == CassetteModeAuto {
+		c.mode = CassetteModeRecord
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+	c.mode = CassetteModeReplay
+	c.replayed = make([]bool, len(c.interactions))
+	return c, nil
+}
+
+// Mode returns whether the cassette is recording or replaying.
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// Interactions returns the interactions recorded in the cassette.
+func (c *Cassette) Interactions() []Interaction {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// WithCassette records or replays every HTTP request of the client through the cassette
+// file at the given path. See NewCassette.
+func WithCassette(path string, mode CassetteMode) ClientOption {
+	return func(o *clientOptions) error {
+		cassette, err := NewCassette(path, mode)
+		if err != nil {
+			return err
+		}
+		o.cassette = cassette
+		return nil
+	}
+}
+
+// UseCassette records or replays every HTTP request of the client through the given cassette.
+// Passing nil sends requests to Atlan again.
+func (ac *AtlanClient) UseCassette(cassette *Cassette) {
+	ac.cassette = cassette
+}
+
+// middleware returns the RequestHandler that records requests through next,
+// or replays them without calling it.
+func (c *Cassette) middleware(next RequestHandler) RequestHandler {
+	return func(req *http.Request) (*http.Response, error) {
+		body, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		if c.mode == CassetteModeReplay {
+			return c.replay(req, body)
+		}
+		return c.record(req, body, next)
+	}
+}
+
+// replay serves the first recorded interaction matching the request that wasn't replayed yet,
+// so a sequence of identical requests gets the recorded sequence of responses.
+func (c *Cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	query := req.URL.Query().Encode()
+	normalizedBody := normalizeJSONBody(body)
+	for i, interaction := range c.interactions {
+		if c.replayed[i] || interaction.Request.Method != req.Method ||
+			interaction.Request.Path != req.URL.Path || interaction.Request.Query != query ||
+			normalizeJSONBody([]byte(interaction.Request.Body)) != normalizedBody {
+			continue
+		}
+		c.replayed[i] = true
+		recorded := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+			StatusCode:    recorded.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, req.URL.RequestURI())
+}
+
+// record sends the request and appends the interaction to the cassette file.
+func (c *Cassette) record(req *http.Request, body []byte, next RequestHandler) (*http.Response, error) {
+	interaction := Interaction{
+		Request: CassetteRequest{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   req.URL.Query().Encode(),
+			Headers: c.scrub(req.Header),
+			Body:    string(body),
+		},
+	}
+
+	resp, err := next(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	interaction.Response = CassetteResponse{
+		Status:  resp.StatusCode,
+		Headers: c.scrub(resp.Header),
+		Body:    string(responseBody),
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.interactions = append(c.interactions, interaction)
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// save writes all recorded interactions to the cassette file.
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(c.path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("unable to create cassette directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(c.path, data, 0o644); err != nil {
+		return fmt.Errorf("unable to write cassette: %w", err)
+	}
+	return nil
+}
+
+// scrub copies the headers, replacing the values of the scrubbed ones.
+func (c *Cassette) scrub(headers http.Header) http.Header {
+	scrubbed := headers.Clone()
+	for _, name := range c.ScrubHeaders {
+		if _, ok := scrubbed[http.CanonicalHeaderKey(name)]; ok {
+			scrubbed.Set(name, ScrubbedValue)
+		}
+	}
+	return scrubbed
+}
+
+// readRequestBody reads the body of the request and restores it, so it can still be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// normalizeJSONBody re-encodes a JSON body with sorted keys and no insignificant whitespace,
+// so that equivalent payloads compare equal. Other bodies are returned as-is.
+func normalizeJSONBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+	return string(normalized)
+}
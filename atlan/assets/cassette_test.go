@@ -0,0 +1,99 @@
+package assets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Authorization", "Bearer leaked")
+		fmt.Fprintf(w, `{"request": %d}`, n)
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "search.json")
+	api := &API{Name: "TEST_SEARCH", Method: http.MethodPost, Endpoint: Endpoint{Atlas: "/test"}, Path: "/search", Status: http.StatusOK}
+	request := map[string]interface{}{"dsl": map[string]interface{}{"from": 0, "size": 10}}
+
+	recorder, err := NewClient(ts.URL, "secret_api_key", WithCassette(path, CassetteModeAuto))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = recorder.CallAPI(api, map[string]string{"b": "2", "a": "1"}, request)
+		require.NoError(t, err)
+	}
+	_, err = recorder.CallAPI(testEndpointAPI, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret_api_key")
+	assert.NotContains(t, string(data), "leaked")
+	var interactions []Interaction
+	require.NoError(t, json.Unmarshal(data, &interactions))
+	require.Len(t, interactions, 3)
+	assert.Equal(t, ScrubbedValue, interactions[0].Request.Headers.Get("Authorization"))
+	assert.Equal(t, "/test/search", interactions[0].Request.Path)
+	assert.Equal(t, "a=1&b=2", interactions[0].Request.Query)
+
+	// Replaying never reaches the server, and serves identical requests in recorded order
+	replayer, err := NewClient("offline.atlan.com", "other_key", WithCassette(path, CassetteModeAuto))
+	require.NoError(t, err)
+	first, err := replayer.CallAPI(api, map[string]string{"a": "1", "b": "2"}, request)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"request": 1}`, string(first))
+	second, err := replayer.CallAPI(api, map[string]string{"a": "1", "b": "2"}, request)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"request": 2}`, string(second))
+	third, err := replayer.CallAPI(testEndpointAPI, nil, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"request": 3}`, string(third))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	_, err = replayer.CallAPI(api, map[string]string{"a": "1", "b": "2"}, request)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), ErrInteractionNotFound.Error())
+}
+
+func TestCassetteMatchesNormalizedJSONBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	interactions := []Interaction{{
+		Request:  CassetteRequest{Method: http.MethodPost, Path: "/test/endpoint", Body: `{"b": [1, 2], "a": {"y": true, "x": 1.5}}`},
+		Response: CassetteResponse{Status: http.StatusOK, Body: `{"matched": true}`},
+	}}
+	data, _ := json.Marshal(interactions)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	client, err := NewClient("offline.atlan.com", "api_key", WithCassette(path, CassetteModeReplay))
+	require.NoError(t, err)
+	api := &API{Method: http.MethodPost, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+
+	_, err = client.CallAPI(api, nil, map[string]interface{}{"b": []int{1, 3}})
+	require.Error(t, err)
+
+	response, err := client.CallAPI(api, nil, map[string]interface{}{"a": map[string]interface{}{"x": 1.5, "y": true}, "b": []int{1, 2}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"matched": true}`, string(response))
+}
+
+func TestNewCassetteReplayRequiresFile(t *testing.T) {
+	_, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteModeReplay)
+	assert.Error(t, err)
+
+	cassette, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, CassetteModeRecord, cassette.Mode())
+}
@@ -2,6 +2,9 @@ package assets
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +12,8 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/atlanhq/atlan-go/config"
 
@@ -19,51 +24,72 @@ import (
 
 // AtlanClient defines the Atlan API client structure.
 type AtlanClient struct {
-	Session        *http.Client
-	host           string
-	ApiKey         string
-	requestParams  map[string]interface{}
-	logger         logger.Logger
-	RoleClient     *RoleClient
-	GroupClient    *GroupClient
-	UserClient     *UserClient
-	TokenClient    *TokenClient
-	WorkflowClient *WorkflowClient
+	Session              *http.Client
+	host                 string
+	ApiKey               string
+	requestParams        map[string]interface{}
+	logger               logger.Logger
+	retryPolicy          *RetryPolicy
+	middlewares          []Middleware
+	telemetry            *telemetry
+	rateLimiter          *RateLimiter
+	pageFetchConcurrency int
+	cassette             *Cassette
+	caches               clientCaches
+	RoleClient           *RoleClient
+	GroupClient          *GroupClient
+	UserClient           *UserClient
+	TokenClient          *TokenClient
+	WorkflowClient       *WorkflowClient
+	LineageClient        *LineageClient
+	GlossaryClient       *GlossaryClient
 	SearchAssets
 }
 
+// clientCaches holds the lazily-created caches owned by a single AtlanClient,
+// so that clients for different tenants never share cached state.
+type clientCaches struct {
+	mutex          sync.Mutex
+	atlanTag       *AtlanTagCache
+	customMetadata *CustomMetadataCache
+	role           *RoleCache
+	group          *GroupCache
+	user           *UserCache
+}
+
 // DefaultAtlanClient represents the default AtlanClient instance.
 var (
 	DefaultAtlanClient   *AtlanClient
 	DefaultAtlanTagCache *AtlanTagCache
-	contentType          string
 )
 
 // Init initializes the default AtlanClient.
 func Init() error {
 	apiKey, baseURL := retrieveAPIConfig()
 
-	// Normalize the baseURL
-	baseURL = normalizeURL(baseURL)
-
-	// Configure client and logger
-	client, logger := configureClient()
-
 	// Initialize default AtlanClient
-	DefaultAtlanClient = &AtlanClient{
-		Session:       client,
-		host:          baseURL,
-		ApiKey:        apiKey,
-		requestParams: defaultRequestParams(apiKey),
-		logger:        *logger,
-		SearchAssets:  newDefaultSearchAssets(),
-	}
+	DefaultAtlanClient = newAtlanClient(baseURL, apiKey)
 
 	return nil
 }
 
 // Context creates a new AtlanClient with provided API key and base URL.
+// The returned client is independent of DefaultAtlanClient, so several clients
+// can be used side by side against different tenants. Use SetDefaultClient
+// to make it the client behind the package-level functions, and NewClient
+// to configure its HTTP transport.
 func Context(baseURL, apiKey string) (*AtlanClient, error) {
+	return NewClient(baseURL, apiKey)
+}
+
+// SetDefaultClient sets the client used by the package-level functions
+// (Save, Search, GetByGuid, the caches, ...).
+func SetDefaultClient(client *AtlanClient) {
+	DefaultAtlanClient = client
+}
+
+// newAtlanClient builds a fully wired AtlanClient for the given tenant.
+func newAtlanClient(baseURL, apiKey string) *AtlanClient {
 	// Normalize the baseURL
 	baseURL = normalizeURL(baseURL)
 
@@ -76,13 +102,20 @@ func Context(baseURL, apiKey string) (*AtlanClient, error) {
 		ApiKey:        apiKey,
 		requestParams: defaultRequestParams(apiKey),
 		logger:        *logger,
+		retryPolicy:   DefaultRetryPolicy(),
 		SearchAssets:  newDefaultSearchAssets(),
 	}
 
-	// Set as default AtlanClient
-	DefaultAtlanClient = atlanClient
+	// Bind the sub-clients to this client, rather than to the default one
+	atlanClient.RoleClient = NewRoleClient(atlanClient)
+	atlanClient.GroupClient = (*GroupClient)(atlanClient)
+	atlanClient.UserClient = (*UserClient)(atlanClient)
+	atlanClient.TokenClient = (*TokenClient)(atlanClient)
+	atlanClient.WorkflowClient = &WorkflowClient{AtlanClient: atlanClient}
+	atlanClient.LineageClient = &LineageClient{AtlanClient: atlanClient}
+	atlanClient.GlossaryClient = &GlossaryClient{AtlanClient: atlanClient}
 
-	return atlanClient, nil
+	return atlanClient
 }
 
 // NewContext initializes a new AtlanClient instance.
@@ -385,9 +418,27 @@ func (ac *AtlanClient) s3PresignedUrlFileDownload(api *API, downloadFilePath str
 
 // CallAPI makes a generic API call.
 func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj interface{}, options ...interface{}) ([]byte, error) {
+	return ac.CallAPIWithContext(context.Background(), api, queryParams, requestObj, options...)
+}
+
+// CallAPIWithContext makes a generic API call bound to the provided context.
+// The request is aborted as soon as the context is cancelled or its deadline expires,
+// in which case the context's error is returned.
+// When telemetry is enabled, the call is traced as a span named after the API.
+func (ac *AtlanClient) CallAPIWithContext(ctx context.Context, api *API, queryParams interface{}, requestObj interface{}, options ...interface{}) ([]byte, error) {
+	ctx, call := ac.telemetry.startAPICall(ctx, api)
+	response, err := ac.callAPI(ctx, call, api, queryParams, requestObj, options...)
+	call.end(err)
+	return response, err
+}
+
+// callAPI sends the API call, reporting its attempts and payload sizes to the call tracker.
+func (ac *AtlanClient) callAPI(ctx context.Context, call *apiCall, api *API, queryParams interface{}, requestObj interface{}, options ...interface{}) ([]byte, error) {
 	var saveFile bool
 	var filePath string
 	var fileProgressBar *progressbar.ProgressBar
+	var streamResponse func(io.Reader) error
+	var requestBody []byte
 	params := deepCopy(ac.requestParams)
 	path := ac.host + api.Endpoint.Atlas + api.Path
 
@@ -427,7 +478,11 @@ func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj int
 	}
 
 	if len(query) > 0 {
-		path += "?" + query.Encode()
+		separator := "?"
+		if strings.Contains(path, "?") {
+			separator = "&" // attr:qualifiedName is already in the path
+		}
+		path += separator + query.Encode()
 	}
 
 	// Check for extra any API call options
@@ -448,6 +503,9 @@ func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj int
 			if bar, ok := optMap["progress_bar"].(*progressbar.ProgressBar); ok {
 				fileProgressBar = bar
 			}
+			if stream, ok := optMap["stream_response"].(func(io.Reader) error); ok {
+				streamResponse = stream
+			}
 		}
 	}
 
@@ -468,13 +526,50 @@ func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj int
 				ac.logger.Errorf("error marshaling request object: %v", err)
 				return nil, fmt.Errorf("error marshaling request object: %v", err)
 			}
-			params["data"] = bytes.NewBuffer(requestJSON)
+			requestBody = requestJSON
+			call.recordRequest(len(requestJSON))
+		}
+	}
+	// Send the request within the rate limits, retrying transient failures according to the retry policy
+	var response *http.Response
+	var err error
+	for attempt := 1; ; attempt++ {
+		if requestBody != nil {
+			params["data"] = bytes.NewBuffer(requestBody)
+		}
+		if err := ac.rateLimiter.Wait(ctx, api.Class); err != nil {
+			return nil, err
+		}
+		response, err = ac.makeRequest(ctx, api.Method, path, params)
+		call.recordAttempt(response)
+		if ctx.Err() != nil {
+			break
+		}
+		policy := ac.retryPolicy
+		if _, isFile := requestObj.(*os.File); isFile {
+			// File uploads can't be replayed
+			policy = nil
+		}
+		delay, retry := policy.shouldRetry(api.Method, attempt, response, err)
+		if !retry {
+			break
+		}
+		if err != nil {
+			ac.logger.Infof("Retrying %s %s in %s (attempt %d of %d) after error: %v", api.Method, path, delay, attempt+1, policy.MaxAttempts, err)
+		} else {
+			ac.logger.Infof("Retrying %s %s in %s (attempt %d of %d) after HTTP status %d", api.Method, path, delay, attempt+1, policy.MaxAttempts, response.StatusCode)
+			io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+		if sleepErr := sleepWithContext(ctx, delay); sleepErr != nil {
+			return nil, sleepErr
 		}
 	}
-	// Send the request
-	response, err := ac.makeRequest(api.Method, path, params)
 	if err != nil {
-		return nil, handleApiError(response, err)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, handleApiError(response, nil, err)
 	}
 
 	ac.logHTTPStatus(response)
@@ -484,7 +579,7 @@ func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj int
 		body, readErr := io.ReadAll(response.Body)
 		if readErr != nil {
 			fmt.Printf("Error reading response body: %v\n", readErr)
-			return nil, handleApiError(response, fmt.Errorf("error reading response body: %v", readErr))
+			return nil, handleApiError(response, nil, fmt.Errorf("error reading response body: %v", readErr))
 		}
 
 		// Create a descriptive error if `err` is nil
@@ -494,7 +589,7 @@ func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj int
 			err = fmt.Errorf("%s", errorMessage)
 			//	fmt.Printf("Constructed error: %s\n", errorMessage) // Optional for debugging
 		}
-		return nil, handleApiError(response, err)
+		return nil, handleApiError(response, body, err)
 	}
 
 	// Handle file download
@@ -522,6 +617,21 @@ func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj int
 		return []byte{}, nil
 	}
 
+	// Handle streamed responses, decoded as they are read rather than loaded in memory
+	if streamResponse != nil {
+		defer response.Body.Close()
+		body := &countingReader{reader: response.Body}
+		err := streamResponse(body)
+		call.recordResponse(body.count)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, err
+		}
+		return []byte{}, nil
+	}
+
 	// Handle JSON response
 	responseJSON, err := io.ReadAll(response.Body)
 	if err != nil {
@@ -531,19 +641,20 @@ func (ac *AtlanClient) CallAPI(api *API, queryParams interface{}, requestObj int
 	// Finally, close the request body
 	response.Body.Close()
 
+	call.recordResponse(len(responseJSON))
 	ac.logResponse(responseJSON)
 
 	return responseJSON, nil
 }
 
 // makeRequest makes an HTTP request.
-func (ac *AtlanClient) makeRequest(method, path string, params map[string]interface{}) (*http.Response, error) {
+func (ac *AtlanClient) makeRequest(ctx context.Context, method, path string, params map[string]interface{}) (*http.Response, error) {
 	var req *http.Request
 	var err error
 
 	switch method {
 	case http.MethodGet:
-		req, err = http.NewRequest(method, path, nil)
+		req, err = http.NewRequestWithContext(ctx, method, path, nil)
 		if err != nil {
 			return nil, ThrowAtlanError(err, CONNECTION_ERROR, nil)
 		}
@@ -563,7 +674,7 @@ func (ac *AtlanClient) makeRequest(method, path string, params map[string]interf
 		default:
 			return nil, fmt.Errorf("invalid 'data' parameter type for POST/PUT request")
 		}
-		req, err = http.NewRequest(method, path, body)
+		req, err = http.NewRequestWithContext(ctx, method, path, body)
 		if err != nil {
 			return nil, ThrowAtlanError(err, CONNECTION_ERROR, nil)
 		}
@@ -577,7 +688,7 @@ func (ac *AtlanClient) makeRequest(method, path string, params map[string]interf
 			}
 		}
 		// Create a new http request
-		req, err = http.NewRequest(method, path, body)
+		req, err = http.NewRequestWithContext(ctx, method, path, body)
 		if err != nil {
 			return nil, ThrowAtlanError(err, CONNECTION_ERROR, nil)
 		}
@@ -599,13 +710,15 @@ func (ac *AtlanClient) makeRequest(method, path string, params map[string]interf
 			req.Header.Set(key, value)
 		}
 	}
+	// Tag every request with an ID, so that failures can be correlated with Atlan's logs
+	if req.Header.Get(RequestIDHeader) == "" {
+		req.Header.Set(RequestIDHeader, newRequestID())
+	}
 
 	// Set content-type
+	contentType := "application/json"
 	if ct, ok := params["content_type"].(string); ok {
 		contentType = ct
-	} else {
-		// Default content type
-		contentType = "application/json"
 	}
 	req.Header.Set("Content-Type", contentType)
 
@@ -641,8 +754,8 @@ func (ac *AtlanClient) makeRequest(method, path string, params map[string]interf
 	}
 
 	ac.logAPICall(req.Method, path, req)
-	// Finally, execute the request
-	return ac.Session.Do(req)
+	// Finally, execute the request through the middleware chain
+	return ac.send(req)
 }
 
 func (ac *AtlanClient) logAPICall(method, path string, request *http.Request) {
@@ -660,6 +773,19 @@ func (ac *AtlanClient) logResponse(responseJSON []byte) {
 	ac.logger.Debugf("<== __call_api %s", string(responseJSON))
 }
 
+// sleepWithContext pauses for the given duration, returning early
+// with the context's error if it is cancelled in the meantime.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func deepCopy(original map[string]interface{}) map[string]interface{} {
 	dcopy := make(map[string]interface{})
 	for key, value := range original {
@@ -667,3 +793,22 @@ func deepCopy(original map[string]interface{}) map[string]interface{} {
 	}
 	return dcopy
 }
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	reader io.Reader
+	count  int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += n
+	return n, err
+}
+
+// newRequestID returns a random ID to identify an API request.
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
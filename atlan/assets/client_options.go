@@ -0,0 +1,271 @@
+package assets
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestHandler sends a single HTTP request to Atlan and returns its response.
+type RequestHandler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RequestHandler to add behavior around every HTTP request
+// made by an AtlanClient, such as custom auth headers, tracing or metrics.
+type Middleware func(next RequestHandler) RequestHandler
+
+// ClientOption configures an AtlanClient created through NewClient.
+type ClientOption func(*clientOptions) error
+
+// clientOptions holds the configuration collected from the ClientOption values.
+type clientOptions struct {
+	httpClient           *http.Client
+	transport            http.RoundTripper
+	timeout              time.Duration
+	connectTimeout       time.Duration
+	proxy                func(*http.Request) (*url.URL, error)
+	tlsConfig            *tls.Config
+	caCertificates       [][]byte
+	certificates         []tls.Certificate
+	middlewares          []Middleware
+	retryPolicy          *RetryPolicy
+	retryPolicySet       bool
+	rateLimiter          *RateLimiter
+	pageFetchConcurrency int
+	cassette             *Cassette
+	tracerProvider       trace.TracerProvider
+	meterProvider        metric.MeterProvider
+}
+
+// WithHTTPClient uses the provided http.Client as-is to send requests.
+// It can't be combined with the other transport-level options.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(o *clientOptions) error {
+		if client == nil {
+			return fmt.Errorf("http client cannot be nil")
+		}
+		o.httpClient = client
+		return nil
+	}
+}
+
+// WithTransport uses the provided http.RoundTripper to send requests.
+// It can't be combined with the proxy, TLS or connect timeout options, which configure the default transport.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(o *clientOptions) error {
+		if transport == nil {
+			return fmt.Errorf("transport cannot be nil")
+		}
+		o.transport = transport
+		return nil
+	}
+}
+
+// WithTimeout sets the overall time limit for a single request, including reading the response body.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) error {
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithConnectTimeout sets the time limit for establishing a connection to Atlan.
+func WithConnectTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) error {
+		o.connectTimeout = timeout
+		return nil
+	}
+}
+
+// WithProxy sends every request through the proxy at the given URL.
+func WithProxy(proxyURL string) ClientOption {
+	return func(o *clientOptions) error {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		o.proxy = http.ProxyURL(parsed)
+		return nil
+	}
+}
+
+// WithTLSConfig uses the provided TLS configuration for connections to Atlan.
+// CA bundles and client certificates added through other options are merged into it: the CA
+// certificates are added to a copy of its RootCAs, when it has any, or else to the system ones.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(o *clientOptions) error {
+		o.tlsConfig = config.Clone()
+		return nil
+	}
+}
+
+// WithCACertificates trusts the PEM-encoded CA certificates, in addition to the system ones.
+func WithCACertificates(pemCerts []byte) ClientOption {
+	return func(o *clientOptions) error {
+		if !x509.NewCertPool().AppendCertsFromPEM(pemCerts) {
+			return fmt.Errorf("no valid PEM certificates found in CA bundle")
+		}
+		o.caCertificates = append(o.caCertificates, pemCerts)
+		return nil
+	}
+}
+
+// WithCABundle trusts the CA certificates in the PEM file at the given path, in addition to the system ones.
+func WithCABundle(path string) ClientOption {
+	return func(o *clientOptions) error {
+		pemCerts, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+		return WithCACertificates(pemCerts)(o)
+	}
+}
+
+// WithClientCertificate presents the given certificate and key files to Atlan, for mutual TLS.
+func WithClientCertificate(certFile, keyFile string) ClientOption {
+	return func(o *clientOptions) error {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		o.certificates = append(o.certificates, certificate)
+		return nil
+	}
+}
+
+// WithMiddleware adds middlewares around every HTTP request made by the client.
+// Middlewares run in the order they are added: the first one sees the request first
+// and the response last.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(o *clientOptions) error {
+		o.middlewares = append(o.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client. Passing nil disables retries.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(o *clientOptions) error {
+		o.retryPolicy = policy
+		o.retryPolicySet = true
+		return nil
+	}
+}
+
+// NewClient creates a new AtlanClient for the given tenant, configured by the provided options.
+// Like Context, it does not change DefaultAtlanClient.
+func NewClient(baseURL, apiKey string, opts ...ClientOption) (*AtlanClient, error) {
+	options := &clientOptions{}
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, err
+		}
+	}
+
+	session, err := options.buildHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	atlanClient := newAtlanClient(baseURL, apiKey)
+	atlanClient.Session = session
+	atlanClient.middlewares = options.middlewares
+	atlanClient.rateLimiter = options.rateLimiter
+	atlanClient.pageFetchConcurrency = options.pageFetchConcurrency
+	atlanClient.cassette = options.cassette
+	if options.retryPolicySet {
+		atlanClient.retryPolicy = options.retryPolicy
+	}
+	if err := atlanClient.SetTelemetry(options.tracerProvider, options.meterProvider); err != nil {
+		return nil, err
+	}
+	return atlanClient, nil
+}
+
+// Use appends middlewares around every HTTP request made by the client,
+// after any middlewares that were already registered.
+func (ac *AtlanClient) Use(middlewares ...Middleware) {
+	ac.middlewares = append(ac.middlewares, middlewares...)
+}
+
+// send runs the request through the middleware chain and finally the HTTP session,
+// or the cassette when one is in use.
+func (ac *AtlanClient) send(req *http.Request) (*http.Response, error) {
+	handler := RequestHandler(ac.Session.Do)
+	if ac.cassette != nil {
+		handler = ac.cassette.middleware(handler)
+	}
+	for i := len(ac.middlewares) - 1; i >= 0; i-- {
+		handler = ac.middlewares[i](handler)
+	}
+	return handler(req)
+}
+
+// buildHTTPClient creates the http.Client described by the options.
+func (o *clientOptions) buildHTTPClient() (*http.Client, error) {
+	customTransport := o.proxy != nil || o.tlsConfig != nil || len(o.caCertificates) > 0 || len(o.certificates) > 0 || o.connectTimeout > 0
+
+	if o.httpClient != nil {
+		if o.transport != nil || customTransport || o.timeout > 0 {
+			return nil, fmt.Errorf("WithHTTPClient cannot be combined with other transport options")
+		}
+		return o.httpClient, nil
+	}
+	if o.transport != nil && customTransport {
+		return nil, fmt.Errorf("WithTransport cannot be combined with proxy, TLS or connect timeout options")
+	}
+
+	client := &http.Client{Timeout: o.timeout}
+	if o.transport != nil {
+		client.Transport = o.transport
+		return client, nil
+	}
+	if !customTransport {
+		return client, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if o.proxy != nil {
+		transport.Proxy = o.proxy
+	}
+	if o.connectTimeout > 0 {
+		dialer := &net.Dialer{Timeout: o.connectTimeout, KeepAlive: 30 * time.Second}
+		transport.DialContext = dialer.DialContext
+	}
+	if o.tlsConfig != nil || len(o.caCertificates) > 0 || len(o.certificates) > 0 {
+		tlsConfig := o.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		if len(o.caCertificates) > 0 {
+			tlsConfig.RootCAs = o.rootCAs(tlsConfig.RootCAs)
+		}
+		tlsConfig.Certificates = append(tlsConfig.Certificates, o.certificates...)
+		transport.TLSClientConfig = tlsConfig
+	}
+	client.Transport = transport
+	return client, nil
+}
+
+// rootCAs returns the pool of CA certificates to trust: a copy of the given pool, or else of the
+// system one, with the CA certificates of the options added.
+func (o *clientOptions) rootCAs(pool *x509.CertPool) *x509.CertPool {
+	if pool != nil {
+		pool = pool.Clone()
+	} else if system, err := x509.SystemCertPool(); err == nil && system != nil {
+		pool = system
+	} else {
+		pool = x509.NewCertPool()
+	}
+	for _, pemCerts := range o.caCertificates {
+		pool.AppendCertsFromPEM(pemCerts)
+	}
+	return pool
+}
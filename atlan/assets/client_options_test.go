@@ -0,0 +1,181 @@
+package assets
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var testEndpointAPI = &API{
+	Method:   http.MethodGet,
+	Endpoint: Endpoint{Atlas: "/test"},
+	Path:     "/endpoint",
+	Status:   http.StatusOK,
+}
+
+func TestNewClientMiddlewareOrder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "first,second", r.Header.Get("X-Trace"))
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	var calls []string
+	tracing := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, "before "+name)
+				if trace := req.Header.Get("X-Trace"); trace != "" {
+					req.Header.Set("X-Trace", trace+","+name)
+				} else {
+					req.Header.Set("X-Trace", name)
+				}
+				resp, err := next(req)
+				calls = append(calls, "after "+name)
+				return resp, err
+			}
+		}
+	}
+
+	client, err := NewClient(ts.URL, "api_key", WithMiddleware(tracing("first")))
+	require.NoError(t, err)
+	client.Use(tracing("second"))
+
+	_, err = client.CallAPI(testEndpointAPI, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"before first", "before second", "after second", "after first"}, calls)
+}
+
+func TestNewClientWithTransport(t *testing.T) {
+	var seen *http.Request
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		seen = req
+		rec := httptest.NewRecorder()
+		rec.Write([]byte(`{"ok": true}`))
+		return rec.Result(), nil
+	})
+
+	client, err := NewClient("tenant.atlan.com", "api_key", WithTransport(transport), WithTimeout(5*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, client.Session.Timeout)
+
+	response, err := client.CallAPI(testEndpointAPI, nil, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ok": true}`, string(response))
+	require.NotNil(t, seen)
+	assert.Equal(t, "https://tenant.atlan.com/test/endpoint", seen.URL.String())
+	assert.Equal(t, "Bearer api_key", seen.Header.Get("Authorization"))
+}
+
+func TestNewClientWithCACertificates(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	// Without the server's CA, the TLS handshake fails
+	untrusted, err := NewClient(ts.URL, "api_key", WithRetryPolicy(nil))
+	require.NoError(t, err)
+	_, err = untrusted.CallAPI(testEndpointAPI, nil, nil)
+	require.Error(t, err)
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	trusted, err := NewClient(ts.URL, "api_key", WithCACertificates(caPEM), WithConnectTimeout(time.Second))
+	require.NoError(t, err)
+	_, err = trusted.CallAPI(testEndpointAPI, nil, nil)
+	require.NoError(t, err)
+}
+
+// newTLSServerWithCertificate starts a TLS server with its own self-signed certificate, unlike
+// httptest.NewTLSServer whose servers all share the same one, and returns it with the certificate
+// in PEM.
+func newTLSServerWithCertificate(t *testing.T, handler http.Handler) (*httptest.Server, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	ts := httptest.NewUnstartedServer(handler)
+	ts.TLS = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	ts.StartTLS()
+	return ts, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestNewClientMergesCACertificatesIntoTheTLSConfig(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	first := httptest.NewTLSServer(handler)
+	defer first.Close()
+	second, caPEM := newTLSServerWithCertificate(t, handler)
+	defer second.Close()
+
+	// The pool of the TLS config trusts the first server, the CA certificates the second
+	pool := x509.NewCertPool()
+	pool.AddCert(first.Certificate())
+	original := pool.Clone()
+	for _, ts := range []*httptest.Server{first, second} {
+		client, err := NewClient(ts.URL, "api_key", WithCACertificates(caPEM), WithTLSConfig(&tls.Config{RootCAs: pool}), WithRetryPolicy(nil))
+		require.NoError(t, err)
+		_, err = client.CallAPI(testEndpointAPI, nil, nil)
+		require.NoError(t, err, ts.URL)
+	}
+	assert.True(t, pool.Equal(original), "the pool of the TLS config is left as it was")
+}
+
+func TestNewClientInvalidOptions(t *testing.T) {
+	_, err := NewClient("tenant.atlan.com", "api_key", WithCACertificates([]byte("not a certificate")))
+	assert.Error(t, err)
+
+	_, err = NewClient("tenant.atlan.com", "api_key", WithClientCertificate("missing.crt", "missing.key"))
+	assert.Error(t, err)
+
+	_, err = NewClient("tenant.atlan.com", "api_key", WithCABundle("missing.pem"))
+	assert.Error(t, err)
+
+	_, err = NewClient("tenant.atlan.com", "api_key", WithTransport(http.DefaultTransport), WithProxy("http://proxy:8080"))
+	assert.Error(t, err)
+
+	_, err = NewClient("tenant.atlan.com", "api_key", WithHTTPClient(&http.Client{}), WithTimeout(time.Second))
+	assert.Error(t, err)
+}
+
+func TestNewClientWithProxy(t *testing.T) {
+	client, err := NewClient("tenant.atlan.com", "api_key", WithProxy("http://proxy.internal:8080"))
+	require.NoError(t, err)
+
+	transport, ok := client.Session.Transport.(*http.Transport)
+	require.True(t, ok)
+	req, _ := http.NewRequest(http.MethodGet, "https://tenant.atlan.com", nil)
+	proxyURL, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.internal:8080", proxyURL.String())
+}
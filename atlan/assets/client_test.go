@@ -2,12 +2,17 @@ package assets
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/atlanhq/atlan-go/atlan/model"
 	"github.com/stretchr/testify/require"
 
 	"github.com/stretchr/testify/assert"
@@ -150,3 +155,146 @@ func TestCallAPI(t *testing.T) {
 	// Compare expected and actual responses
 	assert.Equal(t, expectedResponse, actualResponse)
 }
+
+func TestCallAPIWithContextDeadline(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hold the request until the test is done
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	client, _ := Context(ts.URL, "api_key")
+
+	api := &API{
+		Method:   http.MethodGet,
+		Endpoint: Endpoint{Atlas: "/test"},
+		Path:     "/endpoint",
+		Status:   http.StatusOK,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.CallAPIWithContext(ctx, api, nil, nil)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestSearchWithContextCancelStopsIteration(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"queryType": "INDEX", "searchParameters": {}, "approximateCount": 100, "entities": [{"typeName": "Table", "guid": "1"}, {"typeName": "Table", "guid": "2"}]}`))
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request := model.IndexSearchRequest{Dsl: model.Dsl{Size: 2}}
+	iterator, err := client.SearchWithContext(ctx, request)
+	require.NoError(t, err)
+
+	assetsCh, errCh := iterator.Iter()
+	first := <-assetsCh
+	require.NotNil(t, first)
+	assert.Equal(t, "1", *first.Guid)
+
+	cancel()
+
+	err = <-errCh
+	assert.True(t, errors.Is(err, context.Canceled))
+	// Only the first page was fetched before the context was cancelled
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestContextDoesNotReplaceDefaultClient(t *testing.T) {
+	original := DefaultAtlanClient
+	defer SetDefaultClient(original)
+
+	defaultClient, _ := Context("default.atlan.com", "default_key")
+	SetDefaultClient(defaultClient)
+
+	other, _ := Context("other.atlan.com", "other_key")
+	assert.Same(t, defaultClient, DefaultAtlanClient)
+	assert.NotSame(t, other, DefaultAtlanClient)
+}
+
+func TestClientsAreIsolatedAcrossTenants(t *testing.T) {
+	newTenant := func(requests *int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(requests, 1)
+			w.Write([]byte(`{"mutatedEntities": {}, "guidAssignments": {}}`))
+		}))
+	}
+	var requestsA, requestsB int32
+	tenantA := newTenant(&requestsA)
+	defer tenantA.Close()
+	tenantB := newTenant(&requestsB)
+	defer tenantB.Close()
+
+	clientA, _ := Context(tenantA.URL, "key_a")
+	clientB, _ := Context(tenantB.URL, "key_b")
+
+	table := &Table{}
+	table.Creator("table", "default/snowflake/123/db/schema")
+
+	_, err := clientA.Save(table)
+	require.NoError(t, err)
+	_, err = clientB.Save(table)
+	require.NoError(t, err)
+	_, err = clientB.Save(table)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requestsA))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requestsB))
+
+	// Caches and sub-clients belong to their owning client
+	assert.NotSame(t, clientA.AtlanTagCache(), clientB.AtlanTagCache())
+	assert.Same(t, clientA.AtlanTagCache(), clientA.AtlanTagCache())
+	assert.NotSame(t, clientA.UserCache(), clientB.UserCache())
+	assert.Same(t, clientA, (*AtlanClient)(clientA.TokenClient))
+	assert.Same(t, clientB, clientB.WorkflowClient.AtlanClient)
+}
+
+func TestPackageFunctionsNeedADefaultClient(t *testing.T) {
+	original := DefaultAtlanClient
+	defer SetDefaultClient(original)
+	SetDefaultClient(nil)
+
+	_, err := Save(&Table{})
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	assert.ErrorIs(t, RemoveAtlanTag[*Table]("default/snowflake/123/db/schema/table", "PII"), errDefaultClientNotInitialized)
+	_, err = DeleteByGuid([]string{"guid"})
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = SearchWithContext(context.Background(), model.IndexSearchRequest{})
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = NewIndexSearchIterator(10, model.IndexSearchRequest{}).NextPage()
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = GetUserIDForName("ana")
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = (&TokenClient{}).Get(nil, nil, nil, false, 0)
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = (&LineageClient{}).Upstream("guid", 1)
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = (&GlossaryClient{}).GetTerm("guid")
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = GetAtlanTagCache()
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	assert.ErrorIs(t, RefreshCache(), errDefaultClientNotInitialized)
+	assert.ErrorIs(t, RefreshCustomMetadataCache(), errDefaultClientNotInitialized)
+	_, err = GetAttributeDef("attr")
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = GetCustomMetadataIDforName("Governance")
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+	_, err = NewCustomMetadataField("Governance", "owner")
+	assert.ErrorIs(t, err, errDefaultClientNotInitialized)
+}
@@ -23,6 +23,9 @@ const (
 	// Files API
 	FILES_API = "files/"
 
+	// Lineage API
+	LINEAGE_API = "lineage/"
+
 	// Users API
 	USER_API = "users"
 
@@ -49,9 +52,11 @@ const (
 
 // API defines the structure of an API call.
 type API struct {
+	Name     string
 	Path     string
 	Method   string
 	Status   int
+	Class    EndpointClass
 	Endpoint Endpoint
 	Consumes string
 	Produces string
@@ -72,6 +77,7 @@ var HeraclesEndpoint = Endpoint{
 // API calls to various services (Atlas, Heracles etc)
 var (
 	GET_TYPEDEF_BY_NAME = API{
+		Name:     "GET_TYPEDEF_BY_NAME",
 		Path:     TYPEDEF_BY_NAME,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
@@ -79,6 +85,7 @@ var (
 	}
 
 	GET_TYPEDEF_BY_GUID = API{
+		Name:     "GET_TYPEDEF_BY_GUID",
 		Path:     TYPEDEF_BY_GUID,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
@@ -86,6 +93,7 @@ var (
 	}
 
 	GET_ALL_TYPE_DEFS = API{
+		Name:     "GET_ALL_TYPE_DEFS",
 		Path:     TYPEDEFS_API,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
@@ -93,6 +101,7 @@ var (
 	}
 
 	GET_ALL_TYPE_DEF_HEADERS = API{
+		Name:     "GET_ALL_TYPE_DEF_HEADERS",
 		Path:     TYPEDEFS_API + "headers",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
@@ -100,6 +109,7 @@ var (
 	}
 
 	UPDATE_TYPE_DEFS = API{
+		Name:     "UPDATE_TYPE_DEFS",
 		Path:     TYPEDEFS_API,
 		Method:   http.MethodPut,
 		Status:   http.StatusOK,
@@ -107,6 +117,7 @@ var (
 	}
 
 	CREATE_TYPE_DEFS = API{
+		Name:     "CREATE_TYPE_DEFS",
 		Path:     TYPEDEFS_API,
 		Method:   http.MethodPut,
 		Status:   http.StatusOK,
@@ -114,6 +125,7 @@ var (
 	}
 
 	DELETE_TYPE_DEFS = API{
+		Name:     "DELETE_TYPE_DEFS",
 		Path:     TYPEDEFS_API,
 		Method:   http.MethodDelete,
 		Status:   http.StatusNoContent,
@@ -121,6 +133,7 @@ var (
 	}
 
 	DELETE_TYPE_DEF_BY_NAME = API{
+		Name:     "DELETE_TYPE_DEF_BY_NAME",
 		Path:     TYPEDEF_BY_NAME,
 		Method:   http.MethodDelete,
 		Status:   http.StatusNoContent,
@@ -128,48 +141,88 @@ var (
 	}
 
 	GET_ENTITY_BY_GUID = API{
+		Name:     "GET_ENTITY_BY_GUID",
 		Path:     ENTITY_API + "guid/",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	GET_ENTITY_BY_UNIQUE_ATTRIBUTE = API{
+		Name:     "GET_ENTITY_BY_UNIQUE_ATTRIBUTE",
 		Path:     ENTITY_API + "uniqueAttribute/type/",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassEntity,
+		Endpoint: AtlasEndpoint,
+	}
+
+	ADD_BUSINESS_ATTRIBUTE_BY_ID = API{
+		Name:     "ADD_BUSINESS_ATTRIBUTE_BY_ID",
+		Path:     ENTITY_API + "guid/",
+		Method:   http.MethodPost,
+		Status:   http.StatusNoContent,
+		Class:    EndpointClassEntity,
+		Endpoint: AtlasEndpoint,
+	}
+
+	REMOVE_BUSINESS_ATTRIBUTE_BY_ID = API{
+		Name:     "REMOVE_BUSINESS_ATTRIBUTE_BY_ID",
+		Path:     ENTITY_API + "guid/",
+		Method:   http.MethodDelete,
+		Status:   http.StatusNoContent,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	INDEX_SEARCH = API{
+		Name:     "INDEX_SEARCH",
 		Path:     "search/indexsearch/",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassSearch,
+		Endpoint: AtlasEndpoint,
+	}
+
+	GET_LINEAGE_LIST = API{
+		Name:     "GET_LINEAGE_LIST",
+		Path:     LINEAGE_API + "list",
+		Method:   http.MethodPost,
+		Status:   http.StatusOK,
+		Class:    EndpointClassSearch,
 		Endpoint: AtlasEndpoint,
 	}
 
 	CREATE_ENTITY = API{
+		Name:     "CREATE_ENTITY",
 		Path:     ENTITY_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	CREATE_ENTITIES = API{
+		Name:     "CREATE_ENTITIES",
 		Path:     ENTITY_BULK_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	DELETE_ENTITIES_BY_GUIDS = API{
+		Name:     "DELETE_ENTITIES_BY_GUIDS",
 		Path:     ENTITY_BULK_API,
 		Method:   http.MethodDelete,
 		Status:   http.StatusOK,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	PRESIGNED_URL = API{
+		Name:     "PRESIGNED_URL",
 		Path:     FILES_API + "presignedUrl",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
@@ -177,254 +230,322 @@ var (
 	}
 
 	UPDATE_ENTITY_BY_ATTRIBUTE = API{
+		Name:     "UPDATE_ENTITY_BY_ATTRIBUTE",
 		Path:     ENTITY_API + "uniqueAttribute/type/",
 		Method:   http.MethodPost,
 		Status:   http.StatusNoContent,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	PARTIAL_UPDATE_ENTITY_BY_ATTRIBUTE = API{
+		Name:     "PARTIAL_UPDATE_ENTITY_BY_ATTRIBUTE",
 		Path:     ENTITY_API + "uniqueAttribute/type/",
 		Method:   http.MethodPut,
 		Status:   http.StatusOK,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	DELETE_ENTITY_BY_ATTRIBUTE = API{
+		Name:     "DELETE_ENTITY_BY_ATTRIBUTE",
 		Path:     ENTITY_API + "uniqueAttribute/type/",
 		Method:   http.MethodDelete,
 		Status:   http.StatusNoContent,
+		Class:    EndpointClassEntity,
 		Endpoint: AtlasEndpoint,
 	}
 
 	// Users API
 
 	CREATE_USERS = API{
+		Name:     "CREATE_USERS",
 		Path:     USER_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	GET_USERS = API{
+		Name:     "GET_USERS",
 		Path:     USER_API,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	UPDATE_USERS = API{
+		Name:     "UPDATE_USERS",
 		Path:     USER_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	DELETE_USER = API{
+		Name:     "DELETE_USER",
 		Path:     USER_API + "/%s/delete",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	GET_USER_GROUPS = API{
+		Name:     "GET_USER_GROUPS",
 		Path:     USER_API + "/%s/groups",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	ADD_USER_TO_GROUPS = API{
+		Name:     "ADD_USER_TO_GROUPS",
 		Path:     USER_API + "/%s/groups",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	CHANGE_USER_ROLE = API{
+		Name:     "CHANGE_USER_ROLE",
 		Path:     USER_API + "/%s/roles/update",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	GET_CURRENT_USER = API{
+		Name:     "GET_CURRENT_USER",
 		Path:     USER_API + "/current",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	// Roles APIs
 
 	GET_ROLES = API{
+		Name:     "GET_ROLES",
 		Path:     ROLES_API,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	// Group APIs
 
 	GET_GROUPS = API{
+		Name:     "GET_GROUPS",
 		Path:     GROUP_API,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	CREATE_GROUP = API{
+		Name:     "CREATE_GROUP",
 		Path:     GROUP_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	UPDATE_GROUP = API{
+		Name:     "UPDATE_GROUP",
 		Path:     GROUP_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	DELETE_GROUP = API{
+		Name:     "DELETE_GROUP",
 		Path:     GROUP_API + "/%s/delete",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	GET_GROUP_MEMBERS = API{
+		Name:     "GET_GROUP_MEMBERS",
 		Path:     GROUP_API + "/%s/members",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	REMOVE_USERS_FROM_GROUP = API{
+		Name:     "REMOVE_USERS_FROM_GROUP",
 		Path:     GROUP_API + "/%s/members/remove",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	// Token APIs
 
 	GET_API_TOKENS = API{
+		Name:     "GET_API_TOKENS",
 		Path:     TOKENS_API,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	UPSERT_API_TOKEN = API{
+		Name:     "UPSERT_API_TOKEN",
 		Path:     TOKENS_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	DELETE_API_TOKEN = API{
+		Name:     "DELETE_API_TOKEN",
 		Path:     TOKENS_API,
 		Method:   http.MethodDelete,
 		Status:   http.StatusOK,
+		Class:    EndpointClassAdmin,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	// Workflows
 
 	SCHEDULE_QUERY_WORKFLOWS_SEARCH = API{
+		Name:     "SCHEDULE_QUERY_WORKFLOWS_SEARCH",
 		Path:     SCHEDULE_QUERY_WORKFLOWS_SEARCH_API,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	SCHEDULE_QUERY_WORKFLOWS_MISSED = API{
+		Name:     "SCHEDULE_QUERY_WORKFLOWS_MISSED",
 		Path:     SCHEDULE_QUERY_WORKFLOWS_MISSED_API,
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	WORKFLOW_INDEX_SEARCH = API{
+		Name:     "WORKFLOW_INDEX_SEARCH",
 		Path:     WORKFLOW_INDEX_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	WORKFLOW_INDEX_RUN_SEARCH = API{
+		Name:     "WORKFLOW_INDEX_RUN_SEARCH",
 		Path:     WORKFLOW_INDEX_RUN_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	// triggers a workflow using the current user's credentials
 
 	WORKFLOW_RERUN = API{
+		Name:     "WORKFLOW_RERUN",
 		Path:     WORKFLOW_RUN_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	// triggers a workflow using the workflow owner's credentials
 
 	WORKFLOW_OWNER_RERUN = API{
+		Name:     "WORKFLOW_OWNER_RERUN",
 		Path:     WORKFLOW_OWNER_RERUN_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	WORKFLOW_UPDATE = API{
+		Name:     "WORKFLOW_UPDATE",
 		Path:     WORKFLOW_API + "/%s",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	WORKFLOW_ARCHIVE = API{
+		Name:     "WORKFLOW_ARCHIVE",
 		Path:     WORKFLOW_API + "/%s/archive",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	GET_ALL_SCHEDULE_RUNS = API{
+		Name:     "GET_ALL_SCHEDULE_RUNS",
 		Path:     WORKFLOW_SCHEDULE_RUN + "/cron",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	GET_SCHEDULE_RUN = API{
+		Name:     "GET_SCHEDULE_RUN",
 		Path:     WORKFLOW_SCHEDULE_RUN + "/cron/%s",
 		Method:   http.MethodGet,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	STOP_WORKFLOW_RUN = API{
+		Name:     "STOP_WORKFLOW_RUN",
 		Path:     WORKFLOW_SCHEDULE_RUN + "/%s/stop",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	WORKFLOW_CHANGE_OWNER = API{
+		Name:     "WORKFLOW_CHANGE_OWNER",
 		Path:     WORKFLOW_API + "/%s/changeownership",
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 
 	WORKFLOW_RUN = API{
+		Name:     "WORKFLOW_RUN",
 		Path:     WORKFLOW_RUN_API,
 		Method:   http.MethodPost,
 		Status:   http.StatusOK,
+		Class:    EndpointClassWorkflow,
 		Endpoint: HeraclesEndpoint,
 	}
 )
@@ -479,9 +600,11 @@ func (api *API) FormatPathWithParams(params ...string) (*API, error) {
 
 	// Return a new API object with the formatted path
 	return &API{
+		Name:     api.Name,
 		Path:     requestPath,
 		Method:   api.Method,
 		Status:   api.Status,
+		Class:    api.Class,
 		Endpoint: api.Endpoint,
 		Consumes: api.Consumes,
 		Produces: api.Produces,
@@ -0,0 +1,209 @@
+package assets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+)
+
+// GetCustomMetadata retrieves the attributes of the custom metadata set with the given name on the
+// asset with the given GUID, by the names of the attributes.
+func GetCustomMetadata(guid, setName string) (map[string]interface{}, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.GetCustomMetadata(guid, setName)
+}
+
+// GetCustomMetadata retrieves the attributes of the custom metadata set with the given name on the
+// asset with the given GUID, by the names of the attributes.
+func (ac *AtlanClient) GetCustomMetadata(guid, setName string) (map[string]interface{}, error) {
+	return ac.GetCustomMetadataWithContext(context.Background(), guid, setName)
+}
+
+// GetCustomMetadataWithContext is like GetCustomMetadata, but bound to the provided context.
+func (ac *AtlanClient) GetCustomMetadataWithContext(ctx context.Context, guid, setName string) (map[string]interface{}, error) {
+	if _, err := ac.CustomMetadataCache().GetIDForName(setName); err != nil {
+		return nil, err
+	}
+	object, err := ac.GetEntityByGuidWithContext(ctx, guid)
+	if err != nil {
+		return nil, err
+	}
+	return object.GetEntity().GetCustomMetadata(setName), nil
+}
+
+// UpdateCustomMetadata sets the given attributes of the custom metadata set with the given name on
+// the asset with the given GUID, leaving the other attributes of the set as they are.
+func UpdateCustomMetadata(guid, setName string, attributes map[string]interface{}) error {
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.UpdateCustomMetadata(guid, setName, attributes)
+}
+
+// UpdateCustomMetadata sets the given attributes of the custom metadata set with the given name on
+// the asset with the given GUID, leaving the other attributes of the set as they are.
+func (ac *AtlanClient) UpdateCustomMetadata(guid, setName string, attributes map[string]interface{}) error {
+	return ac.UpdateCustomMetadataWithContext(context.Background(), guid, setName, attributes)
+}
+
+// UpdateCustomMetadataWithContext is like UpdateCustomMetadata, but bound to the provided context.
+func (ac *AtlanClient) UpdateCustomMetadataWithContext(ctx context.Context, guid, setName string, attributes map[string]interface{}) error {
+	return ac.saveCustomMetadataSet(ctx, guid, setName, attributes, false)
+}
+
+// ReplaceCustomMetadata replaces the attributes of the custom metadata set with the given name on
+// the asset with the given GUID: the attributes of the set that aren't given are removed.
+func ReplaceCustomMetadata(guid, setName string, attributes map[string]interface{}) error {
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.ReplaceCustomMetadata(guid, setName, attributes)
+}
+
+// ReplaceCustomMetadata replaces the attributes of the custom metadata set with the given name on
+// the asset with the given GUID: the attributes of the set that aren't given are removed.
+func (ac *AtlanClient) ReplaceCustomMetadata(guid, setName string, attributes map[string]interface{}) error {
+	return ac.ReplaceCustomMetadataWithContext(context.Background(), guid, setName, attributes)
+}
+
+// ReplaceCustomMetadataWithContext is like ReplaceCustomMetadata, but bound to the provided context.
+func (ac *AtlanClient) ReplaceCustomMetadataWithContext(ctx context.Context, guid, setName string, attributes map[string]interface{}) error {
+	return ac.saveCustomMetadataSet(ctx, guid, setName, attributes, true)
+}
+
+// RemoveCustomMetadata removes every attribute of the custom metadata set with the given name from
+// the asset with the given GUID.
+func RemoveCustomMetadata(guid, setName string) error {
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.RemoveCustomMetadata(guid, setName)
+}
+
+// RemoveCustomMetadata removes every attribute of the custom metadata set with the given name from
+// the asset with the given GUID.
+func (ac *AtlanClient) RemoveCustomMetadata(guid, setName string) error {
+	return ac.RemoveCustomMetadataWithContext(context.Background(), guid, setName)
+}
+
+// RemoveCustomMetadataWithContext is like RemoveCustomMetadata, but bound to the provided context.
+// The attributes are removed through the endpoint that deletes the business metadata of the set.
+func (ac *AtlanClient) RemoveCustomMetadataWithContext(ctx context.Context, guid, setName string) error {
+	if guid == "" {
+		return errors.New("guid is a required field")
+	}
+	cache := ac.CustomMetadataCache()
+	setID, err := cache.GetIDForName(setName)
+	if err != nil {
+		return err
+	}
+	businessAttributes := make(map[string]interface{})
+	cache.mutex.RLock()
+	for attrID := range cache.MapAttrIDToName[setID] {
+		businessAttributes[attrID] = nil
+	}
+	cache.mutex.RUnlock()
+
+	api, err := REMOVE_BUSINESS_ATTRIBUTE_BY_ID.FormatPathWithParams(guid, "businessmetadata", setID)
+	if err != nil {
+		return err
+	}
+	_, err = ac.CallAPIWithContext(ctx, api, nil, businessAttributes)
+	return err
+}
+
+// saveCustomMetadataSet saves the attributes of a custom metadata set on an asset through the
+// business metadata endpoint of the set. When replacing, the attributes that aren't given are
+// sent as null, which removes them.
+func (ac *AtlanClient) saveCustomMetadataSet(ctx context.Context, guid, setName string, attributes map[string]interface{}, replace bool) error {
+	if guid == "" {
+		return errors.New("guid is a required field")
+	}
+	cache := ac.CustomMetadataCache()
+	setID, err := cache.GetIDForName(setName)
+	if err != nil {
+		return err
+	}
+	businessAttributes := make(map[string]interface{})
+	if replace {
+		cache.mutex.RLock()
+		for attrID := range cache.MapAttrIDToName[setID] {
+			businessAttributes[attrID] = nil
+		}
+		cache.mutex.RUnlock()
+	}
+	for attrName, value := range attributes {
+		attrID, err := cache.GetAttrIDForName(setName, attrName)
+		if err != nil {
+			return err
+		}
+		businessAttributes[attrID] = value
+	}
+
+	api, err := ADD_BUSINESS_ATTRIBUTE_BY_ID.FormatPathWithParams(guid, "businessmetadata", setID)
+	if err != nil {
+		return err
+	}
+	_, err = ac.CallAPIWithContext(ctx, api, nil, businessAttributes)
+	return err
+}
+
+// nameCustomMetadata sets the CustomMetadata of the entity from its BusinessAttributes,
+// translating the IDs of the sets and attributes into their human-readable names. Attributes
+// that have since been archived are left out.
+func (ac *AtlanClient) nameCustomMetadata(entity *entities.Entity) error {
+	if entity.BusinessAttributes == nil || len(*entity.BusinessAttributes) == 0 {
+		return nil
+	}
+	cache := ac.CustomMetadataCache()
+	for setID, attributes := range *entity.BusinessAttributes {
+		setName, err := cache.GetNameForID(setID)
+		if err != nil {
+			return err
+		}
+		named := make(map[string]interface{}, len(attributes))
+		for attrID, value := range attributes {
+			if attrName, err := cache.GetAttrNameForID(setID, attrID); err == nil {
+				named[attrName] = value
+			}
+		}
+		entity.SetCustomMetadata(setName, named)
+	}
+	return nil
+}
+
+// identifyCustomMetadata adds the CustomMetadata of the entity to its BusinessAttributes,
+// translating the human-readable names of the sets and attributes into their IDs, so that it
+// is saved along with the entity.
+func (ac *AtlanClient) identifyCustomMetadata(entity *entities.Entity) error {
+	if len(entity.CustomMetadata) == 0 {
+		return nil
+	}
+	cache := ac.CustomMetadataCache()
+	businessAttributes := make(map[string]map[string]interface{})
+	if entity.BusinessAttributes != nil {
+		for setID, attributes := range *entity.BusinessAttributes {
+			businessAttributes[setID] = attributes
+		}
+	}
+	for setName, attributes := range entity.CustomMetadata {
+		setID, err := cache.GetIDForName(setName)
+		if err != nil {
+			return err
+		}
+		identified := make(map[string]interface{}, len(attributes))
+		for attrName, value := range attributes {
+			attrID, err := cache.GetAttrIDForName(setName, attrName)
+			if err != nil {
+				return err
+			}
+			identified[attrID] = value
+		}
+		businessAttributes[setID] = identified
+	}
+	entity.BusinessAttributes = &businessAttributes
+	return nil
+}
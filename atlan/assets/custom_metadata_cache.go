@@ -29,7 +29,7 @@ type CustomMetadataCache struct {
 // NewCustomMetadataCache creates a new CustomMetadataCache instance.
 func NewCustomMetadataCache(atlanClient *AtlanClient) *CustomMetadataCache {
 	return &CustomMetadataCache{
-		AtlanClient:     DefaultAtlanClient,
+		AtlanClient:     atlanClient,
 		CacheByID:       make(map[string]model.CustomMetadataDef),
 		AttrCacheByID:   make(map[string]model.AttributeDef),
 		MapIDToName:     make(map[string]string),
@@ -40,42 +40,47 @@ func NewCustomMetadataCache(atlanClient *AtlanClient) *CustomMetadataCache {
 	}
 }
 
-var customMetadataCaches = make(map[string]*CustomMetadataCache)
-
-func RefreshCustomMetadataCache() {
-	GetCustomMetadataCache().RefreshCache()
+func RefreshCustomMetadataCache() error {
+	cache, err := GetCustomMetadataCache()
+	if err != nil {
+		return err
+	}
+	return cache.RefreshCache()
 }
 
-func GetAttributeDef(attrID string) model.AttributeDef {
-	attrdef, _ := GetCustomMetadataCache().GetAttributeDef(attrID)
-	return attrdef
+func GetAttributeDef(attrID string) (model.AttributeDef, error) {
+	cache, err := GetCustomMetadataCache()
+	if err != nil {
+		return model.AttributeDef{}, err
+	}
+	return cache.GetAttributeDef(attrID)
 }
 
 func GetCustomMetadataIDforName(name string) (string, error) {
-	return GetCustomMetadataCache().GetIDForName(name)
+	cache, err := GetCustomMetadataCache()
+	if err != nil {
+		return "", err
+	}
+	return cache.GetIDForName(name)
+}
+
+// CustomMetadataCache returns the CustomMetadataCache owned by this client.
+func (ac *AtlanClient) CustomMetadataCache() *CustomMetadataCache {
+	ac.caches.mutex.Lock()
+	defer ac.caches.mutex.Unlock()
+
+	if ac.caches.customMetadata == nil {
+		ac.caches.customMetadata = NewCustomMetadataCache(ac)
+	}
+	return ac.caches.customMetadata
 }
 
 // GetCustomMetadataCache returns the CustomMetadataCache for the default AtlanClient.
-func GetCustomMetadataCache() *CustomMetadataCache {
-	client := DefaultAtlanClient
-	cacheKey := generateCacheKey(client.host, client.ApiKey)
-
-	mu.Lock()
-	defer mu.Unlock()
-
-	if _, ok := customMetadataCaches[cacheKey]; !ok {
-		customMetadataCaches[cacheKey] = &CustomMetadataCache{
-			AtlanClient:     client,
-			CacheByID:       make(map[string]model.CustomMetadataDef),
-			AttrCacheByID:   make(map[string]model.AttributeDef),
-			MapIDToName:     make(map[string]string),
-			MapNameToID:     make(map[string]string),
-			MapAttrIDToName: make(map[string]map[string]string),
-			MapAttrNameToID: make(map[string]map[string]string),
-			archivedAttrIds: make(map[string]string),
-		}
+func GetCustomMetadataCache() (*CustomMetadataCache, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
 	}
-	return customMetadataCaches[cacheKey]
+	return DefaultAtlanClient.CustomMetadataCache(), nil
 }
 
 /*
@@ -87,7 +92,7 @@ func (c *CustomMetadataCache) RefreshCache() error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	response, err := Get(atlan.AtlanTypeCategoryBusinessMetadata)
+	response, err := c.AtlanClient.GetTypeDefs(atlan.AtlanTypeCategoryBusinessMetadata)
 	if err != nil {
 		return err
 	}
@@ -123,7 +128,7 @@ func (c *CustomMetadataCache) RefreshCache() error {
 
 			// Check for duplicate attributes.
 			if existsInIDToName || existsInNameToID {
-				return LogicError{AtlanError{ErrorCode: errorCodes[DUPLICATE_CUSTOM_ATTRIBUTES]}}
+				return &LogicError{AtlanError{ErrorCode: errorCodes[DUPLICATE_CUSTOM_ATTRIBUTES]}}
 			}
 
 			if attr.Options == nil || attr.Options.IsArchived {
@@ -0,0 +1,40 @@
+package assets_test
+
+import (
+	"testing"
+
+	"github.com/atlanhq/atlan-go/atlan/assets"
+	"github.com/atlanhq/atlan-go/atlan/atlantest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomMetadataFieldsAreResolvedByTheirClient(t *testing.T) {
+	// Each tenant gives the same custom metadata attribute its own internal name
+	var clients []*assets.AtlanClient
+	for i := 0; i < 2; i++ {
+		server := atlantest.NewServer()
+		defer server.Close()
+		server.AddTypeDefs(map[string]interface{}{"businessMetadataDefs": []interface{}{map[string]interface{}{
+			"displayName": "Governance",
+			"attributeDefs": []interface{}{map[string]interface{}{
+				"displayName": "owner", "typeName": "string", "options": map[string]interface{}{},
+			}},
+		}}})
+		client, err := assets.NewClient(server.URL, "api_key")
+		require.NoError(t, err)
+		clients = append(clients, client)
+	}
+
+	var fieldNames []string
+	for _, client := range clients {
+		field, err := client.NewCustomMetadataField("Governance", "owner")
+		require.NoError(t, err)
+		expected, err := client.CustomMetadataCache().GetAttrIDForName("Governance", "owner")
+		require.NoError(t, err)
+		assert.Equal(t, expected, field.ElasticFieldName)
+		assert.Equal(t, expected, *field.AttributeDef.Name)
+		fieldNames = append(fieldNames, field.ElasticFieldName)
+	}
+	assert.NotEqual(t, fieldNames[0], fieldNames[1])
+}
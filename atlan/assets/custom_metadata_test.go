@@ -0,0 +1,110 @@
+package assets_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/assets"
+	"github.com/atlanhq/atlan-go/atlan/atlantest"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCustomMetadataServer stores a single custom metadata set, Governance (cm1), with the
+// attributes owner (a1), tier (a2) and the archived retired (a3), and a table with GUID t1 that
+// has all three attributes set.
+func newCustomMetadataServer(t *testing.T) (*atlantest.Server, *assets.AtlanClient) {
+	server, client := newTestServer(t)
+	server.AddTypeDefs(map[string]interface{}{"businessMetadataDefs": []interface{}{map[string]interface{}{
+		"name": "cm1", "displayName": "Governance", "attributeDefs": []interface{}{
+			map[string]interface{}{"name": "a1", "displayName": "owner", "options": map[string]interface{}{}},
+			map[string]interface{}{"name": "a2", "displayName": "tier", "options": map[string]interface{}{}},
+			map[string]interface{}{"name": "a3", "displayName": "retired", "options": map[string]interface{}{"isArchived": true}},
+		},
+	}}})
+	server.AddEntity(map[string]interface{}{"typeName": "Table", "guid": "t1",
+		"attributes":         map[string]interface{}{"name": "ORDERS"},
+		"businessAttributes": map[string]interface{}{"cm1": map[string]interface{}{"a1": "Ana", "a2": 2, "a3": "gone"}}})
+	return server, client
+}
+
+// businessAttributes returns the custom metadata stored on the entity with the given GUID.
+func businessAttributes(server *atlantest.Server, guid string) interface{} {
+	return server.Entity(guid)["businessAttributes"]
+}
+
+func TestCustomMetadataByName(t *testing.T) {
+	server, client := newCustomMetadataServer(t)
+
+	attributes, err := client.GetCustomMetadata("t1", "Governance")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"owner": "Ana", "tier": float64(2)}, attributes, "archived attributes are left out")
+
+	object, err := client.GetEntityByGuid("t1")
+	require.NoError(t, err)
+	assert.Equal(t, "Ana", object.GetEntity().GetCustomMetadata("Governance")["owner"])
+
+	require.NoError(t, client.UpdateCustomMetadata("t1", "Governance", map[string]interface{}{"owner": "Bo"}))
+	assert.Equal(t, map[string]interface{}{"cm1": map[string]interface{}{"a1": "Bo", "a2": json.Number("2"), "a3": "gone"}},
+		businessAttributes(server, "t1"))
+
+	require.NoError(t, client.ReplaceCustomMetadata("t1", "Governance", map[string]interface{}{"tier": 1}))
+	saved := server.Received("POST /api/meta/entity/guid/t1/businessmetadata/cm1")
+	require.Len(t, saved, 2)
+	assert.JSONEq(t, `{"a1": null, "a2": 1}`, string(saved[1].Body), "attributes that aren't given are removed")
+	assert.Equal(t, map[string]interface{}{"cm1": map[string]interface{}{"a2": json.Number("1"), "a3": "gone"}},
+		businessAttributes(server, "t1"))
+
+	require.NoError(t, client.RemoveCustomMetadata("t1", "Governance"))
+	removed := server.Received("DELETE /api/meta/entity/guid/t1/businessmetadata/cm1")
+	require.Len(t, removed, 1)
+	assert.JSONEq(t, `{"a1": null, "a2": null}`, string(removed[0].Body))
+	assert.Equal(t, map[string]interface{}{"cm1": map[string]interface{}{"a3": "gone"}}, businessAttributes(server, "t1"))
+
+	_, err = client.GetCustomMetadata("t1", "Quality")
+	require.Error(t, err)
+	require.Error(t, client.UpdateCustomMetadata("t1", "Governance", map[string]interface{}{"steward": "Bo"}))
+}
+
+func TestBatchSavesAtlanTagsAndCustomMetadataWithItsOptions(t *testing.T) {
+	for _, tc := range []struct {
+		options            assets.SaveOptions
+		businessAttributes interface{}
+		query              string
+	}{
+		{assets.SaveOptions{}, nil,
+			"appendTags=false&overwriteBusinessAttributes=false&replaceBusinessAttributes=false&replaceClassifications=false"},
+		{assets.SaveOptions{ReplaceAtlanTags: true, CustomMetadataHandling: atlan.IGNORE}, nil,
+			"appendTags=false&overwriteBusinessAttributes=false&replaceBusinessAttributes=false&replaceClassifications=true"},
+		{assets.SaveOptions{AppendAtlanTags: true, CustomMetadataHandling: atlan.MERGE}, map[string]interface{}{"cm1": map[string]interface{}{"a1": "Ana"}},
+			"appendTags=true&overwriteBusinessAttributes=false&replaceBusinessAttributes=true&replaceClassifications=false"},
+		{assets.SaveOptions{CustomMetadataHandling: atlan.OVERWRITE}, map[string]interface{}{"cm1": map[string]interface{}{"a1": "Ana"}},
+			"appendTags=false&overwriteBusinessAttributes=true&replaceBusinessAttributes=true&replaceClassifications=false"},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			server, client := newCustomMetadataServer(t)
+
+			table := &entities.Table{}
+			require.NoError(t, table.Creator("ORDERS", "default/snowflake/123/DB/SCHEMA"))
+			table.SetCustomMetadata("Governance", map[string]interface{}{"owner": "Ana"})
+			batch := assets.NewBatchWithOptions(client, 10, tc.options, false)
+			require.NoError(t, batch.Add(table))
+			_, err := batch.Flush()
+			require.NoError(t, err)
+
+			saved := server.Received("POST /api/meta/entity/bulk")
+			require.Len(t, saved, 1)
+			assert.Equal(t, tc.query, saved[0].Query.Encode())
+			var body struct {
+				Entities []map[string]interface{} `json:"entities"`
+			}
+			require.NoError(t, saved[0].DecodeBody(&body))
+			assert.Equal(t, tc.businessAttributes, body.Entities[0]["businessAttributes"])
+		})
+	}
+
+	_, err := (&assets.AtlanClient{}).SaveWithOptions(assets.SaveOptions{ReplaceAtlanTags: true, AppendAtlanTags: true})
+	assert.EqualError(t, err, "Atlan tags can't be both replaced and appended")
+}
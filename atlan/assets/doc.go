@@ -0,0 +1,22 @@
+// Package assets is the client for the Atlan APIs: it saves, retrieves, searches and deletes
+// assets, and manages the users, groups, tags, custom metadata and other definitions of a tenant.
+//
+// # Errors
+//
+// The errors returned by failed API calls are typed by the HTTP status of the response
+// (*NotFoundError, *PermissionError, *RateLimitError, ...), each wrapping the *AtlanError that
+// carries the details of the failure. Branch on the sentinels with errors.Is, and extract the
+// details with errors.As or AsAtlanError:
+//
+//	if errors.Is(err, assets.ErrNotFound) {
+//		...
+//	}
+//	var atlanError *assets.AtlanError
+//	if errors.As(err, &atlanError) {
+//		log.Println(atlanError.StatusCode, atlanError.RequestID)
+//	}
+//
+// API errors used to be returned as a bare *AtlanError, so a type assertion such as
+// err.(*assets.AtlanError) no longer matches them: use errors.As as above instead. Errors raised
+// by the SDK itself (ThrowAtlanError) are still a bare *AtlanError.
+package assets
@@ -2,12 +2,28 @@ package assets
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 )
 
+// Sentinel errors to branch on with errors.Is. An AtlanError matches the sentinel of its HTTP status,
+// whether it was returned by the API or raised by the SDK itself.
+var (
+	ErrInvalidRequest = errors.New("invalid request")       // HTTP 400
+	ErrAuthentication = errors.New("authentication failed") // HTTP 401
+	ErrPermission     = errors.New("permission denied")     // HTTP 403
+	ErrNotFound       = errors.New("not found")             // HTTP 404
+	ErrConflict       = errors.New("conflict")              // HTTP 409
+	ErrRateLimit      = errors.New("rate limit exceeded")   // HTTP 429
+	ErrServer         = errors.New("server error")          // HTTP 5xx
+	ErrConnection     = errors.New("connection error")      // No response received
+)
+
+// RequestIDHeader is the header carrying the ID of each API request, sent by the client and echoed by Atlan.
+const RequestIDHeader = "X-Atlan-Request-Id"
+
 type ErrorInfo struct {
 	HTTPErrorCode int
 	ErrorID       string
@@ -18,8 +34,13 @@ type ErrorInfo struct {
 type AtlanError struct {
 	ErrorCode     ErrorInfo
 	Args          []interface{}
-	OriginalError string  // Error received from Atlan API
-	Causes        []Cause // List of causes from API response
+	OriginalError string         // Error received from Atlan API
+	Causes        []Cause        // List of causes from API response
+	StatusCode    int            // HTTP status code of the response, 0 when no response was received
+	RequestID     string         // ID of the failed request, to correlate with Atlan's logs
+	Retryable     bool           // Whether the same request may succeed when retried later
+	Response      *ErrorResponse // Error details returned by the API, if any
+	Err           error          // Underlying error, returned by Unwrap
 }
 
 func (e AtlanError) Error() string {
@@ -30,6 +51,9 @@ func (e AtlanError) Error() string {
 	if e.OriginalError != "" {
 		errorMessage += "\nError response from server: " + e.OriginalError
 	}
+	if e.RequestID != "" {
+		errorMessage += "\nRequest ID: " + e.RequestID
+	}
 
 	if len(e.Causes) > 0 {
 		errorMessage += "\nCauses:\n"
@@ -40,6 +64,57 @@ func (e AtlanError) Error() string {
 	return errorMessage
 }
 
+// Unwrap returns the underlying error, if any.
+func (e AtlanError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches one of the sentinel errors, based on its HTTP status.
+func (e AtlanError) Is(target error) bool {
+	status := e.HTTPStatus()
+	switch target {
+	case ErrInvalidRequest:
+		return status == http.StatusBadRequest
+	case ErrAuthentication:
+		return status == http.StatusUnauthorized
+	case ErrPermission:
+		return status == http.StatusForbidden
+	case ErrNotFound:
+		return status == http.StatusNotFound
+	case ErrConflict:
+		return status == http.StatusConflict
+	case ErrRateLimit:
+		return status == http.StatusTooManyRequests
+	case ErrServer:
+		return status >= http.StatusInternalServerError
+	case ErrConnection:
+		return e.ErrorCode.ErrorID == errorCodes[CONNECTION_ERROR].ErrorID
+	}
+	return false
+}
+
+// HTTPStatus returns the HTTP status code returned by the API, or the one associated with the SDK error.
+func (e AtlanError) HTTPStatus() int {
+	if e.StatusCode != 0 {
+		return e.StatusCode
+	}
+	return e.ErrorCode.HTTPErrorCode
+}
+
+// AtlanErrorCode returns the error code reported by the API (for example "ATLAS-404-00-005"),
+// or the SDK error ID when the API did not report one.
+func (e AtlanError) AtlanErrorCode() string {
+	if e.Response != nil {
+		if e.Response.ErrorCode != "" {
+			return e.Response.ErrorCode
+		}
+		if e.Response.ErrorID != "" {
+			return e.Response.ErrorID
+		}
+	}
+	return e.ErrorCode.ErrorID
+}
+
 type (
 	ApiConnectionError  struct{ AtlanError }
 	NotFoundError       struct{ AtlanError }
@@ -52,6 +127,40 @@ type (
 	LogicError          struct{ AtlanError }
 )
 
+// Unwrap methods expose the embedded AtlanError, so errors.As can extract it from any typed error.
+
+func (e ApiConnectionError) Unwrap() error  { return &e.AtlanError }
+func (e NotFoundError) Unwrap() error       { return &e.AtlanError }
+func (e InvalidRequestError) Unwrap() error { return &e.AtlanError }
+func (e ApiError) Unwrap() error            { return &e.AtlanError }
+func (e AuthenticationError) Unwrap() error { return &e.AtlanError }
+func (e PermissionError) Unwrap() error     { return &e.AtlanError }
+func (e ConflictError) Unwrap() error       { return &e.AtlanError }
+func (e RateLimitError) Unwrap() error      { return &e.AtlanError }
+func (e LogicError) Unwrap() error          { return &e.AtlanError }
+
+// AsAtlanError finds the first AtlanError in the chain of err.
+func AsAtlanError(err error) (*AtlanError, bool) {
+	var atlanError *AtlanError
+	if errors.As(err, &atlanError) {
+		return atlanError, true
+	}
+	var atlanErrorValue AtlanError
+	if errors.As(err, &atlanErrorValue) {
+		return &atlanErrorValue, true
+	}
+	return nil, false
+}
+
+// IsRetryable reports whether err is a transient API error, such as a connection failure,
+// a rate limit or an unavailable service, so the same request may succeed when retried later.
+func IsRetryable(err error) bool {
+	if atlanError, ok := AsAtlanError(err); ok {
+		return atlanError.Retryable
+	}
+	return false
+}
+
 type ErrorCode int
 
 const (
@@ -754,28 +863,38 @@ type Cause struct {
 	Location     string `json:"location"`
 }
 
+// ErrorResponse is the error payload returned by the API. Heracles (users, groups, tokens...)
+// reports errors with errorId and message, while Atlas reports them with errorCode and errorMessage.
 type ErrorResponse struct {
-	Causes  []Cause `json:"causes"`
-	ErrorID string  `json:"errorId"`
-	Message string  `json:"message"`
+	Causes       []Cause `json:"causes"`
+	ErrorID      string  `json:"errorId"`
+	Message      string  `json:"message"`
+	ErrorCode    string  `json:"errorCode,omitempty"`
+	ErrorMessage string  `json:"errorMessage,omitempty"`
 }
 
-func handleApiError(response *http.Response, originalError error) error {
+// handleApiError converts a failed API call into a typed error carrying the HTTP status,
+// the request ID and the error details from the response body.
+func handleApiError(response *http.Response, body []byte, originalError error) error {
 	if response == nil {
-		return ThrowAtlanError(originalError, CONNECTION_ERROR, nil)
+		atlanError := newAtlanError(originalError, CONNECTION_ERROR, nil)
+		atlanError.Retryable = !errors.Is(originalError, ErrInteractionNotFound)
+		return &ApiConnectionError{*atlanError}
 	}
 	rc := response.StatusCode
-	body, _ := io.ReadAll(response.Body)
-	var errorResponse ErrorResponse
+	var errorResponse *ErrorResponse
 	var causes []Cause
 
-	if err := json.Unmarshal(body, &errorResponse); err == nil {
-		fmt.Println(errorResponse)
+	if err := json.Unmarshal(body, &errorResponse); err == nil && errorResponse != nil {
 		causes = errorResponse.Causes
 		// Check for Atlan-specific error code 1006
 		if errorResponse.ErrorID == "1006" && strings.Contains(errorResponse.Message, "Please provide the required payload") {
-			return ThrowAtlanError(originalError, PERMISSION_PASSTHROUGH, nil, "API token doesn't have necessary permissions")
+			atlanError := newAtlanError(originalError, PERMISSION_PASSTHROUGH, nil, "API token doesn't have necessary permissions")
+			atlanError.setResponse(response, errorResponse)
+			return &PermissionError{*atlanError}
 		}
+	} else {
+		errorResponse = nil
 	}
 	var causesString string
 	if len(causes) > 0 {
@@ -784,27 +903,58 @@ func handleApiError(response *http.Response, originalError error) error {
 		}
 	}
 
+	var atlanError *AtlanError
 	switch rc {
 	case 400:
-		return ThrowAtlanError(originalError, INVALID_REQUEST_PASSTHROUGH, nil, causesString)
+		atlanError = newAtlanError(originalError, INVALID_REQUEST_PASSTHROUGH, nil, causesString)
+		atlanError.setResponse(response, errorResponse)
+		return &InvalidRequestError{*atlanError}
 	case 404:
-		return ThrowAtlanError(originalError, NOT_FOUND_PASSTHROUGH, nil, causesString)
+		atlanError = newAtlanError(originalError, NOT_FOUND_PASSTHROUGH, nil, causesString)
+		atlanError.setResponse(response, errorResponse)
+		return &NotFoundError{*atlanError}
 	case 401:
-		return ThrowAtlanError(originalError, AUTHENTICATION_PASSTHROUGH, nil, causesString)
+		atlanError = newAtlanError(originalError, AUTHENTICATION_PASSTHROUGH, nil, causesString)
+		atlanError.setResponse(response, errorResponse)
+		return &AuthenticationError{*atlanError}
 	case 403:
-		return ThrowAtlanError(originalError, PERMISSION_PASSTHROUGH, nil, causesString)
+		atlanError = newAtlanError(originalError, PERMISSION_PASSTHROUGH, nil, causesString)
+		atlanError.setResponse(response, errorResponse)
+		return &PermissionError{*atlanError}
 	case 409:
-		return ThrowAtlanError(originalError, CONFLICT_PASSTHROUGH, nil, causesString)
+		atlanError = newAtlanError(originalError, CONFLICT_PASSTHROUGH, nil, causesString)
+		atlanError.setResponse(response, errorResponse)
+		return &ConflictError{*atlanError}
 	case 429:
-		return ThrowAtlanError(originalError, RATE_LIMIT_PASSTHROUGH, nil, causesString)
+		atlanError = newAtlanError(originalError, RATE_LIMIT_PASSTHROUGH, nil, causesString)
+		atlanError.setResponse(response, errorResponse)
+		return &RateLimitError{*atlanError}
 	default:
-		return ThrowAtlanError(originalError, ERROR_PASSTHROUGH, nil, causesString)
+		atlanError = newAtlanError(originalError, ERROR_PASSTHROUGH, nil, causesString)
+		atlanError.setResponse(response, errorResponse)
+		return &ApiError{*atlanError}
 	}
 }
 
+// setResponse records the details of the failed response on the error.
+func (e *AtlanError) setResponse(response *http.Response, errorResponse *ErrorResponse) {
+	e.StatusCode = response.StatusCode
+	e.Response = errorResponse
+	e.RequestID = response.Header.Get(RequestIDHeader)
+	if e.RequestID == "" && response.Request != nil {
+		e.RequestID = response.Request.Header.Get(RequestIDHeader)
+	}
+	e.Retryable = DefaultRetryPolicy().isRetryableStatus(response.StatusCode)
+}
+
 func ThrowAtlanError(err error, sdkError ErrorCode, suggestion *string, args ...interface{}) error {
+	return newAtlanError(err, sdkError, suggestion, args...)
+}
+
+func newAtlanError(err error, sdkError ErrorCode, suggestion *string, args ...interface{}) *AtlanError {
 	atlanError := AtlanError{
 		ErrorCode: errorCodes[sdkError],
+		Err:       err,
 	}
 
 	if err != nil {
@@ -0,0 +1,127 @@
+package assets
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callFailingAPI(t *testing.T, status int, body string) error {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "request-"+r.Header.Get(RequestIDHeader))
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	client.SetRetryPolicy(NoRetryPolicy())
+	api := &API{Method: http.MethodGet, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+	_, err := client.CallAPI(api, nil, nil)
+	require.Error(t, err)
+	return err
+}
+
+func TestApiErrorsMatchSentinels(t *testing.T) {
+	tests := []struct {
+		status    int
+		sentinel  error
+		retryable bool
+	}{
+		{http.StatusBadRequest, ErrInvalidRequest, false},
+		{http.StatusUnauthorized, ErrAuthentication, false},
+		{http.StatusForbidden, ErrPermission, false},
+		{http.StatusNotFound, ErrNotFound, false},
+		{http.StatusConflict, ErrConflict, false},
+		{http.StatusTooManyRequests, ErrRateLimit, true},
+		{http.StatusInternalServerError, ErrServer, false},
+		{http.StatusServiceUnavailable, ErrServer, true},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			err := callFailingAPI(t, tt.status, `{}`)
+			assert.ErrorIs(t, err, tt.sentinel)
+			assert.Equal(t, tt.retryable, IsRetryable(err))
+
+			atlanError, ok := AsAtlanError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.status, atlanError.StatusCode)
+			assert.Equal(t, tt.status, atlanError.HTTPStatus())
+
+			if tt.sentinel != ErrNotFound {
+				assert.False(t, errors.Is(err, ErrNotFound))
+			}
+		})
+	}
+}
+
+func TestApiErrorsAreTyped(t *testing.T) {
+	var rateLimitError *RateLimitError
+	err := callFailingAPI(t, http.StatusTooManyRequests, `{}`)
+	require.ErrorAs(t, err, &rateLimitError)
+	assert.True(t, rateLimitError.Retryable)
+
+	var conflictError *ConflictError
+	err = callFailingAPI(t, http.StatusConflict, `{}`)
+	require.ErrorAs(t, err, &conflictError)
+	assert.False(t, errors.As(err, &rateLimitError))
+
+	// The migration path for the type assertions on *AtlanError that API errors used to satisfy
+	_, ok := err.(*AtlanError)
+	assert.False(t, ok)
+	var atlanError *AtlanError
+	require.ErrorAs(t, err, &atlanError)
+	assert.Equal(t, http.StatusConflict, atlanError.StatusCode)
+}
+
+func TestApiErrorsExposeResponseDetails(t *testing.T) {
+	err := callFailingAPI(t, http.StatusNotFound,
+		`{"errorCode": "ATLAS-404-00-005", "errorMessage": "Given instance guid abc is invalid/not found"}`)
+	atlanError, ok := AsAtlanError(err)
+	require.True(t, ok)
+	assert.Equal(t, "ATLAS-404-00-005", atlanError.AtlanErrorCode())
+	require.NotNil(t, atlanError.Response)
+	assert.Equal(t, "Given instance guid abc is invalid/not found", atlanError.Response.ErrorMessage)
+	assert.Regexp(t, "^request-[0-9a-f]{32}$", atlanError.RequestID)
+	assert.Contains(t, err.Error(), "Request ID: "+atlanError.RequestID)
+
+	err = callFailingAPI(t, http.StatusBadRequest,
+		`{"errorId": "1001", "message": "Invalid filter", "causes": [{"errorType": "Validation", "errorMessage": "bad filter", "location": "filter"}]}`)
+	atlanError, ok = AsAtlanError(err)
+	require.True(t, ok)
+	assert.Equal(t, "1001", atlanError.AtlanErrorCode())
+	require.Len(t, atlanError.Response.Causes, 1)
+	assert.Equal(t, "filter", atlanError.Response.Causes[0].Location)
+}
+
+func TestConnectionErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	client.SetRetryPolicy(NoRetryPolicy())
+	api := &API{Method: http.MethodGet, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+	_, err := client.CallAPI(api, nil, nil)
+	assert.ErrorIs(t, err, ErrConnection)
+	assert.True(t, IsRetryable(err))
+
+	var connectionError *ApiConnectionError
+	require.ErrorAs(t, err, &connectionError)
+	assert.Zero(t, connectionError.StatusCode)
+	assert.NotNil(t, errors.Unwrap(connectionError.AtlanError))
+}
+
+func TestSDKErrorsMatchSentinels(t *testing.T) {
+	err := error(&NotFoundError{AtlanError{ErrorCode: errorCodes[TYPEDEF_NOT_FOUND_BY_NAME], Args: []interface{}{"missing"}}})
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.False(t, IsRetryable(err))
+
+	err = ThrowAtlanError(nil, MISSING_GUID_FOR_DELETE, nil)
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+	assert.NotErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,19 @@
+// Package fields holds the searchable fields of the asset types in the generator's snapshot of
+// the metamodel, generated from their type definitions. Each asset type has a variable holding
+// its fields, including those it inherits, for use in a FluentSearch:
+//
+//	assets.NewFluentSearch().
+//		AssetType("Schema").
+//		Where(fields.Schema.DATABASE_QUALIFIED_NAME.Eq(databaseQualifiedName)).
+//		Where(fields.Schema.TABLE_COUNT.Gt(&minimumTables))
+//
+// The snapshot covers only part of the metamodel: it was assembled from the 47 asset types the
+// SDK already models (the SQL, glossary, lineage and access control types, and a few BI, Kafka,
+// S3 and dbt ones) rather than fetched from a tenant, and has no relationship or custom metadata
+// definitions. Fields of the other asset types are missing until the snapshot is refreshed with
+// the generator's -fetch flag.
+//
+// To regenerate the fields when the metamodel changes, see the generator command.
+package fields
+
+//go:generate go run ../../../generator -typedefs ../../../generator/typedefs.json -fields fields_gen.go -types ""
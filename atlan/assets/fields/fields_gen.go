@@ -0,0 +1,1846 @@
+// Code generated by go run ./generator; DO NOT EDIT.
+
+package fields
+
+import "github.com/atlanhq/atlan-go/atlan/assets"
+
+// AWSFields holds the searchable fields of AWS assets.
+// AWS: Base class for AWS assets.
+type AWSFields struct {
+	CloudFields
+	// AWS_ARN Amazon Resource Name (ARN) for this asset. This uniquely identifies the asset in AWS, and thus must be unique across all AWS asset instances.
+	AWS_ARN *assets.KeywordTextField
+	// AWS_PARTITION Group of AWS region and service objects.
+	AWS_PARTITION *assets.KeywordField
+	// AWS_SERVICE Type of service in which the asset exists.
+	AWS_SERVICE *assets.KeywordField
+	// AWS_REGION Physical region where the data center in which the asset exists is clustered.
+	AWS_REGION *assets.KeywordField
+	// AWS_ACCOUNT_ID 12-digit number that uniquely identifies an AWS account.
+	AWS_ACCOUNT_ID *assets.KeywordField
+	// AWS_RESOURCE_ID Unique resource ID assigned when a new resource is created.
+	AWS_RESOURCE_ID *assets.KeywordField
+	// AWS_OWNER_NAME Root user's name.
+	AWS_OWNER_NAME *assets.KeywordTextField
+	// AWS_OWNER_ID Root user's ID.
+	AWS_OWNER_ID *assets.KeywordField
+}
+
+func newAWSFields() AWSFields {
+	return AWSFields{
+		CloudFields:     newCloudFields(),
+		AWS_ARN:         assets.NewKeywordTextField("awsArn", "awsArn", "awsArn.text"),
+		AWS_PARTITION:   assets.NewKeywordField("awsPartition", "awsPartition"),
+		AWS_SERVICE:     assets.NewKeywordField("awsService", "awsService"),
+		AWS_REGION:      assets.NewKeywordField("awsRegion", "awsRegion"),
+		AWS_ACCOUNT_ID:  assets.NewKeywordField("awsAccountId", "awsAccountId"),
+		AWS_RESOURCE_ID: assets.NewKeywordField("awsResourceId", "awsResourceId"),
+		AWS_OWNER_NAME:  assets.NewKeywordTextField("awsOwnerName", "awsOwnerName", "awsOwnerName.text"),
+		AWS_OWNER_ID:    assets.NewKeywordField("awsOwnerId", "awsOwnerId"),
+	}
+}
+
+// AccessControlFields holds the searchable fields of AccessControl assets.
+// AccessControl: Base class for the assets that control access to other assets in Atlan.
+type AccessControlFields struct {
+	AssetFields
+	// IS_ACCESS_CONTROL_ENABLED Whether the access control is enabled (true) or not (false).
+	IS_ACCESS_CONTROL_ENABLED *assets.BooleanField
+	// DENY_CUSTOM_METADATA_GUIDS GUIDs of the custom metadata sets that are hidden from the users of the access control.
+	DENY_CUSTOM_METADATA_GUIDS *assets.KeywordField
+	// DENY_ASSET_TABS Asset tabs that are hidden from the users of the access control.
+	DENY_ASSET_TABS *assets.KeywordField
+	// DENY_ASSET_FILTERS Asset filters that are hidden from the users of the access control.
+	DENY_ASSET_FILTERS *assets.TextField
+	// CHANNEL_LINK Link to the channel in which the access control is discussed.
+	CHANNEL_LINK *assets.TextField
+	// DENY_ASSET_TYPES Types of asset that are hidden from the users of the access control.
+	DENY_ASSET_TYPES *assets.TextField
+	// DENY_NAVIGATION_PAGES Navigation pages that are hidden from the users of the access control.
+	DENY_NAVIGATION_PAGES *assets.TextField
+	// DEFAULT_NAVIGATION Page the users of the access control land on by default.
+	DEFAULT_NAVIGATION *assets.TextField
+	// DISPLAY_PREFERENCES Display preferences of the users of the access control.
+	DISPLAY_PREFERENCES *assets.KeywordField
+	// POLICIES Policies of the access control.
+	POLICIES *assets.RelationField
+}
+
+func newAccessControlFields() AccessControlFields {
+	return AccessControlFields{
+		AssetFields:                newAssetFields(),
+		IS_ACCESS_CONTROL_ENABLED:  assets.NewBooleanField("isAccessControlEnabled", "isAccessControlEnabled"),
+		DENY_CUSTOM_METADATA_GUIDS: assets.NewKeywordField("denyCustomMetadataGuids", "denyCustomMetadataGuids"),
+		DENY_ASSET_TABS:            assets.NewKeywordField("denyAssetTabs", "denyAssetTabs"),
+		DENY_ASSET_FILTERS:         assets.NewTextField("denyAssetFilters", "denyAssetFilters"),
+		CHANNEL_LINK:               assets.NewTextField("channelLink", "channelLink"),
+		DENY_ASSET_TYPES:           assets.NewTextField("denyAssetTypes", "denyAssetTypes"),
+		DENY_NAVIGATION_PAGES:      assets.NewTextField("denyNavigationPages", "denyNavigationPages"),
+		DEFAULT_NAVIGATION:         assets.NewTextField("defaultNavigation", "defaultNavigation"),
+		DISPLAY_PREFERENCES:        assets.NewKeywordField("displayPreferences", "displayPreferences"),
+		POLICIES:                   assets.NewRelationField("policies"),
+	}
+}
+
+// AssetFields holds the searchable fields of Asset assets.
+// Asset: Base class for all assets.
+type AssetFields struct {
+	ReferenceableFields
+	// NAME Name of this asset. Fallback for display purposes, if displayName is empty.
+	NAME *assets.KeywordTextStemmedField
+	// DISPLAY_NAME Human-readable name of this asset used for display purposes (in user interface).
+	DISPLAY_NAME *assets.KeywordTextField
+	// DESCRIPTION Description of this asset, for example as crawled from a source. Fallback for display purposes, if userDescription is empty.
+	DESCRIPTION *assets.KeywordTextField
+	// USER_DESCRIPTION Description of this asset, as provided by a user. If present, this will be used for the description in user interface.
+	USER_DESCRIPTION *assets.KeywordTextField
+	// TENANT_ID Name of the Atlan workspace in which this asset exists.
+	TENANT_ID *assets.KeywordField
+	// CERTIFICATE_STATUS Status of this asset's certification.
+	CERTIFICATE_STATUS *assets.KeywordTextField
+	// CERTIFICATE_STATUS_MESSAGE Human-readable descriptive message used to provide further detail to certificateStatus.
+	CERTIFICATE_STATUS_MESSAGE *assets.KeywordField
+	// CERTIFICATE_UPDATED_BY Name of the user who last updated the certification of this asset.
+	CERTIFICATE_UPDATED_BY *assets.KeywordField
+	// CERTIFICATE_UPDATED_AT Time (epoch) at which the certification was last updated, in milliseconds.
+	CERTIFICATE_UPDATED_AT *assets.NumericField
+	// ANNOUNCEMENT_TITLE Brief title for the announcement on this asset. Required when announcementType is specified.
+	ANNOUNCEMENT_TITLE *assets.KeywordField
+	// ANNOUNCEMENT_MESSAGE Detailed message to include in the announcement on this asset.
+	ANNOUNCEMENT_MESSAGE *assets.KeywordTextField
+	// ANNOUNCEMENT_TYPE Type of announcement on this asset.
+	ANNOUNCEMENT_TYPE *assets.KeywordField
+	// ANNOUNCEMENT_UPDATED_AT Time (epoch) at which the announcement was last updated, in milliseconds.
+	ANNOUNCEMENT_UPDATED_AT *assets.NumericField
+	// ANNOUNCEMENT_UPDATED_BY Name of the user who last updated the announcement.
+	ANNOUNCEMENT_UPDATED_BY *assets.KeywordField
+	// OWNER_USERS List of users who own this asset.
+	OWNER_USERS *assets.KeywordTextField
+	// OWNER_GROUPS List of groups who own this asset.
+	OWNER_GROUPS *assets.KeywordTextField
+	// ADMIN_USERS List of users who administer this asset. (This is only used for certain asset types.)
+	ADMIN_USERS *assets.KeywordField
+	// ADMIN_GROUPS List of groups who administer this asset. (This is only used for certain asset types.)
+	ADMIN_GROUPS *assets.KeywordField
+	// VIEWER_USERS List of users who can view assets contained in a collection. (This is only used for certain asset types.)
+	VIEWER_USERS *assets.KeywordField
+	// VIEWER_GROUPS List of groups who can view assets contained in a collection. (This is only used for certain asset types.)
+	VIEWER_GROUPS *assets.KeywordField
+	// CONNECTOR_NAME Type of the connector through which this asset is accessible.
+	CONNECTOR_NAME *assets.KeywordTextField
+	// CONNECTION_NAME Simple name of the connection through which this asset is accessible.
+	CONNECTION_NAME *assets.KeywordTextField
+	// CONNECTION_QUALIFIED_NAME Unique name of the connection through which this asset is accessible.
+	CONNECTION_QUALIFIED_NAME *assets.KeywordTextField
+	// HAS_LINEAGE Whether this asset has lineage (true) or not (false).
+	HAS_LINEAGE *assets.BooleanField
+	// IS_DISCOVERABLE Whether this asset is discoverable through the UI (true) or not (false).
+	IS_DISCOVERABLE *assets.BooleanField
+	// IS_EDITABLE Whether this asset can be edited in the UI (true) or not (false).
+	IS_EDITABLE *assets.BooleanField
+	// POPULARITY_SCORE Popularity score for this asset.
+	POPULARITY_SCORE *assets.NumericField
+	// SOURCE_OWNERS List of owners of this asset, in the source system.
+	SOURCE_OWNERS *assets.KeywordTextField
+	// SOURCE_CREATED_BY Name of the user who created this asset, in the source system.
+	SOURCE_CREATED_BY *assets.KeywordField
+	// SOURCE_CREATED_AT Time (epoch) at which this asset was created in the source system, in milliseconds.
+	SOURCE_CREATED_AT *assets.NumericField
+	// SOURCE_UPDATED_AT Time (epoch) at which this asset was last updated in the source system, in milliseconds.
+	SOURCE_UPDATED_AT *assets.NumericField
+	// SOURCE_UPDATED_BY Name of the user who last updated this asset, in the source system.
+	SOURCE_UPDATED_BY *assets.KeywordField
+	// SOURCE_URL URL to the resource within the source application, used to create a button to view this asset in the source application.
+	SOURCE_URL *assets.KeywordField
+	// LAST_SYNC_WORKFLOW_NAME Name of the crawler that last synchronized this asset.
+	LAST_SYNC_WORKFLOW_NAME *assets.KeywordTextField
+	// LAST_SYNC_RUN_AT Time (epoch) at which this asset was last crawled, in milliseconds.
+	LAST_SYNC_RUN_AT *assets.NumericField
+	// LAST_SYNC_RUN Name of the last run of the crawler that last synchronized this asset.
+	LAST_SYNC_RUN *assets.KeywordTextField
+	// ASSET_TAGS List of tags attached to this asset.
+	ASSET_TAGS *assets.KeywordTextField
+	// MEANINGS Glossary terms that are linked to this asset.
+	MEANINGS *assets.RelationField
+}
+
+func newAssetFields() AssetFields {
+	return AssetFields{
+		ReferenceableFields:        newReferenceableFields(),
+		NAME:                       assets.NewKeywordTextStemmedField("name", "name.keyword", "name", "name.stemmed"),
+		DISPLAY_NAME:               assets.NewKeywordTextField("displayName", "displayName.keyword", "displayName"),
+		DESCRIPTION:                assets.NewKeywordTextField("description", "description", "description.text"),
+		USER_DESCRIPTION:           assets.NewKeywordTextField("userDescription", "userDescription", "userDescription.text"),
+		TENANT_ID:                  assets.NewKeywordField("tenantId", "tenantId"),
+		CERTIFICATE_STATUS:         assets.NewKeywordTextField("certificateStatus", "certificateStatus", "certificateStatus.text"),
+		CERTIFICATE_STATUS_MESSAGE: assets.NewKeywordField("certificateStatusMessage", "certificateStatusMessage"),
+		CERTIFICATE_UPDATED_BY:     assets.NewKeywordField("certificateUpdatedBy", "certificateUpdatedBy"),
+		CERTIFICATE_UPDATED_AT:     assets.NewNumericField("certificateUpdatedAt", "certificateUpdatedAt"),
+		ANNOUNCEMENT_TITLE:         assets.NewKeywordField("announcementTitle", "announcementTitle"),
+		ANNOUNCEMENT_MESSAGE:       assets.NewKeywordTextField("announcementMessage", "announcementMessage", "announcementMessage.text"),
+		ANNOUNCEMENT_TYPE:          assets.NewKeywordField("announcementType", "announcementType"),
+		ANNOUNCEMENT_UPDATED_AT:    assets.NewNumericField("announcementUpdatedAt", "announcementUpdatedAt"),
+		ANNOUNCEMENT_UPDATED_BY:    assets.NewKeywordField("announcementUpdatedBy", "announcementUpdatedBy"),
+		OWNER_USERS:                assets.NewKeywordTextField("ownerUsers", "ownerUsers", "ownerUsers.text"),
+		OWNER_GROUPS:               assets.NewKeywordTextField("ownerGroups", "ownerGroups", "ownerGroups.text"),
+		ADMIN_USERS:                assets.NewKeywordField("adminUsers", "adminUsers"),
+		ADMIN_GROUPS:               assets.NewKeywordField("adminGroups", "adminGroups"),
+		VIEWER_USERS:               assets.NewKeywordField("viewerUsers", "viewerUsers"),
+		VIEWER_GROUPS:              assets.NewKeywordField("viewerGroups", "viewerGroups"),
+		CONNECTOR_NAME:             assets.NewKeywordTextField("connectorName", "connectorName", "connectorName.text"),
+		CONNECTION_NAME:            assets.NewKeywordTextField("connectionName", "connectionName", "connectionName.text"),
+		CONNECTION_QUALIFIED_NAME:  assets.NewKeywordTextField("connectionQualifiedName", "connectionQualifiedName", "connectionQualifiedName.text"),
+		HAS_LINEAGE:                assets.NewBooleanField("hasLineage", "hasLineage"),
+		IS_DISCOVERABLE:            assets.NewBooleanField("isDiscoverable", "isDiscoverable"),
+		IS_EDITABLE:                assets.NewBooleanField("isEditable", "isEditable"),
+		POPULARITY_SCORE:           assets.NewNumericField("popularityScore", "popularityScore"),
+		SOURCE_OWNERS:              assets.NewKeywordTextField("sourceOwners", "sourceOwners", "sourceOwners.text"),
+		SOURCE_CREATED_BY:          assets.NewKeywordField("sourceCreatedBy", "sourceCreatedBy"),
+		SOURCE_CREATED_AT:          assets.NewNumericField("sourceCreatedAt", "sourceCreatedAt"),
+		SOURCE_UPDATED_AT:          assets.NewNumericField("sourceUpdatedAt", "sourceUpdatedAt"),
+		SOURCE_UPDATED_BY:          assets.NewKeywordField("sourceUpdatedBy", "sourceUpdatedBy"),
+		SOURCE_URL:                 assets.NewKeywordField("sourceURL", "sourceURL"),
+		LAST_SYNC_WORKFLOW_NAME:    assets.NewKeywordTextField("lastSyncWorkflowName", "lastSyncWorkflowName", "lastSyncWorkflowName.text"),
+		LAST_SYNC_RUN_AT:           assets.NewNumericField("lastSyncRunAt", "lastSyncRunAt"),
+		LAST_SYNC_RUN:              assets.NewKeywordTextField("lastSyncRun", "lastSyncRun", "lastSyncRun.text"),
+		ASSET_TAGS:                 assets.NewKeywordTextField("assetTags", "assetTags", "assetTags.text"),
+		MEANINGS:                   assets.NewRelationField("meanings"),
+	}
+}
+
+// AtlasGlossaryFields holds the searchable fields of AtlasGlossary assets.
+// AtlasGlossary: Instance of a glossary in Atlan.
+type AtlasGlossaryFields struct {
+	AssetFields
+	// SHORT_DESCRIPTION Unused. A short definition of the glossary element.
+	SHORT_DESCRIPTION *assets.KeywordField
+	// LONG_DESCRIPTION Unused. A longer description of the glossary element.
+	LONG_DESCRIPTION *assets.KeywordField
+	// ADDITIONAL_ATTRIBUTES Unused. Arbitrary set of additional attributes associated with this glossary element.
+	ADDITIONAL_ATTRIBUTES *assets.KeywordField
+	// LANGUAGE Unused. Language of the glossary's contents.
+	LANGUAGE *assets.KeywordField
+	// USAGE Unused. Inteded usage for the glossary.
+	USAGE *assets.KeywordField
+	// TERMS Terms within this glossary.
+	TERMS *assets.RelationField
+	// CATEGORIES Categories within this glossary.
+	CATEGORIES *assets.RelationField
+}
+
+func newAtlasGlossaryFields() AtlasGlossaryFields {
+	return AtlasGlossaryFields{
+		AssetFields:           newAssetFields(),
+		SHORT_DESCRIPTION:     assets.NewKeywordField("shortDescription", "shortDescription"),
+		LONG_DESCRIPTION:      assets.NewKeywordField("longDescription", "longDescription"),
+		ADDITIONAL_ATTRIBUTES: assets.NewKeywordField("additionalAttributes", "additionalAttributes"),
+		LANGUAGE:              assets.NewKeywordField("language", "language"),
+		USAGE:                 assets.NewKeywordField("usage", "usage"),
+		TERMS:                 assets.NewRelationField("terms"),
+		CATEGORIES:            assets.NewRelationField("categories"),
+	}
+}
+
+// AtlasGlossaryCategoryFields holds the searchable fields of AtlasGlossaryCategory assets.
+// AtlasGlossaryCategory: Instance of a category in a glossary, in Atlan.
+type AtlasGlossaryCategoryFields struct {
+	AssetFields
+	// SHORT_DESCRIPTION Unused. A short definition of the glossary element.
+	SHORT_DESCRIPTION *assets.KeywordField
+	// LONG_DESCRIPTION Unused. A longer description of the glossary element.
+	LONG_DESCRIPTION *assets.KeywordField
+	// ADDITIONAL_ATTRIBUTES Unused. Arbitrary set of additional attributes associated with this glossary element.
+	ADDITIONAL_ATTRIBUTES *assets.KeywordField
+	// ANCHOR Glossary in which the category is contained.
+	ANCHOR *assets.RelationField
+	// PARENT_CATEGORY Parent category in which this category is located (or empty if it is a root-level category).
+	PARENT_CATEGORY *assets.RelationField
+	// CHILDREN_CATEGORIES Child categories organized within this category.
+	CHILDREN_CATEGORIES *assets.RelationField
+	// TERMS Terms organized within this category.
+	TERMS *assets.RelationField
+}
+
+func newAtlasGlossaryCategoryFields() AtlasGlossaryCategoryFields {
+	return AtlasGlossaryCategoryFields{
+		AssetFields:           newAssetFields(),
+		SHORT_DESCRIPTION:     assets.NewKeywordField("shortDescription", "shortDescription"),
+		LONG_DESCRIPTION:      assets.NewKeywordField("longDescription", "longDescription"),
+		ADDITIONAL_ATTRIBUTES: assets.NewKeywordField("additionalAttributes", "additionalAttributes"),
+		ANCHOR:                assets.NewRelationField("anchor"),
+		PARENT_CATEGORY:       assets.NewRelationField("parentCategory"),
+		CHILDREN_CATEGORIES:   assets.NewRelationField("childrenCategories"),
+		TERMS:                 assets.NewRelationField("terms"),
+	}
+}
+
+// AtlasGlossaryTermFields holds the searchable fields of AtlasGlossaryTerm assets.
+// AtlasGlossaryTerm: Instance of a term in a glossary, in Atlan.
+type AtlasGlossaryTermFields struct {
+	AssetFields
+	// SHORT_DESCRIPTION Unused. A short definition of the glossary element.
+	SHORT_DESCRIPTION *assets.KeywordField
+	// LONG_DESCRIPTION Unused. A longer description of the glossary element.
+	LONG_DESCRIPTION *assets.KeywordField
+	// ADDITIONAL_ATTRIBUTES Unused. Arbitrary set of additional attributes associated with this glossary element.
+	ADDITIONAL_ATTRIBUTES *assets.KeywordField
+	// EXAMPLES Unused. Exmaples of the term.
+	EXAMPLES *assets.KeywordField
+	// ABBREVIATION Unused. Abbreviation of the term.
+	ABBREVIATION *assets.KeywordField
+	// USAGE Unused. Intended usage for the term.
+	USAGE *assets.KeywordField
+	// ANCHOR Glossary in which the term is contained.
+	ANCHOR *assets.RelationField
+	// CATEGORIES Categories within which this term is organized.
+	CATEGORIES *assets.RelationField
+	// ASSIGNED_ENTITIES Assets to which this term is linked.
+	ASSIGNED_ENTITIES *assets.RelationField
+	// SEE_ALSO Related terms that may also be of interest.
+	SEE_ALSO *assets.RelationField
+	// SYNONYMS Terms that have the same (or a very similar) meaning, in the same language.
+	SYNONYMS *assets.RelationField
+	// ANTONYMS Terms that have the opposite (or near opposite) meaning, in the same language.
+	ANTONYMS *assets.RelationField
+	// PREFERRED_TERMS Terms that should be used instead of this term.
+	PREFERRED_TERMS *assets.RelationField
+	// PREFERRED_TO_TERMS Unused. Terms that this term should be used instead of.
+	PREFERRED_TO_TERMS *assets.RelationField
+	// REPLACED_BY Unused. Terms that replace this term.
+	REPLACED_BY *assets.RelationField
+	// REPLACEMENT_TERMS Unused. Terms that this term replaces.
+	REPLACEMENT_TERMS *assets.RelationField
+	// TRANSLATION_TERMS Translated versions of this term, in other languages.
+	TRANSLATION_TERMS *assets.RelationField
+	// TRANSLATED_TERMS Unused. Terms that this term translates.
+	TRANSLATED_TERMS *assets.RelationField
+	// IS_A Unused. Terms that this term is a kind of.
+	IS_A *assets.RelationField
+	// CLASSIFIES Unused. Terms that are kinds of this term.
+	CLASSIFIES *assets.RelationField
+	// VALID_VALUES Unused. Terms that are valid values for this term.
+	VALID_VALUES *assets.RelationField
+	// VALID_VALUES_FOR Unused. Terms for which this term is a valid value.
+	VALID_VALUES_FOR *assets.RelationField
+}
+
+func newAtlasGlossaryTermFields() AtlasGlossaryTermFields {
+	return AtlasGlossaryTermFields{
+		AssetFields:           newAssetFields(),
+		SHORT_DESCRIPTION:     assets.NewKeywordField("shortDescription", "shortDescription"),
+		LONG_DESCRIPTION:      assets.NewKeywordField("longDescription", "longDescription"),
+		ADDITIONAL_ATTRIBUTES: assets.NewKeywordField("additionalAttributes", "additionalAttributes"),
+		EXAMPLES:              assets.NewKeywordField("examples", "examples"),
+		ABBREVIATION:          assets.NewKeywordField("abbreviation", "abbreviation"),
+		USAGE:                 assets.NewKeywordField("usage", "usage"),
+		ANCHOR:                assets.NewRelationField("anchor"),
+		CATEGORIES:            assets.NewRelationField("categories"),
+		ASSIGNED_ENTITIES:     assets.NewRelationField("assignedEntities"),
+		SEE_ALSO:              assets.NewRelationField("seeAlso"),
+		SYNONYMS:              assets.NewRelationField("synonyms"),
+		ANTONYMS:              assets.NewRelationField("antonyms"),
+		PREFERRED_TERMS:       assets.NewRelationField("preferredTerms"),
+		PREFERRED_TO_TERMS:    assets.NewRelationField("preferredToTerms"),
+		REPLACED_BY:           assets.NewRelationField("replacedBy"),
+		REPLACEMENT_TERMS:     assets.NewRelationField("replacementTerms"),
+		TRANSLATION_TERMS:     assets.NewRelationField("translationTerms"),
+		TRANSLATED_TERMS:      assets.NewRelationField("translatedTerms"),
+		IS_A:                  assets.NewRelationField("isA"),
+		CLASSIFIES:            assets.NewRelationField("classifies"),
+		VALID_VALUES:          assets.NewRelationField("validValues"),
+		VALID_VALUES_FOR:      assets.NewRelationField("validValuesFor"),
+	}
+}
+
+// AuthPolicyFields holds the searchable fields of AuthPolicy assets.
+// AuthPolicy: Instance of an access policy of a persona or purpose in Atlan.
+type AuthPolicyFields struct {
+	AssetFields
+	// POLICY_TYPE Type of the policy, for example allow or deny.
+	POLICY_TYPE *assets.KeywordField
+	// POLICY_SERVICE_NAME Name of the service the policy is enforced by.
+	POLICY_SERVICE_NAME *assets.KeywordField
+	// POLICY_CATEGORY Category of the policy, for example persona or purpose.
+	POLICY_CATEGORY *assets.KeywordField
+	// POLICY_SUB_CATEGORY Subcategory of the policy, for example metadata or data.
+	POLICY_SUB_CATEGORY *assets.KeywordField
+	// POLICY_USERS Users the policy applies to.
+	POLICY_USERS *assets.KeywordField
+	// POLICY_GROUPS Groups the policy applies to.
+	POLICY_GROUPS *assets.KeywordField
+	// POLICY_ROLES Roles the policy applies to.
+	POLICY_ROLES *assets.KeywordField
+	// POLICY_ACTIONS Actions the policy allows or denies.
+	POLICY_ACTIONS *assets.KeywordField
+	// POLICY_RESOURCES Resources the policy applies to.
+	POLICY_RESOURCES *assets.KeywordField
+	// POLICY_RESOURCE_CATEGORY Category of the resources the policy applies to.
+	POLICY_RESOURCE_CATEGORY *assets.KeywordField
+	// POLICY_PRIORITY Priority of the policy over the others that apply.
+	POLICY_PRIORITY *assets.NumericField
+	// IS_POLICY_ENABLED Whether the policy is enabled (true) or not (false).
+	IS_POLICY_ENABLED *assets.BooleanField
+	// POLICY_MASK_TYPE Type of masking the policy applies to data.
+	POLICY_MASK_TYPE *assets.KeywordField
+	// POLICY_RESOURCE_SIGNATURE Signature of the resources of the policy.
+	POLICY_RESOURCE_SIGNATURE *assets.KeywordField
+	// POLICY_DELEGATE_ADMIN Whether the users of the policy can administer it (true) or not (false).
+	POLICY_DELEGATE_ADMIN *assets.BooleanField
+	// ACCESS_CONTROL Access control (persona or purpose) the policy belongs to.
+	ACCESS_CONTROL *assets.RelationField
+}
+
+func newAuthPolicyFields() AuthPolicyFields {
+	return AuthPolicyFields{
+		AssetFields:               newAssetFields(),
+		POLICY_TYPE:               assets.NewKeywordField("policyType", "policyType"),
+		POLICY_SERVICE_NAME:       assets.NewKeywordField("policyServiceName", "policyServiceName"),
+		POLICY_CATEGORY:           assets.NewKeywordField("policyCategory", "policyCategory"),
+		POLICY_SUB_CATEGORY:       assets.NewKeywordField("policySubCategory", "policySubCategory"),
+		POLICY_USERS:              assets.NewKeywordField("policyUsers", "policyUsers"),
+		POLICY_GROUPS:             assets.NewKeywordField("policyGroups", "policyGroups"),
+		POLICY_ROLES:              assets.NewKeywordField("policyRoles", "policyRoles"),
+		POLICY_ACTIONS:            assets.NewKeywordField("policyActions", "policyActions"),
+		POLICY_RESOURCES:          assets.NewKeywordField("policyResources", "policyResources"),
+		POLICY_RESOURCE_CATEGORY:  assets.NewKeywordField("policyResourceCategory", "policyResourceCategory"),
+		POLICY_PRIORITY:           assets.NewNumericField("policyPriority", "policyPriority"),
+		IS_POLICY_ENABLED:         assets.NewBooleanField("isPolicyEnabled", "isPolicyEnabled"),
+		POLICY_MASK_TYPE:          assets.NewKeywordField("policyMaskType", "policyMaskType"),
+		POLICY_RESOURCE_SIGNATURE: assets.NewKeywordField("policyResourceSignature", "policyResourceSignature"),
+		POLICY_DELEGATE_ADMIN:     assets.NewBooleanField("policyDelegateAdmin", "policyDelegateAdmin"),
+		ACCESS_CONTROL:            assets.NewRelationField("accessControl"),
+	}
+}
+
+// AuthServiceFields holds the searchable fields of AuthService assets.
+// AuthService: Instance of a service that enforces access policies in Atlan.
+type AuthServiceFields struct {
+	AssetFields
+	// AUTH_SERVICE_TYPE Type of the service.
+	AUTH_SERVICE_TYPE *assets.KeywordField
+	// TAG_SERVICE Name of the service that enforces the policies on Atlan tags.
+	TAG_SERVICE *assets.KeywordField
+	// AUTH_SERVICE_IS_ENABLED Whether the service is enabled (true) or not (false).
+	AUTH_SERVICE_IS_ENABLED *assets.BooleanField
+	// AUTH_SERVICE_CONFIG Configuration of the service.
+	AUTH_SERVICE_CONFIG *assets.KeywordField
+	// AUTH_SERVICE_POLICY_LAST_SYNC Time (epoch) at which the policies of the service were last synced, in milliseconds.
+	AUTH_SERVICE_POLICY_LAST_SYNC *assets.NumericField
+}
+
+func newAuthServiceFields() AuthServiceFields {
+	return AuthServiceFields{
+		AssetFields:                   newAssetFields(),
+		AUTH_SERVICE_TYPE:             assets.NewKeywordField("authServiceType", "authServiceType"),
+		TAG_SERVICE:                   assets.NewKeywordField("tagService", "tagService"),
+		AUTH_SERVICE_IS_ENABLED:       assets.NewBooleanField("authServiceIsEnabled", "authServiceIsEnabled"),
+		AUTH_SERVICE_CONFIG:           assets.NewKeywordField("authServiceConfig", "authServiceConfig"),
+		AUTH_SERVICE_POLICY_LAST_SYNC: assets.NewNumericField("authServicePolicyLastSync", "authServicePolicyLastSync"),
+	}
+}
+
+// BIFields holds the searchable fields of BI assets.
+// BI: Base class for BI assets.
+type BIFields struct {
+	CatalogFields
+}
+
+func newBIFields() BIFields {
+	return BIFields{
+		CatalogFields: newCatalogFields(),
+	}
+}
+
+// BIProcessFields holds the searchable fields of BIProcess assets.
+// BIProcess: Instance of a BI lineage process in Atlan.
+type BIProcessFields struct {
+	ProcessFields
+}
+
+func newBIProcessFields() BIProcessFields {
+	return BIProcessFields{
+		ProcessFields: newProcessFields(),
+	}
+}
+
+// CatalogFields holds the searchable fields of Catalog assets.
+// Catalog: Base class for catalog assets.
+type CatalogFields struct {
+	AssetFields
+	// INPUT_TO_PROCESSES Processes to which this asset provides input.
+	INPUT_TO_PROCESSES *assets.RelationField
+	// OUTPUT_FROM_PROCESSES Processes from which this asset is produced as output.
+	OUTPUT_FROM_PROCESSES *assets.RelationField
+}
+
+func newCatalogFields() CatalogFields {
+	return CatalogFields{
+		AssetFields:           newAssetFields(),
+		INPUT_TO_PROCESSES:    assets.NewRelationField("inputToProcesses"),
+		OUTPUT_FROM_PROCESSES: assets.NewRelationField("outputFromProcesses"),
+	}
+}
+
+// CloudFields holds the searchable fields of Cloud assets.
+// Cloud: Base class for cloud assets.
+type CloudFields struct {
+	AssetFields
+}
+
+func newCloudFields() CloudFields {
+	return CloudFields{
+		AssetFields: newAssetFields(),
+	}
+}
+
+// ColumnFields holds the searchable fields of Column assets.
+// Column: Instance of a column in Atlan.
+type ColumnFields struct {
+	SQLFields
+	// DATA_TYPE Data type of values in this column.
+	DATA_TYPE *assets.KeywordTextField
+	// SUB_DATA_TYPE Sub-data type of this column.
+	SUB_DATA_TYPE            *assets.KeywordField
+	RAW_DATA_TYPE_DEFINITION *assets.KeywordField
+	// ORDER Order (position) in which this column appears in the table (starting at 1).
+	ORDER               *assets.NumericField
+	NESTED_COLUMN_ORDER *assets.KeywordField
+	// NESTED_COLUMN_COUNT Number of columns nested within this (STRUCT or NESTED) column.
+	NESTED_COLUMN_COUNT *assets.NumericField
+	// IS_PARTITION Whether this column is a partition column (true) or not (false).
+	IS_PARTITION *assets.BooleanField
+	// PARTITION_ORDER Order (position) of this partition column in the table.
+	PARTITION_ORDER *assets.NumericField
+	// IS_CLUSTERED Whether this column is a cluster column (true) or not (false).
+	IS_CLUSTERED *assets.BooleanField
+	// IS_PRIMARY When true, this column is the primary key for the table.
+	IS_PRIMARY *assets.BooleanField
+	// IS_FOREIGN When true, this column is a foreign key to another table.
+	IS_FOREIGN *assets.BooleanField
+	// IS_INDEXED When true, this column is indexed in the database.
+	IS_INDEXED *assets.BooleanField
+	// IS_SORT Whether this column is a sort column (true) or not (false).
+	IS_SORT *assets.BooleanField
+	// IS_DIST Whether this column is a distribution column (true) or not (false).
+	IS_DIST *assets.BooleanField
+	// IS_PINNED Whether this column is pinned (true) or not (false).
+	IS_PINNED *assets.BooleanField
+	// PINNED_BY User who pinned this column.
+	PINNED_BY *assets.KeywordField
+	// PINNED_AT Time (epoch) at which this column was pinned, in milliseconds.
+	PINNED_AT *assets.NumericField
+	// PRECISION Total number of digits allowed, when the dataType is numeric.
+	PRECISION *assets.NumericField
+	// DEFAULT_VALUE Default value for this column.
+	DEFAULT_VALUE *assets.KeywordField
+	// IS_NULLABLE When true, the values in this column can be null.
+	IS_NULLABLE *assets.BooleanField
+	// NUMERIC_SCALE Number of digits allowed to the right of the decimal point.
+	NUMERIC_SCALE *assets.NumericField
+	// MAX_LENGTH Maximum length of a value in this column.
+	MAX_LENGTH *assets.NumericField
+	// COLUMN_DISTINCT_VALUES_COUNT Number of rows that contain distinct values.
+	COLUMN_DISTINCT_VALUES_COUNT *assets.NumericField
+	// COLUMN_MAX Greatest value in a numeric column.
+	COLUMN_MAX *assets.NumericField
+	// COLUMN_MIN Least value in a numeric column.
+	COLUMN_MIN *assets.NumericField
+	// COLUMN_MEAN Arithmetic mean of the values in a numeric column.
+	COLUMN_MEAN *assets.NumericField
+	// COLUMN_SUM Calculated sum of the values in a numeric column.
+	COLUMN_SUM *assets.NumericField
+	// COLUMN_MEDIAN Calculated median of the values in a numeric column.
+	COLUMN_MEDIAN *assets.NumericField
+	// COLUMN_STANDARD_DEVIATION Calculated standard deviation of the values in a numeric column.
+	COLUMN_STANDARD_DEVIATION *assets.NumericField
+	// COLUMN_UNIQUE_VALUES_COUNT Number of rows in which a value in this column appears only once.
+	COLUMN_UNIQUE_VALUES_COUNT *assets.NumericField
+	// COLUMN_AVERAGE Average value in this column.
+	COLUMN_AVERAGE *assets.NumericField
+	// COLUMN_MISSING_VALUES_COUNT Number of rows in a column that do not contain content.
+	COLUMN_MISSING_VALUES_COUNT *assets.NumericField
+	// COLUMN_DEPTH_LEVEL Level of nesting of this column, used for STRUCT and NESTED columns.
+	COLUMN_DEPTH_LEVEL *assets.NumericField
+	// TABLE Table in which this column exists.
+	TABLE *assets.RelationField
+	// VIEW View in which this column exists.
+	VIEW *assets.RelationField
+	// MATERIALISED_VIEW Materialized view in which this column exists.
+	MATERIALISED_VIEW *assets.RelationField
+	// PARENT_COLUMN Column in which this sub-column is nested.
+	PARENT_COLUMN *assets.RelationField
+	// NESTED_COLUMNS Nested columns that exist within this column.
+	NESTED_COLUMNS               *assets.RelationField
+	INPUT_TO_COLUMN_PROCESSES    *assets.RelationField
+	OUTPUT_FROM_COLUMN_PROCESSES *assets.RelationField
+}
+
+func newColumnFields() ColumnFields {
+	return ColumnFields{
+		SQLFields:                    newSQLFields(),
+		DATA_TYPE:                    assets.NewKeywordTextField("dataType", "dataType", "dataType.text"),
+		SUB_DATA_TYPE:                assets.NewKeywordField("subDataType", "subDataType"),
+		RAW_DATA_TYPE_DEFINITION:     assets.NewKeywordField("rawDataTypeDefinition", "rawDataTypeDefinition"),
+		ORDER:                        assets.NewNumericField("order", "order"),
+		NESTED_COLUMN_ORDER:          assets.NewKeywordField("nestedColumnOrder", "nestedColumnOrder"),
+		NESTED_COLUMN_COUNT:          assets.NewNumericField("nestedColumnCount", "nestedColumnCount"),
+		IS_PARTITION:                 assets.NewBooleanField("isPartition", "isPartition"),
+		PARTITION_ORDER:              assets.NewNumericField("partitionOrder", "partitionOrder"),
+		IS_CLUSTERED:                 assets.NewBooleanField("isClustered", "isClustered"),
+		IS_PRIMARY:                   assets.NewBooleanField("isPrimary", "isPrimary"),
+		IS_FOREIGN:                   assets.NewBooleanField("isForeign", "isForeign"),
+		IS_INDEXED:                   assets.NewBooleanField("isIndexed", "isIndexed"),
+		IS_SORT:                      assets.NewBooleanField("isSort", "isSort"),
+		IS_DIST:                      assets.NewBooleanField("isDist", "isDist"),
+		IS_PINNED:                    assets.NewBooleanField("isPinned", "isPinned"),
+		PINNED_BY:                    assets.NewKeywordField("pinnedBy", "pinnedBy"),
+		PINNED_AT:                    assets.NewNumericField("pinnedAt", "pinnedAt"),
+		PRECISION:                    assets.NewNumericField("precision", "precision"),
+		DEFAULT_VALUE:                assets.NewKeywordField("defaultValue", "defaultValue"),
+		IS_NULLABLE:                  assets.NewBooleanField("isNullable", "isNullable"),
+		NUMERIC_SCALE:                assets.NewNumericField("numericScale", "numericScale"),
+		MAX_LENGTH:                   assets.NewNumericField("maxLength", "maxLength"),
+		COLUMN_DISTINCT_VALUES_COUNT: assets.NewNumericField("columnDistinctValuesCount", "columnDistinctValuesCount"),
+		COLUMN_MAX:                   assets.NewNumericField("columnMax", "columnMax"),
+		COLUMN_MIN:                   assets.NewNumericField("columnMin", "columnMin"),
+		COLUMN_MEAN:                  assets.NewNumericField("columnMean", "columnMean"),
+		COLUMN_SUM:                   assets.NewNumericField("columnSum", "columnSum"),
+		COLUMN_MEDIAN:                assets.NewNumericField("columnMedian", "columnMedian"),
+		COLUMN_STANDARD_DEVIATION:    assets.NewNumericField("columnStandardDeviation", "columnStandardDeviation"),
+		COLUMN_UNIQUE_VALUES_COUNT:   assets.NewNumericField("columnUniqueValuesCount", "columnUniqueValuesCount"),
+		COLUMN_AVERAGE:               assets.NewNumericField("columnAverage", "columnAverage"),
+		COLUMN_MISSING_VALUES_COUNT:  assets.NewNumericField("columnMissingValuesCount", "columnMissingValuesCount"),
+		COLUMN_DEPTH_LEVEL:           assets.NewNumericField("columnDepthLevel", "columnDepthLevel"),
+		TABLE:                        assets.NewRelationField("table"),
+		VIEW:                         assets.NewRelationField("view"),
+		MATERIALISED_VIEW:            assets.NewRelationField("materialisedView"),
+		PARENT_COLUMN:                assets.NewRelationField("parentColumn"),
+		NESTED_COLUMNS:               assets.NewRelationField("nestedColumns"),
+		INPUT_TO_COLUMN_PROCESSES:    assets.NewRelationField("inputToColumnProcesses"),
+		OUTPUT_FROM_COLUMN_PROCESSES: assets.NewRelationField("outputFromColumnProcesses"),
+	}
+}
+
+// ColumnProcessFields holds the searchable fields of ColumnProcess assets.
+// ColumnProcess: Instance of a column-level lineage process in Atlan.
+type ColumnProcessFields struct {
+	ProcessFields
+	// PROCESS Parent process that contains this column-level process.
+	PROCESS *assets.RelationField
+}
+
+func newColumnProcessFields() ColumnProcessFields {
+	return ColumnProcessFields{
+		ProcessFields: newProcessFields(),
+		PROCESS:       assets.NewRelationField("process"),
+	}
+}
+
+// ConnectionFields holds the searchable fields of Connection assets.
+// Connection: Instance of a connection to a data source in Atlan.
+type ConnectionFields struct {
+	AssetFields
+	// CATEGORY Type of connection, for example WAREHOUSE, RDBMS, etc.
+	CATEGORY *assets.KeywordField
+	// SUB_CATEGORY Subcategory of this connection.
+	SUB_CATEGORY *assets.KeywordField
+	// HOST Host name of this connection's source.
+	HOST *assets.KeywordField
+	// PORT Port number to this connection's source.
+	PORT *assets.NumericField
+	// ALLOW_QUERY Whether using this connection to run queries on the source is allowed (true) or not (false).
+	ALLOW_QUERY *assets.BooleanField
+	// ALLOW_QUERY_PREVIEW Whether using this connection to run preview queries on the source is allowed (true) or not (false).
+	ALLOW_QUERY_PREVIEW *assets.BooleanField
+	// QUERY_PREVIEW_CONFIG Configuration for preview queries.
+	QUERY_PREVIEW_CONFIG *assets.KeywordField
+	// QUERY_CONFIG Query config for this connection.
+	QUERY_CONFIG *assets.KeywordField
+	// CREDENTIAL_STRATEGY Credential strategy to use for this connection for queries.
+	CREDENTIAL_STRATEGY *assets.KeywordField
+	// PREVIEW_CREDENTIAL_STRATEGY Credential strategy to use for this connection for preview queries.
+	PREVIEW_CREDENTIAL_STRATEGY *assets.KeywordField
+	// POLICY_STRATEGY Policy strategy is a configuration that determines whether the Atlan policy will be applied to the results of insight queries and whether the query will be rewritten, applicable for stream api call made from insight screen
+	POLICY_STRATEGY *assets.KeywordField
+	// QUERY_USERNAME_STRATEGY Username strategy to use for this connection for queries.
+	QUERY_USERNAME_STRATEGY *assets.KeywordField
+	// ROW_LIMIT Maximum number of rows that can be returned for the source.
+	ROW_LIMIT *assets.NumericField
+	// QUERY_TIMEOUT Maximum time a query should be allowed to run before timing out.
+	QUERY_TIMEOUT *assets.NumericField
+	// DEFAULT_CREDENTIAL_GUID Unique identifier (GUID) for the default credentials to use for this connection.
+	DEFAULT_CREDENTIAL_GUID *assets.KeywordField
+	// CONNECTOR_ICON Unused. Only the value of connectorType impacts icons.
+	CONNECTOR_ICON *assets.KeywordField
+	// CONNECTOR_IMAGE Unused. Only the value of connectorType impacts icons.
+	CONNECTOR_IMAGE *assets.KeywordField
+	// SOURCE_LOGO Unused. Only the value of connectorType impacts icons.
+	SOURCE_LOGO *assets.KeywordField
+	// IS_SAMPLE_DATA_PREVIEW_ENABLED Whether sample data can be previewed for this connection (true) or not (false).
+	IS_SAMPLE_DATA_PREVIEW_ENABLED *assets.BooleanField
+	// POPULARITY_INSIGHTS_TIMEFRAME Number of days over which popularity is calculated, for example 30 days.
+	POPULARITY_INSIGHTS_TIMEFRAME *assets.NumericField
+	// HAS_POPULARITY_INSIGHTS Whether this connection has popularity insights (true) or not (false).
+	HAS_POPULARITY_INSIGHTS     *assets.BooleanField
+	CONNECTION_DBT_ENVIRONMENTS *assets.KeywordField
+	// CONNECTION_SSO_CREDENTIAL_GUID Unique identifier (GUID) for the SSO credentials to use for this connection.
+	CONNECTION_SSO_CREDENTIAL_GUID *assets.KeywordField
+	// USE_OBJECT_STORAGE Whether to upload to S3, GCP, or another storage location (true) or not (false).
+	USE_OBJECT_STORAGE *assets.BooleanField
+	// OBJECT_STORAGE_UPLOAD_THRESHOLD Number of rows after which results should be uploaded to storage.
+	OBJECT_STORAGE_UPLOAD_THRESHOLD *assets.NumericField
+}
+
+func newConnectionFields() ConnectionFields {
+	return ConnectionFields{
+		AssetFields:                     newAssetFields(),
+		CATEGORY:                        assets.NewKeywordField("category", "category"),
+		SUB_CATEGORY:                    assets.NewKeywordField("subCategory", "subCategory"),
+		HOST:                            assets.NewKeywordField("host", "host"),
+		PORT:                            assets.NewNumericField("port", "port"),
+		ALLOW_QUERY:                     assets.NewBooleanField("allowQuery", "allowQuery"),
+		ALLOW_QUERY_PREVIEW:             assets.NewBooleanField("allowQueryPreview", "allowQueryPreview"),
+		QUERY_PREVIEW_CONFIG:            assets.NewKeywordField("queryPreviewConfig", "queryPreviewConfig"),
+		QUERY_CONFIG:                    assets.NewKeywordField("queryConfig", "queryConfig"),
+		CREDENTIAL_STRATEGY:             assets.NewKeywordField("credentialStrategy", "credentialStrategy"),
+		PREVIEW_CREDENTIAL_STRATEGY:     assets.NewKeywordField("previewCredentialStrategy", "previewCredentialStrategy"),
+		POLICY_STRATEGY:                 assets.NewKeywordField("policyStrategy", "policyStrategy"),
+		QUERY_USERNAME_STRATEGY:         assets.NewKeywordField("queryUsernameStrategy", "queryUsernameStrategy"),
+		ROW_LIMIT:                       assets.NewNumericField("rowLimit", "rowLimit"),
+		QUERY_TIMEOUT:                   assets.NewNumericField("queryTimeout", "queryTimeout"),
+		DEFAULT_CREDENTIAL_GUID:         assets.NewKeywordField("defaultCredentialGuid", "defaultCredentialGuid"),
+		CONNECTOR_ICON:                  assets.NewKeywordField("connectorIcon", "connectorIcon"),
+		CONNECTOR_IMAGE:                 assets.NewKeywordField("connectorImage", "connectorImage"),
+		SOURCE_LOGO:                     assets.NewKeywordField("sourceLogo", "sourceLogo"),
+		IS_SAMPLE_DATA_PREVIEW_ENABLED:  assets.NewBooleanField("isSampleDataPreviewEnabled", "isSampleDataPreviewEnabled"),
+		POPULARITY_INSIGHTS_TIMEFRAME:   assets.NewNumericField("popularityInsightsTimeframe", "popularityInsightsTimeframe"),
+		HAS_POPULARITY_INSIGHTS:         assets.NewBooleanField("hasPopularityInsights", "hasPopularityInsights"),
+		CONNECTION_DBT_ENVIRONMENTS:     assets.NewKeywordField("connectionDbtEnvironments", "connectionDbtEnvironments"),
+		CONNECTION_SSO_CREDENTIAL_GUID:  assets.NewKeywordField("connectionSSOCredentialGuid", "connectionSSOCredentialGuid"),
+		USE_OBJECT_STORAGE:              assets.NewBooleanField("useObjectStorage", "useObjectStorage"),
+		OBJECT_STORAGE_UPLOAD_THRESHOLD: assets.NewNumericField("objectStorageUploadThreshold", "objectStorageUploadThreshold"),
+	}
+}
+
+// DataContractFields holds the searchable fields of DataContract assets.
+// DataContract: Instance of a data contract for an asset in Atlan.
+type DataContractFields struct {
+	CatalogFields
+	// DATA_CONTRACT_JSON (Deprecated) Replaced by dataContractSpec.
+	DATA_CONTRACT_JSON *assets.KeywordField
+	// DATA_CONTRACT_SPEC Actual content of the contract in YAML.
+	DATA_CONTRACT_SPEC *assets.KeywordField
+	// DATA_CONTRACT_VERSION Version of the contract.
+	DATA_CONTRACT_VERSION *assets.NumericField
+	// DATA_CONTRACT_ASSET_GUID Unique identifier of the asset associated with the contract.
+	DATA_CONTRACT_ASSET_GUID *assets.KeywordField
+	// DATA_CONTRACT_ASSET_CERTIFIED Asset the contract is the latest certified version for.
+	DATA_CONTRACT_ASSET_CERTIFIED *assets.RelationField
+	// DATA_CONTRACT_ASSET_LATEST Asset the contract is the latest version for.
+	DATA_CONTRACT_ASSET_LATEST *assets.RelationField
+	// DATA_CONTRACT_PREVIOUS_VERSION Previous version of the contract.
+	DATA_CONTRACT_PREVIOUS_VERSION *assets.RelationField
+	// DATA_CONTRACT_NEXT_VERSION Next version of the contract.
+	DATA_CONTRACT_NEXT_VERSION *assets.RelationField
+}
+
+func newDataContractFields() DataContractFields {
+	return DataContractFields{
+		CatalogFields:                  newCatalogFields(),
+		DATA_CONTRACT_JSON:             assets.NewKeywordField("dataContractJson", "dataContractJson"),
+		DATA_CONTRACT_SPEC:             assets.NewKeywordField("dataContractSpec", "dataContractSpec"),
+		DATA_CONTRACT_VERSION:          assets.NewNumericField("dataContractVersion", "dataContractVersion"),
+		DATA_CONTRACT_ASSET_GUID:       assets.NewKeywordField("dataContractAssetGuid", "dataContractAssetGuid"),
+		DATA_CONTRACT_ASSET_CERTIFIED:  assets.NewRelationField("dataContractAssetCertified"),
+		DATA_CONTRACT_ASSET_LATEST:     assets.NewRelationField("dataContractAssetLatest"),
+		DATA_CONTRACT_PREVIOUS_VERSION: assets.NewRelationField("dataContractPreviousVersion"),
+		DATA_CONTRACT_NEXT_VERSION:     assets.NewRelationField("dataContractNextVersion"),
+	}
+}
+
+// DatabaseFields holds the searchable fields of Database assets.
+// Database: Instance of a database in Atlan.
+type DatabaseFields struct {
+	SQLFields
+	// SCHEMA_COUNT Number of schemas in this database.
+	SCHEMA_COUNT *assets.NumericField
+	// SCHEMAS Schemas that exist within this database.
+	SCHEMAS *assets.RelationField
+}
+
+func newDatabaseFields() DatabaseFields {
+	return DatabaseFields{
+		SQLFields:    newSQLFields(),
+		SCHEMA_COUNT: assets.NewNumericField("schemaCount", "schemaCount"),
+		SCHEMAS:      assets.NewRelationField("schemas"),
+	}
+}
+
+// DbtFields holds the searchable fields of Dbt assets.
+// Dbt: Base class for dbt assets.
+type DbtFields struct {
+	CatalogFields
+	DBT_ALIAS                    *assets.KeywordField
+	DBT_META                     *assets.KeywordField
+	DBT_UNIQUE_ID                *assets.KeywordTextField
+	DBT_ACCOUNT_NAME             *assets.KeywordTextField
+	DBT_PROJECT_NAME             *assets.KeywordTextField
+	DBT_PACKAGE_NAME             *assets.KeywordTextField
+	DBT_JOB_NAME                 *assets.KeywordTextField
+	DBT_JOB_SCHEDULE             *assets.KeywordField
+	DBT_JOB_STATUS               *assets.KeywordField
+	DBT_JOB_LAST_RUN             *assets.NumericField
+	DBT_JOB_NEXT_RUN             *assets.NumericField
+	DBT_ENVIRONMENT_NAME         *assets.KeywordTextField
+	DBT_CONNECTION_CONTEXT       *assets.KeywordField
+	DBT_SEMANTIC_LAYER_PROXY_URL *assets.KeywordField
+	// DBT_TAGS List of tags attached to this asset.
+	DBT_TAGS *assets.KeywordField
+}
+
+func newDbtFields() DbtFields {
+	return DbtFields{
+		CatalogFields:                newCatalogFields(),
+		DBT_ALIAS:                    assets.NewKeywordField("dbtAlias", "dbtAlias"),
+		DBT_META:                     assets.NewKeywordField("dbtMeta", "dbtMeta"),
+		DBT_UNIQUE_ID:                assets.NewKeywordTextField("dbtUniqueId", "dbtUniqueId", "dbtUniqueId.text"),
+		DBT_ACCOUNT_NAME:             assets.NewKeywordTextField("dbtAccountName", "dbtAccountName", "dbtAccountName.text"),
+		DBT_PROJECT_NAME:             assets.NewKeywordTextField("dbtProjectName", "dbtProjectName", "dbtProjectName.text"),
+		DBT_PACKAGE_NAME:             assets.NewKeywordTextField("dbtPackageName", "dbtPackageName", "dbtPackageName.text"),
+		DBT_JOB_NAME:                 assets.NewKeywordTextField("dbtJobName", "dbtJobName", "dbtJobName.text"),
+		DBT_JOB_SCHEDULE:             assets.NewKeywordField("dbtJobSchedule", "dbtJobSchedule"),
+		DBT_JOB_STATUS:               assets.NewKeywordField("dbtJobStatus", "dbtJobStatus"),
+		DBT_JOB_LAST_RUN:             assets.NewNumericField("dbtJobLastRun", "dbtJobLastRun"),
+		DBT_JOB_NEXT_RUN:             assets.NewNumericField("dbtJobNextRun", "dbtJobNextRun"),
+		DBT_ENVIRONMENT_NAME:         assets.NewKeywordTextField("dbtEnvironmentName", "dbtEnvironmentName", "dbtEnvironmentName.text"),
+		DBT_CONNECTION_CONTEXT:       assets.NewKeywordField("dbtConnectionContext", "dbtConnectionContext"),
+		DBT_SEMANTIC_LAYER_PROXY_URL: assets.NewKeywordField("dbtSemanticLayerProxyUrl", "dbtSemanticLayerProxyUrl"),
+		DBT_TAGS:                     assets.NewKeywordField("dbtTags", "dbtTags"),
+	}
+}
+
+// DbtModelFields holds the searchable fields of DbtModel assets.
+// DbtModel: Instance of a dbt model in Atlan.
+type DbtModelFields struct {
+	DbtFields
+	DBT_STATUS               *assets.KeywordField
+	DBT_ERROR                *assets.KeywordField
+	DBT_RAW_SQL              *assets.KeywordField
+	DBT_COMPILED_SQL         *assets.KeywordField
+	DBT_STATS                *assets.KeywordField
+	DBT_MATERIALIZATION_TYPE *assets.KeywordField
+	DBT_MODEL_COMPILED_AT    *assets.NumericField
+	DBT_MODEL_EXECUTION_TIME *assets.NumericField
+	DBT_MODEL_COLUMNS        *assets.RelationField
+	SQL_ASSET                *assets.RelationField
+	DBT_TESTS                *assets.RelationField
+}
+
+func newDbtModelFields() DbtModelFields {
+	return DbtModelFields{
+		DbtFields:                newDbtFields(),
+		DBT_STATUS:               assets.NewKeywordField("dbtStatus", "dbtStatus"),
+		DBT_ERROR:                assets.NewKeywordField("dbtError", "dbtError"),
+		DBT_RAW_SQL:              assets.NewKeywordField("dbtRawSQL", "dbtRawSQL"),
+		DBT_COMPILED_SQL:         assets.NewKeywordField("dbtCompiledSQL", "dbtCompiledSQL"),
+		DBT_STATS:                assets.NewKeywordField("dbtStats", "dbtStats"),
+		DBT_MATERIALIZATION_TYPE: assets.NewKeywordField("dbtMaterializationType", "dbtMaterializationType"),
+		DBT_MODEL_COMPILED_AT:    assets.NewNumericField("dbtModelCompiledAt", "dbtModelCompiledAt"),
+		DBT_MODEL_EXECUTION_TIME: assets.NewNumericField("dbtModelExecutionTime", "dbtModelExecutionTime"),
+		DBT_MODEL_COLUMNS:        assets.NewRelationField("dbtModelColumns"),
+		SQL_ASSET:                assets.NewRelationField("sqlAsset"),
+		DBT_TESTS:                assets.NewRelationField("dbtTests"),
+	}
+}
+
+// DbtModelColumnFields holds the searchable fields of DbtModelColumn assets.
+// DbtModelColumn: Instance of a column of a dbt model in Atlan.
+type DbtModelColumnFields struct {
+	DbtFields
+	DBT_MODEL_QUALIFIED_NAME   *assets.KeywordField
+	DBT_MODEL_COLUMN_DATA_TYPE *assets.KeywordField
+	DBT_MODEL_COLUMN_ORDER     *assets.NumericField
+	DBT_MODEL                  *assets.RelationField
+	SQL_COLUMN                 *assets.RelationField
+	DBT_TESTS                  *assets.RelationField
+}
+
+func newDbtModelColumnFields() DbtModelColumnFields {
+	return DbtModelColumnFields{
+		DbtFields:                  newDbtFields(),
+		DBT_MODEL_QUALIFIED_NAME:   assets.NewKeywordField("dbtModelQualifiedName", "dbtModelQualifiedName"),
+		DBT_MODEL_COLUMN_DATA_TYPE: assets.NewKeywordField("dbtModelColumnDataType", "dbtModelColumnDataType"),
+		DBT_MODEL_COLUMN_ORDER:     assets.NewNumericField("dbtModelColumnOrder", "dbtModelColumnOrder"),
+		DBT_MODEL:                  assets.NewRelationField("dbtModel"),
+		SQL_COLUMN:                 assets.NewRelationField("sqlColumn"),
+		DBT_TESTS:                  assets.NewRelationField("dbtTests"),
+	}
+}
+
+// DbtSourceFields holds the searchable fields of DbtSource assets.
+// DbtSource: Instance of a dbt source in Atlan.
+type DbtSourceFields struct {
+	DbtFields
+	DBT_STATE              *assets.KeywordField
+	DBT_FRESHNESS_CRITERIA *assets.KeywordField
+	SQL_ASSETS             *assets.RelationField
+	SQL_ASSET              *assets.RelationField
+	DBT_TESTS              *assets.RelationField
+}
+
+func newDbtSourceFields() DbtSourceFields {
+	return DbtSourceFields{
+		DbtFields:              newDbtFields(),
+		DBT_STATE:              assets.NewKeywordField("dbtState", "dbtState"),
+		DBT_FRESHNESS_CRITERIA: assets.NewKeywordField("dbtFreshnessCriteria", "dbtFreshnessCriteria"),
+		SQL_ASSETS:             assets.NewRelationField("sqlAssets"),
+		SQL_ASSET:              assets.NewRelationField("sqlAsset"),
+		DBT_TESTS:              assets.NewRelationField("dbtTests"),
+	}
+}
+
+// DbtTestFields holds the searchable fields of DbtTest assets.
+// DbtTest: Instance of a dbt test in Atlan.
+type DbtTestFields struct {
+	DbtFields
+	// DBT_TEST_STATUS Details of the results of the test. For errors, it reads "ERROR".
+	DBT_TEST_STATUS *assets.KeywordField
+	// DBT_TEST_STATE Test results. Can be one of, in order of severity, "error", "fail", "warn", "pass".
+	DBT_TEST_STATE *assets.KeywordField
+	// DBT_TEST_ERROR Error message in the case of state being "error".
+	DBT_TEST_ERROR *assets.KeywordField
+	// DBT_TEST_RAW_SQL Raw SQL of the test.
+	DBT_TEST_RAW_SQL *assets.KeywordTextField
+	// DBT_TEST_COMPILED_SQL Compiled SQL of the test.
+	DBT_TEST_COMPILED_SQL *assets.KeywordField
+	// DBT_TEST_RAW_CODE Raw code of the test (when the test is defined using Python).
+	DBT_TEST_RAW_CODE *assets.KeywordTextField
+	// DBT_TEST_COMPILED_CODE Compiled code of the test (when the test is defined using Python).
+	DBT_TEST_COMPILED_CODE *assets.KeywordField
+	// DBT_TEST_LANGUAGE Language in which the test is written, for example: SQL or Python.
+	DBT_TEST_LANGUAGE *assets.KeywordField
+	DBT_SOURCES       *assets.RelationField
+	SQL_ASSETS        *assets.RelationField
+	DBT_MODELS        *assets.RelationField
+	DBT_MODEL_COLUMNS *assets.RelationField
+}
+
+func newDbtTestFields() DbtTestFields {
+	return DbtTestFields{
+		DbtFields:              newDbtFields(),
+		DBT_TEST_STATUS:        assets.NewKeywordField("dbtTestStatus", "dbtTestStatus"),
+		DBT_TEST_STATE:         assets.NewKeywordField("dbtTestState", "dbtTestState"),
+		DBT_TEST_ERROR:         assets.NewKeywordField("dbtTestError", "dbtTestError"),
+		DBT_TEST_RAW_SQL:       assets.NewKeywordTextField("dbtTestRawSQL", "dbtTestRawSQL", "dbtTestRawSQL.text"),
+		DBT_TEST_COMPILED_SQL:  assets.NewKeywordField("dbtTestCompiledSQL", "dbtTestCompiledSQL"),
+		DBT_TEST_RAW_CODE:      assets.NewKeywordTextField("dbtTestRawCode", "dbtTestRawCode", "dbtTestRawCode.text"),
+		DBT_TEST_COMPILED_CODE: assets.NewKeywordField("dbtTestCompiledCode", "dbtTestCompiledCode"),
+		DBT_TEST_LANGUAGE:      assets.NewKeywordField("dbtTestLanguage", "dbtTestLanguage"),
+		DBT_SOURCES:            assets.NewRelationField("dbtSources"),
+		SQL_ASSETS:             assets.NewRelationField("sqlAssets"),
+		DBT_MODELS:             assets.NewRelationField("dbtModels"),
+		DBT_MODEL_COLUMNS:      assets.NewRelationField("dbtModelColumns"),
+	}
+}
+
+// EventStoreFields holds the searchable fields of EventStore assets.
+// EventStore: Base class for event store assets.
+type EventStoreFields struct {
+	CatalogFields
+}
+
+func newEventStoreFields() EventStoreFields {
+	return EventStoreFields{
+		CatalogFields: newCatalogFields(),
+	}
+}
+
+// KafkaFields holds the searchable fields of Kafka assets.
+// Kafka: Base class for Kafka assets.
+type KafkaFields struct {
+	EventStoreFields
+}
+
+func newKafkaFields() KafkaFields {
+	return KafkaFields{
+		EventStoreFields: newEventStoreFields(),
+	}
+}
+
+// KafkaConsumerGroupFields holds the searchable fields of KafkaConsumerGroup assets.
+// KafkaConsumerGroup: Instance of a Kafka consumer group in Atlan.
+type KafkaConsumerGroupFields struct {
+	KafkaFields
+	// KAFKA_CONSUMER_GROUP_MEMBER_COUNT Number of members in this consumer group.
+	KAFKA_CONSUMER_GROUP_MEMBER_COUNT *assets.NumericField
+	// KAFKA_TOPIC_NAMES Simple names of the topics consumed by this consumer group.
+	KAFKA_TOPIC_NAMES *assets.KeywordField
+	// KAFKA_TOPIC_QUALIFIED_NAMES Unique names of the topics consumed by this consumer group.
+	KAFKA_TOPIC_QUALIFIED_NAMES *assets.KeywordField
+	// KAFKA_TOPICS Topics consumed by this consumer group.
+	KAFKA_TOPICS *assets.RelationField
+}
+
+func newKafkaConsumerGroupFields() KafkaConsumerGroupFields {
+	return KafkaConsumerGroupFields{
+		KafkaFields:                       newKafkaFields(),
+		KAFKA_CONSUMER_GROUP_MEMBER_COUNT: assets.NewNumericField("kafkaConsumerGroupMemberCount", "kafkaConsumerGroupMemberCount"),
+		KAFKA_TOPIC_NAMES:                 assets.NewKeywordField("kafkaTopicNames", "kafkaTopicNames"),
+		KAFKA_TOPIC_QUALIFIED_NAMES:       assets.NewKeywordField("kafkaTopicQualifiedNames", "kafkaTopicQualifiedNames"),
+		KAFKA_TOPICS:                      assets.NewRelationField("kafkaTopics"),
+	}
+}
+
+// KafkaTopicFields holds the searchable fields of KafkaTopic assets.
+// KafkaTopic: Instance of a Kafka topic in Atlan.
+type KafkaTopicFields struct {
+	KafkaFields
+	// KAFKA_TOPIC_IS_INTERNAL Whether this topic is an internal topic (true) or not (false).
+	KAFKA_TOPIC_IS_INTERNAL *assets.BooleanField
+	// KAFKA_TOPIC_COMPRESSION_TYPE Type of compression used for this topic.
+	KAFKA_TOPIC_COMPRESSION_TYPE *assets.KeywordField
+	// KAFKA_TOPIC_REPLICATION_FACTOR Replication factor for this topic.
+	KAFKA_TOPIC_REPLICATION_FACTOR *assets.NumericField
+	// KAFKA_TOPIC_SEGMENT_BYTES Segment size for this topic.
+	KAFKA_TOPIC_SEGMENT_BYTES *assets.NumericField
+	// KAFKA_TOPIC_RETENTION_TIME_IN_MS Amount of time messages will be retained in this topic, in milliseconds.
+	KAFKA_TOPIC_RETENTION_TIME_IN_MS *assets.NumericField
+	// KAFKA_TOPIC_PARTITIONS_COUNT Number of partitions for this topic.
+	KAFKA_TOPIC_PARTITIONS_COUNT *assets.NumericField
+	// KAFKA_TOPIC_SIZE_IN_BYTES Size of this topic, in bytes.
+	KAFKA_TOPIC_SIZE_IN_BYTES *assets.NumericField
+	// KAFKA_TOPIC_RECORD_COUNT Number of (unexpired) messages in this topic.
+	KAFKA_TOPIC_RECORD_COUNT *assets.NumericField
+	// KAFKA_TOPIC_CLEANUP_POLICY Cleanup policy for this topic.
+	KAFKA_TOPIC_CLEANUP_POLICY *assets.KeywordField
+	// KAFKA_CONSUMER_GROUPS Consumer groups subscribed to this topic.
+	KAFKA_CONSUMER_GROUPS *assets.RelationField
+}
+
+func newKafkaTopicFields() KafkaTopicFields {
+	return KafkaTopicFields{
+		KafkaFields:                      newKafkaFields(),
+		KAFKA_TOPIC_IS_INTERNAL:          assets.NewBooleanField("kafkaTopicIsInternal", "kafkaTopicIsInternal"),
+		KAFKA_TOPIC_COMPRESSION_TYPE:     assets.NewKeywordField("kafkaTopicCompressionType", "kafkaTopicCompressionType"),
+		KAFKA_TOPIC_REPLICATION_FACTOR:   assets.NewNumericField("kafkaTopicReplicationFactor", "kafkaTopicReplicationFactor"),
+		KAFKA_TOPIC_SEGMENT_BYTES:        assets.NewNumericField("kafkaTopicSegmentBytes", "kafkaTopicSegmentBytes"),
+		KAFKA_TOPIC_RETENTION_TIME_IN_MS: assets.NewNumericField("kafkaTopicRetentionTimeInMs", "kafkaTopicRetentionTimeInMs"),
+		KAFKA_TOPIC_PARTITIONS_COUNT:     assets.NewNumericField("kafkaTopicPartitionsCount", "kafkaTopicPartitionsCount"),
+		KAFKA_TOPIC_SIZE_IN_BYTES:        assets.NewNumericField("kafkaTopicSizeInBytes", "kafkaTopicSizeInBytes"),
+		KAFKA_TOPIC_RECORD_COUNT:         assets.NewNumericField("kafkaTopicRecordCount", "kafkaTopicRecordCount"),
+		KAFKA_TOPIC_CLEANUP_POLICY:       assets.NewKeywordField("kafkaTopicCleanupPolicy", "kafkaTopicCleanupPolicy"),
+		KAFKA_CONSUMER_GROUPS:            assets.NewRelationField("kafkaConsumerGroups"),
+	}
+}
+
+// MaterialisedViewFields holds the searchable fields of MaterialisedView assets.
+// MaterialisedView: Instance of a database materialized view in Atlan.
+type MaterialisedViewFields struct {
+	SQLFields
+	// REFRESH_MODE Refresh mode for this materialized view.
+	REFRESH_MODE *assets.KeywordField
+	// REFRESH_METHOD Refresh method for this materialized view.
+	REFRESH_METHOD *assets.KeywordField
+	// STALENESS Staleness of this materialized view.
+	STALENESS *assets.KeywordField
+	// STALE_SINCE_DATE Time (epoch) from which this materialized view is stale, in milliseconds.
+	STALE_SINCE_DATE *assets.NumericField
+	// COLUMN_COUNT Number of columns in this table.
+	COLUMN_COUNT *assets.NumericField
+	// ROW_COUNT Number of rows in this table.
+	ROW_COUNT *assets.NumericField
+	// SIZE_BYTES Size of this table, in bytes.
+	SIZE_BYTES *assets.NumericField
+	// ALIAS Alias for this table.
+	ALIAS *assets.KeywordField
+	// IS_TEMPORARY Whether this table is temporary (true) or not (false).
+	IS_TEMPORARY *assets.BooleanField
+	// IS_QUERY_PREVIEW Whether preview queries are allowed for this table (true) or not (false).
+	IS_QUERY_PREVIEW *assets.BooleanField
+	// QUERY_PREVIEW_CONFIG Configuration for preview queries.
+	QUERY_PREVIEW_CONFIG *assets.KeywordField
+	// DEFINITION SQL definition of this materialized view.
+	DEFINITION *assets.KeywordField
+	// COLUMNS Columns that exist within this materialized view.
+	COLUMNS *assets.RelationField
+	// ATLAN_SCHEMA Schema in which this materialized view exists.
+	ATLAN_SCHEMA *assets.RelationField
+}
+
+func newMaterialisedViewFields() MaterialisedViewFields {
+	return MaterialisedViewFields{
+		SQLFields:            newSQLFields(),
+		REFRESH_MODE:         assets.NewKeywordField("refreshMode", "refreshMode"),
+		REFRESH_METHOD:       assets.NewKeywordField("refreshMethod", "refreshMethod"),
+		STALENESS:            assets.NewKeywordField("staleness", "staleness"),
+		STALE_SINCE_DATE:     assets.NewNumericField("staleSinceDate", "staleSinceDate"),
+		COLUMN_COUNT:         assets.NewNumericField("columnCount", "columnCount"),
+		ROW_COUNT:            assets.NewNumericField("rowCount", "rowCount"),
+		SIZE_BYTES:           assets.NewNumericField("sizeBytes", "sizeBytes"),
+		ALIAS:                assets.NewKeywordField("alias", "alias"),
+		IS_TEMPORARY:         assets.NewBooleanField("isTemporary", "isTemporary"),
+		IS_QUERY_PREVIEW:     assets.NewBooleanField("isQueryPreview", "isQueryPreview"),
+		QUERY_PREVIEW_CONFIG: assets.NewKeywordField("queryPreviewConfig", "queryPreviewConfig"),
+		DEFINITION:           assets.NewKeywordField("definition", "definition"),
+		COLUMNS:              assets.NewRelationField("columns"),
+		ATLAN_SCHEMA:         assets.NewRelationField("atlanSchema"),
+	}
+}
+
+// ObjectStoreFields holds the searchable fields of ObjectStore assets.
+// ObjectStore: Base class for object store assets.
+type ObjectStoreFields struct {
+	CatalogFields
+}
+
+func newObjectStoreFields() ObjectStoreFields {
+	return ObjectStoreFields{
+		CatalogFields: newCatalogFields(),
+	}
+}
+
+// PersonaFields holds the searchable fields of Persona assets.
+// Persona: Instance of a persona in Atlan, which grants its users and groups access to assets.
+type PersonaFields struct {
+	AccessControlFields
+	// PERSONA_GROUPS Groups to which the persona is assigned.
+	PERSONA_GROUPS *assets.KeywordField
+	// PERSONA_USERS Users to whom the persona is assigned.
+	PERSONA_USERS *assets.KeywordField
+	// ROLE_ID ID of the role that backs the persona.
+	ROLE_ID *assets.KeywordField
+}
+
+func newPersonaFields() PersonaFields {
+	return PersonaFields{
+		AccessControlFields: newAccessControlFields(),
+		PERSONA_GROUPS:      assets.NewKeywordField("personaGroups", "personaGroups"),
+		PERSONA_USERS:       assets.NewKeywordField("personaUsers", "personaUsers"),
+		ROLE_ID:             assets.NewKeywordField("roleId", "roleId"),
+	}
+}
+
+// PowerBIFields holds the searchable fields of PowerBI assets.
+// PowerBI: Base class for Power BI assets.
+type PowerBIFields struct {
+	BIFields
+	// POWER_BI_IS_HIDDEN Whether this asset is hidden in Power BI (true) or not (false).
+	POWER_BI_IS_HIDDEN *assets.BooleanField
+	// POWER_BI_TABLE_QUALIFIED_NAME Unique name of the Power BI table in which this asset exists.
+	POWER_BI_TABLE_QUALIFIED_NAME *assets.KeywordTextField
+	// POWER_BI_FORMAT_STRING Format of this asset, as specified in the FORMAT_STRING of the MDX cell property.
+	POWER_BI_FORMAT_STRING *assets.KeywordField
+	// POWER_BI_ENDORSEMENT Endorsement status of this asset, in Power BI.
+	POWER_BI_ENDORSEMENT *assets.KeywordField
+}
+
+func newPowerBIFields() PowerBIFields {
+	return PowerBIFields{
+		BIFields:                      newBIFields(),
+		POWER_BI_IS_HIDDEN:            assets.NewBooleanField("powerBIIsHidden", "powerBIIsHidden"),
+		POWER_BI_TABLE_QUALIFIED_NAME: assets.NewKeywordTextField("powerBITableQualifiedName", "powerBITableQualifiedName", "powerBITableQualifiedName.text"),
+		POWER_BI_FORMAT_STRING:        assets.NewKeywordField("powerBIFormatString", "powerBIFormatString"),
+		POWER_BI_ENDORSEMENT:          assets.NewKeywordField("powerBIEndorsement", "powerBIEndorsement"),
+	}
+}
+
+// PowerBIDashboardFields holds the searchable fields of PowerBIDashboard assets.
+// PowerBIDashboard: Instance of a Power BI dashboard in Atlan.
+type PowerBIDashboardFields struct {
+	PowerBIFields
+	// WORKSPACE_QUALIFIED_NAME Unique name of the workspace in which this dashboard exists.
+	WORKSPACE_QUALIFIED_NAME *assets.KeywordField
+	// WEB_URL Deprecated. See 'sourceUrl' instead.
+	WEB_URL *assets.KeywordField
+	// TILE_COUNT Number of tiles in this table.
+	TILE_COUNT *assets.NumericField
+	// WORKSPACE Workspace in which this dashboard exists.
+	WORKSPACE *assets.RelationField
+}
+
+func newPowerBIDashboardFields() PowerBIDashboardFields {
+	return PowerBIDashboardFields{
+		PowerBIFields:            newPowerBIFields(),
+		WORKSPACE_QUALIFIED_NAME: assets.NewKeywordField("workspaceQualifiedName", "workspaceQualifiedName"),
+		WEB_URL:                  assets.NewKeywordField("webUrl", "webUrl"),
+		TILE_COUNT:               assets.NewNumericField("tileCount", "tileCount"),
+		WORKSPACE:                assets.NewRelationField("workspace"),
+	}
+}
+
+// PowerBIReportFields holds the searchable fields of PowerBIReport assets.
+// PowerBIReport: Instance of a Power BI report in Atlan.
+type PowerBIReportFields struct {
+	PowerBIFields
+	// WORKSPACE_QUALIFIED_NAME Unique name of the workspace in which this report exists.
+	WORKSPACE_QUALIFIED_NAME *assets.KeywordField
+	// DATASET_QUALIFIED_NAME Unique name of the dataset used to build this report.
+	DATASET_QUALIFIED_NAME *assets.KeywordField
+	// WEB_URL Deprecated. See 'sourceUrl' instead.
+	WEB_URL *assets.KeywordField
+	// PAGE_COUNT Number of pages in this report.
+	PAGE_COUNT *assets.NumericField
+	// WORKSPACE Workspace in which this report exists.
+	WORKSPACE *assets.RelationField
+}
+
+func newPowerBIReportFields() PowerBIReportFields {
+	return PowerBIReportFields{
+		PowerBIFields:            newPowerBIFields(),
+		WORKSPACE_QUALIFIED_NAME: assets.NewKeywordField("workspaceQualifiedName", "workspaceQualifiedName"),
+		DATASET_QUALIFIED_NAME:   assets.NewKeywordField("datasetQualifiedName", "datasetQualifiedName"),
+		WEB_URL:                  assets.NewKeywordField("webUrl", "webUrl"),
+		PAGE_COUNT:               assets.NewNumericField("pageCount", "pageCount"),
+		WORKSPACE:                assets.NewRelationField("workspace"),
+	}
+}
+
+// PowerBIWorkspaceFields holds the searchable fields of PowerBIWorkspace assets.
+// PowerBIWorkspace: Instance of a Power BI workspace in Atlan.
+type PowerBIWorkspaceFields struct {
+	PowerBIFields
+	// WEB_URL Deprecated.
+	WEB_URL *assets.KeywordField
+	// REPORT_COUNT Number of reports in this workspace.
+	REPORT_COUNT *assets.NumericField
+	// DASHBOARD_COUNT Number of dashboards in this workspace.
+	DASHBOARD_COUNT *assets.NumericField
+	// DATASET_COUNT Number of datasets in this workspace.
+	DATASET_COUNT *assets.NumericField
+	// DATAFLOW_COUNT Number of dataflows in this workspace.
+	DATAFLOW_COUNT *assets.NumericField
+	// REPORTS Reports that exist within this workspace.
+	REPORTS *assets.RelationField
+	// DASHBOARDS Dashboards that exist within this workspace.
+	DASHBOARDS *assets.RelationField
+}
+
+func newPowerBIWorkspaceFields() PowerBIWorkspaceFields {
+	return PowerBIWorkspaceFields{
+		PowerBIFields:   newPowerBIFields(),
+		WEB_URL:         assets.NewKeywordField("webUrl", "webUrl"),
+		REPORT_COUNT:    assets.NewNumericField("reportCount", "reportCount"),
+		DASHBOARD_COUNT: assets.NewNumericField("dashboardCount", "dashboardCount"),
+		DATASET_COUNT:   assets.NewNumericField("datasetCount", "datasetCount"),
+		DATAFLOW_COUNT:  assets.NewNumericField("dataflowCount", "dataflowCount"),
+		REPORTS:         assets.NewRelationField("reports"),
+		DASHBOARDS:      assets.NewRelationField("dashboards"),
+	}
+}
+
+// ProcessFields holds the searchable fields of Process assets.
+// Process: Instance of a lineage process in Atlan.
+type ProcessFields struct {
+	AssetFields
+	// INPUTS Assets that are inputs to this process.
+	INPUTS *assets.RelationField
+	// OUTPUTS Assets that are outputs from this process.
+	OUTPUTS *assets.RelationField
+	// CODE Code that ran within the process.
+	CODE *assets.KeywordField
+	// SQL SQL query that ran to produce the outputs.
+	SQL *assets.KeywordField
+	// AST Parsed AST of the code or SQL statements that describe the logic of this process.
+	AST *assets.KeywordField
+	// ADDITIONAL_ETL_CONTEXT Additional Context of the ETL pipeline/notebook which creates the process.
+	ADDITIONAL_ETL_CONTEXT *assets.KeywordField
+	// COLUMN_PROCESSES Processes that detail column-level lineage for this process.
+	COLUMN_PROCESSES *assets.RelationField
+}
+
+func newProcessFields() ProcessFields {
+	return ProcessFields{
+		AssetFields:            newAssetFields(),
+		INPUTS:                 assets.NewRelationField("inputs"),
+		OUTPUTS:                assets.NewRelationField("outputs"),
+		CODE:                   assets.NewKeywordField("code", "code"),
+		SQL:                    assets.NewKeywordField("sql", "sql"),
+		AST:                    assets.NewKeywordField("ast", "ast"),
+		ADDITIONAL_ETL_CONTEXT: assets.NewKeywordField("additionalEtlContext", "additionalEtlContext"),
+		COLUMN_PROCESSES:       assets.NewRelationField("columnProcesses"),
+	}
+}
+
+// PurposeFields holds the searchable fields of Purpose assets.
+// Purpose: Instance of a purpose in Atlan, which grants access to the assets with some Atlan tags.
+type PurposeFields struct {
+	AccessControlFields
+	// PURPOSE_CLASSIFICATIONS Atlan tags whose assets the purpose controls access to.
+	PURPOSE_CLASSIFICATIONS *assets.KeywordField
+}
+
+func newPurposeFields() PurposeFields {
+	return PurposeFields{
+		AccessControlFields:     newAccessControlFields(),
+		PURPOSE_CLASSIFICATIONS: assets.NewKeywordField("purposeClassifications", "purposeClassifications"),
+	}
+}
+
+// ReferenceableFields holds the searchable fields of Referenceable assets.
+// Referenceable: Base type for everything that can be referenced.
+type ReferenceableFields struct {
+	// TYPE_NAME Type of the asset. For example Table, Column, and so on.
+	TYPE_NAME *assets.KeywordTextField
+	// GUID Globally unique identifier (GUID) of any object in Atlan.
+	GUID *assets.KeywordField
+	// CREATED_BY Atlan user who created this asset.
+	CREATED_BY *assets.KeywordField
+	// UPDATED_BY Atlan user who last updated the asset.
+	UPDATED_BY *assets.KeywordField
+	// STATUS Asset status in Atlan (active vs deleted).
+	STATUS *assets.KeywordField
+	// ATLAN_TAGS All directly-assigned Atlan tags that exist on an asset, searchable by internal hashed-string ID of the Atlan tag.
+	ATLAN_TAGS *assets.KeywordTextField
+	// PROPAGATED_ATLAN_TAGS All propagated Atlan tags that exist on an asset, searchable by internal hashed-string ID of the Atlan tag.
+	PROPAGATED_ATLAN_TAGS *assets.KeywordTextField
+	// ASSIGNED_TERMS All terms attached to an asset, searchable by the term's qualifiedName.
+	ASSIGNED_TERMS *assets.KeywordTextField
+	// SUPER_TYPE_NAMES All super types of an asset.
+	SUPER_TYPE_NAMES *assets.KeywordTextField
+	// CREATE_TIME Time (in milliseconds) when the asset was created.
+	CREATE_TIME *assets.NumericField
+	// UPDATE_TIME Time (in milliseconds) when the asset was last updated.
+	UPDATE_TIME *assets.NumericField
+	// QUALIFIED_NAME Unique name for this asset. This is typically a concatenation of the asset's name onto its parent's qualifiedName.
+	QUALIFIED_NAME *assets.KeywordTextField
+}
+
+func newReferenceableFields() ReferenceableFields {
+	return ReferenceableFields{
+		TYPE_NAME:             assets.NewKeywordTextField("typeName", "__typeName.keyword", "__typeName"),
+		GUID:                  assets.NewKeywordField("guid", "__guid"),
+		CREATED_BY:            assets.NewKeywordField("createdBy", "__createdBy"),
+		UPDATED_BY:            assets.NewKeywordField("updatedBy", "__modifiedBy"),
+		STATUS:                assets.NewKeywordField("status", "__state"),
+		ATLAN_TAGS:            assets.NewKeywordTextField("classificationNames", "__traitNames", "__classificationsText"),
+		PROPAGATED_ATLAN_TAGS: assets.NewKeywordTextField("classificationNames", "__propagatedTraitNames", "__classificationsText"),
+		ASSIGNED_TERMS:        assets.NewKeywordTextField("meanings", "__meanings", "__meaningsText"),
+		SUPER_TYPE_NAMES:      assets.NewKeywordTextField("typeName", "__superTypeNames.keyword", "__superTypeNames"),
+		CREATE_TIME:           assets.NewNumericField("createTime", "__timestamp"),
+		UPDATE_TIME:           assets.NewNumericField("updateTime", "__modificationTimestamp"),
+		QUALIFIED_NAME:        assets.NewKeywordTextField("qualifiedName", "qualifiedName", "qualifiedName.text"),
+	}
+}
+
+// S3Fields holds the searchable fields of S3 assets.
+// S3: Base class for S3 assets.
+type S3Fields struct {
+	ObjectStoreFields
+	// S3_E_TAG Entity tag for the asset. An entity tag is a hash of the object and represents changes to the contents of an object only, not its metadata.
+	S3_E_TAG      *assets.KeywordField
+	S3_ENCRYPTION *assets.KeywordField
+	// AWS_ARN Amazon Resource Name (ARN) for this asset. This uniquely identifies the asset in AWS, and thus must be unique across all AWS asset instances.
+	AWS_ARN *assets.KeywordTextField
+	// AWS_PARTITION Group of AWS region and service objects.
+	AWS_PARTITION *assets.KeywordField
+	// AWS_SERVICE Type of service in which the asset exists.
+	AWS_SERVICE *assets.KeywordField
+	// AWS_REGION Physical region where the data center in which the asset exists is clustered.
+	AWS_REGION *assets.KeywordField
+	// AWS_ACCOUNT_ID 12-digit number that uniquely identifies an AWS account.
+	AWS_ACCOUNT_ID *assets.KeywordField
+	// AWS_RESOURCE_ID Unique resource ID assigned when a new resource is created.
+	AWS_RESOURCE_ID *assets.KeywordField
+	// AWS_OWNER_NAME Root user's name.
+	AWS_OWNER_NAME *assets.KeywordTextField
+	// AWS_OWNER_ID Root user's ID.
+	AWS_OWNER_ID *assets.KeywordField
+}
+
+func newS3Fields() S3Fields {
+	return S3Fields{
+		ObjectStoreFields: newObjectStoreFields(),
+		S3_E_TAG:          assets.NewKeywordField("s3ETag", "s3ETag"),
+		S3_ENCRYPTION:     assets.NewKeywordField("s3Encryption", "s3Encryption"),
+		AWS_ARN:           assets.NewKeywordTextField("awsArn", "awsArn", "awsArn.text"),
+		AWS_PARTITION:     assets.NewKeywordField("awsPartition", "awsPartition"),
+		AWS_SERVICE:       assets.NewKeywordField("awsService", "awsService"),
+		AWS_REGION:        assets.NewKeywordField("awsRegion", "awsRegion"),
+		AWS_ACCOUNT_ID:    assets.NewKeywordField("awsAccountId", "awsAccountId"),
+		AWS_RESOURCE_ID:   assets.NewKeywordField("awsResourceId", "awsResourceId"),
+		AWS_OWNER_NAME:    assets.NewKeywordTextField("awsOwnerName", "awsOwnerName", "awsOwnerName.text"),
+		AWS_OWNER_ID:      assets.NewKeywordField("awsOwnerId", "awsOwnerId"),
+	}
+}
+
+// S3BucketFields holds the searchable fields of S3Bucket assets.
+// S3Bucket: Instance of an S3 bucket in Atlan.
+type S3BucketFields struct {
+	S3Fields
+	// S3_OBJECT_COUNT Number of objects within the bucket.
+	S3_OBJECT_COUNT *assets.NumericField
+	// S3_BUCKET_VERSIONING_ENABLED Whether versioning is enabled for the bucket (true) or not (false).
+	S3_BUCKET_VERSIONING_ENABLED *assets.BooleanField
+	// OBJECTS Objects that exist within this bucket.
+	OBJECTS *assets.RelationField
+}
+
+func newS3BucketFields() S3BucketFields {
+	return S3BucketFields{
+		S3Fields:                     newS3Fields(),
+		S3_OBJECT_COUNT:              assets.NewNumericField("s3ObjectCount", "s3ObjectCount"),
+		S3_BUCKET_VERSIONING_ENABLED: assets.NewBooleanField("s3BucketVersioningEnabled", "s3BucketVersioningEnabled"),
+		OBJECTS:                      assets.NewRelationField("objects"),
+	}
+}
+
+// S3ObjectFields holds the searchable fields of S3Object assets.
+// S3Object: Instance of an S3 object in Atlan.
+type S3ObjectFields struct {
+	S3Fields
+	// S3_OBJECT_LAST_MODIFIED_TIME Time (epoch) at which this object was last updated, in milliseconds, or when it was created if it has never been modified.
+	S3_OBJECT_LAST_MODIFIED_TIME *assets.NumericField
+	// S3_BUCKET_NAME Simple name of the bucket in which this object exists.
+	S3_BUCKET_NAME *assets.KeywordTextField
+	// S3_BUCKET_QUALIFIED_NAME Unique name of the bucket in which this object exists.
+	S3_BUCKET_QUALIFIED_NAME *assets.KeywordField
+	// S3_OBJECT_SIZE Object size in bytes.
+	S3_OBJECT_SIZE *assets.NumericField
+	// S3_OBJECT_STORAGE_CLASS Storage class used for storing this object, for example: standard, intelligent-tiering, glacier, etc.
+	S3_OBJECT_STORAGE_CLASS *assets.KeywordField
+	// S3_OBJECT_KEY Unique identity of this object in an S3 bucket. This is usually the concatenation of any prefix (folder) in the S3 bucket with the name of the object (file) itself.
+	S3_OBJECT_KEY *assets.KeywordTextField
+	// S3_OBJECT_CONTENT_TYPE Type of content in this object, for example: text/plain, application/json, etc.
+	S3_OBJECT_CONTENT_TYPE *assets.KeywordField
+	// S3_OBJECT_CONTENT_DISPOSITION Information about how this object's content should be presented.
+	S3_OBJECT_CONTENT_DISPOSITION *assets.KeywordField
+	// S3_OBJECT_VERSION_ID Version of this object. This is only applicable when versioning is enabled on the bucket in which this object exists.
+	S3_OBJECT_VERSION_ID *assets.KeywordField
+	// BUCKET Bucket in which this object exists.
+	BUCKET *assets.RelationField
+}
+
+func newS3ObjectFields() S3ObjectFields {
+	return S3ObjectFields{
+		S3Fields:                      newS3Fields(),
+		S3_OBJECT_LAST_MODIFIED_TIME:  assets.NewNumericField("s3ObjectLastModifiedTime", "s3ObjectLastModifiedTime"),
+		S3_BUCKET_NAME:                assets.NewKeywordTextField("s3BucketName", "s3BucketName", "s3BucketName.text"),
+		S3_BUCKET_QUALIFIED_NAME:      assets.NewKeywordField("s3BucketQualifiedName", "s3BucketQualifiedName"),
+		S3_OBJECT_SIZE:                assets.NewNumericField("s3ObjectSize", "s3ObjectSize"),
+		S3_OBJECT_STORAGE_CLASS:       assets.NewKeywordField("s3ObjectStorageClass", "s3ObjectStorageClass"),
+		S3_OBJECT_KEY:                 assets.NewKeywordTextField("s3ObjectKey", "s3ObjectKey", "s3ObjectKey.text"),
+		S3_OBJECT_CONTENT_TYPE:        assets.NewKeywordField("s3ObjectContentType", "s3ObjectContentType"),
+		S3_OBJECT_CONTENT_DISPOSITION: assets.NewKeywordField("s3ObjectContentDisposition", "s3ObjectContentDisposition"),
+		S3_OBJECT_VERSION_ID:          assets.NewKeywordField("s3ObjectVersionId", "s3ObjectVersionId"),
+		BUCKET:                        assets.NewRelationField("bucket"),
+	}
+}
+
+// SQLFields holds the searchable fields of SQL assets.
+// SQL: Base class for SQL assets.
+type SQLFields struct {
+	CatalogFields
+	// QUERY_COUNT Number of times this asset has been queried.
+	QUERY_COUNT *assets.NumericField
+	// QUERY_USER_COUNT Number of unique users who have queried this asset.
+	QUERY_USER_COUNT *assets.NumericField
+	// QUERY_USER_MAP Map of unique users who have queried this asset to the number of times they have queried it.
+	QUERY_USER_MAP *assets.KeywordField
+	// QUERY_COUNT_UPDATED_AT Time (epoch) at which the query count was last updated, in milliseconds.
+	QUERY_COUNT_UPDATED_AT *assets.NumericField
+	// DATABASE_NAME Simple name of the database in which this SQL asset exists, or empty if it does not exist within a database.
+	DATABASE_NAME *assets.KeywordTextField
+	// DATABASE_QUALIFIED_NAME Unique name of the database in which this SQL asset exists, or empty if it does not exist within a database.
+	DATABASE_QUALIFIED_NAME *assets.KeywordField
+	// SCHEMA_NAME Simple name of the schema in which this SQL asset exists, or empty if it does not exist within a schema.
+	SCHEMA_NAME *assets.KeywordTextField
+	// SCHEMA_QUALIFIED_NAME Unique name of the schema in which this SQL asset exists, or empty if it does not exist within a schema.
+	SCHEMA_QUALIFIED_NAME *assets.KeywordField
+	// TABLE_NAME Simple name of the table in which this SQL asset exists, or empty if it does not exist within a table.
+	TABLE_NAME *assets.KeywordTextField
+	// TABLE_QUALIFIED_NAME Unique name of the table in which this SQL asset exists, or empty if it does not exist within a table.
+	TABLE_QUALIFIED_NAME *assets.KeywordField
+	// VIEW_NAME Simple name of the view in which this SQL asset exists, or empty if it does not exist within a view.
+	VIEW_NAME *assets.KeywordTextField
+	// VIEW_QUALIFIED_NAME Unique name of the view in which this SQL asset exists, or empty if it does not exist within a view.
+	VIEW_QUALIFIED_NAME *assets.KeywordField
+	// IS_PROFILED Whether this asset has been profiled (true) or not (false).
+	IS_PROFILED *assets.BooleanField
+	// LAST_PROFILED_AT Time (epoch) at which this asset was last profiled, in milliseconds.
+	LAST_PROFILED_AT *assets.NumericField
+	DBT_SOURCES      *assets.RelationField
+	SQL_DBT_MODELS   *assets.RelationField
+	SQL_DBT_SOURCES  *assets.RelationField
+	DBT_MODELS       *assets.RelationField
+	DBT_TESTS        *assets.RelationField
+}
+
+func newSQLFields() SQLFields {
+	return SQLFields{
+		CatalogFields:           newCatalogFields(),
+		QUERY_COUNT:             assets.NewNumericField("queryCount", "queryCount"),
+		QUERY_USER_COUNT:        assets.NewNumericField("queryUserCount", "queryUserCount"),
+		QUERY_USER_MAP:          assets.NewKeywordField("queryUserMap", "queryUserMap"),
+		QUERY_COUNT_UPDATED_AT:  assets.NewNumericField("queryCountUpdatedAt", "queryCountUpdatedAt"),
+		DATABASE_NAME:           assets.NewKeywordTextField("databaseName", "databaseName.keyword", "databaseName"),
+		DATABASE_QUALIFIED_NAME: assets.NewKeywordField("databaseQualifiedName", "databaseQualifiedName"),
+		SCHEMA_NAME:             assets.NewKeywordTextField("schemaName", "schemaName.keyword", "schemaName"),
+		SCHEMA_QUALIFIED_NAME:   assets.NewKeywordField("schemaQualifiedName", "schemaQualifiedName"),
+		TABLE_NAME:              assets.NewKeywordTextField("tableName", "tableName.keyword", "tableName"),
+		TABLE_QUALIFIED_NAME:    assets.NewKeywordField("tableQualifiedName", "tableQualifiedName"),
+		VIEW_NAME:               assets.NewKeywordTextField("viewName", "viewName.keyword", "viewName"),
+		VIEW_QUALIFIED_NAME:     assets.NewKeywordField("viewQualifiedName", "viewQualifiedName"),
+		IS_PROFILED:             assets.NewBooleanField("isProfiled", "isProfiled"),
+		LAST_PROFILED_AT:        assets.NewNumericField("lastProfiledAt", "lastProfiledAt"),
+		DBT_SOURCES:             assets.NewRelationField("dbtSources"),
+		SQL_DBT_MODELS:          assets.NewRelationField("sqlDbtModels"),
+		SQL_DBT_SOURCES:         assets.NewRelationField("sqlDBTSources"),
+		DBT_MODELS:              assets.NewRelationField("dbtModels"),
+		DBT_TESTS:               assets.NewRelationField("dbtTests"),
+	}
+}
+
+// SchemaFields holds the searchable fields of Schema assets.
+// Schema: Instance of a database schema in Atlan.
+type SchemaFields struct {
+	SQLFields
+	// TABLE_COUNT Number of tables in this schema.
+	TABLE_COUNT *assets.NumericField
+	// VIEWS_COUNT Number of views in this schema.
+	VIEWS_COUNT *assets.NumericField
+	// LINKED_SCHEMA_QUALIFIED_NAME Unique name of the Linked Schema on which this Schema is dependent.
+	LINKED_SCHEMA_QUALIFIED_NAME *assets.KeywordField
+	// TABLES Tables that exist within this schema.
+	TABLES *assets.RelationField
+	// VIEWS Views that exist within this schema.
+	VIEWS *assets.RelationField
+	// MATERIALISED_VIEWS Materialized views that exist within this schema.
+	MATERIALISED_VIEWS *assets.RelationField
+	// DATABASE Database in which this schema exists.
+	DATABASE *assets.RelationField
+}
+
+func newSchemaFields() SchemaFields {
+	return SchemaFields{
+		SQLFields:                    newSQLFields(),
+		TABLE_COUNT:                  assets.NewNumericField("tableCount", "tableCount"),
+		VIEWS_COUNT:                  assets.NewNumericField("viewsCount", "viewsCount"),
+		LINKED_SCHEMA_QUALIFIED_NAME: assets.NewKeywordField("linkedSchemaQualifiedName", "linkedSchemaQualifiedName"),
+		TABLES:                       assets.NewRelationField("tables"),
+		VIEWS:                        assets.NewRelationField("views"),
+		MATERIALISED_VIEWS:           assets.NewRelationField("materialisedViews"),
+		DATABASE:                     assets.NewRelationField("database"),
+	}
+}
+
+// TableFields holds the searchable fields of Table assets.
+// Table: Instance of a database table in Atlan.
+type TableFields struct {
+	SQLFields
+	// COLUMN_COUNT Number of columns in this table.
+	COLUMN_COUNT *assets.NumericField
+	// ROW_COUNT Number of rows in this table.
+	ROW_COUNT *assets.NumericField
+	// SIZE_BYTES Size of this table, in bytes.
+	SIZE_BYTES *assets.NumericField
+	// ALIAS Alias for this table.
+	ALIAS *assets.KeywordField
+	// IS_TEMPORARY Whether this table is temporary (true) or not (false).
+	IS_TEMPORARY *assets.BooleanField
+	// IS_QUERY_PREVIEW Whether preview queries are allowed for this table (true) or not (false).
+	IS_QUERY_PREVIEW *assets.BooleanField
+	// QUERY_PREVIEW_CONFIG Configuration for preview queries.
+	QUERY_PREVIEW_CONFIG *assets.KeywordField
+	// EXTERNAL_LOCATION External location of this table, for example: an S3 object location.
+	EXTERNAL_LOCATION *assets.KeywordField
+	// EXTERNAL_LOCATION_REGION Region of the external location of this table, for example: S3 region.
+	EXTERNAL_LOCATION_REGION *assets.KeywordField
+	// EXTERNAL_LOCATION_FORMAT Format of the external location of this table, for example: JSON, CSV, PARQUET, etc.
+	EXTERNAL_LOCATION_FORMAT *assets.KeywordField
+	// IS_PARTITIONED Whether this table is partitioned (true) or not (false).
+	IS_PARTITIONED *assets.BooleanField
+	// PARTITION_STRATEGY Partition strategy for this table.
+	PARTITION_STRATEGY *assets.KeywordField
+	// PARTITION_COUNT Number of partitions in this table.
+	PARTITION_COUNT *assets.NumericField
+	// PARTITION_LIST List of partitions in this table.
+	PARTITION_LIST *assets.KeywordField
+	// COLUMNS Columns that exist within this table.
+	COLUMNS *assets.RelationField
+	// ATLAN_SCHEMA Schema in which this table exists.
+	ATLAN_SCHEMA *assets.RelationField
+	// DIMENSIONS Dimension tables related to this fact table.
+	DIMENSIONS *assets.RelationField
+	// FACTS Fact tables related to this dimension table.
+	FACTS *assets.RelationField
+}
+
+func newTableFields() TableFields {
+	return TableFields{
+		SQLFields:                newSQLFields(),
+		COLUMN_COUNT:             assets.NewNumericField("columnCount", "columnCount"),
+		ROW_COUNT:                assets.NewNumericField("rowCount", "rowCount"),
+		SIZE_BYTES:               assets.NewNumericField("sizeBytes", "sizeBytes"),
+		ALIAS:                    assets.NewKeywordField("alias", "alias"),
+		IS_TEMPORARY:             assets.NewBooleanField("isTemporary", "isTemporary"),
+		IS_QUERY_PREVIEW:         assets.NewBooleanField("isQueryPreview", "isQueryPreview"),
+		QUERY_PREVIEW_CONFIG:     assets.NewKeywordField("queryPreviewConfig", "queryPreviewConfig"),
+		EXTERNAL_LOCATION:        assets.NewKeywordField("externalLocation", "externalLocation"),
+		EXTERNAL_LOCATION_REGION: assets.NewKeywordField("externalLocationRegion", "externalLocationRegion"),
+		EXTERNAL_LOCATION_FORMAT: assets.NewKeywordField("externalLocationFormat", "externalLocationFormat"),
+		IS_PARTITIONED:           assets.NewBooleanField("isPartitioned", "isPartitioned"),
+		PARTITION_STRATEGY:       assets.NewKeywordField("partitionStrategy", "partitionStrategy"),
+		PARTITION_COUNT:          assets.NewNumericField("partitionCount", "partitionCount"),
+		PARTITION_LIST:           assets.NewKeywordField("partitionList", "partitionList"),
+		COLUMNS:                  assets.NewRelationField("columns"),
+		ATLAN_SCHEMA:             assets.NewRelationField("atlanSchema"),
+		DIMENSIONS:               assets.NewRelationField("dimensions"),
+		FACTS:                    assets.NewRelationField("facts"),
+	}
+}
+
+// TableauFields holds the searchable fields of Tableau assets.
+// Tableau: Base class for Tableau assets.
+type TableauFields struct {
+	BIFields
+}
+
+func newTableauFields() TableauFields {
+	return TableauFields{
+		BIFields: newBIFields(),
+	}
+}
+
+// TableauWorkbookFields holds the searchable fields of TableauWorkbook assets.
+// TableauWorkbook: Instance of a Tableau workbook in Atlan.
+type TableauWorkbookFields struct {
+	TableauFields
+	// SITE_QUALIFIED_NAME Unique name of the site in which this workbook exists.
+	SITE_QUALIFIED_NAME *assets.KeywordField
+	// PROJECT_QUALIFIED_NAME Unique name of the project in which this workbook exists.
+	PROJECT_QUALIFIED_NAME *assets.KeywordField
+	// TOP_LEVEL_PROJECT_NAME Simple name of the top-level project in which this workbook exists.
+	TOP_LEVEL_PROJECT_NAME *assets.KeywordField
+	// TOP_LEVEL_PROJECT_QUALIFIED_NAME Unique name of the top-level project in which this workbook exists.
+	TOP_LEVEL_PROJECT_QUALIFIED_NAME *assets.KeywordField
+	// PROJECT_HIERARCHY List of top-level projects with their nested child projects.
+	PROJECT_HIERARCHY *assets.KeywordField
+}
+
+func newTableauWorkbookFields() TableauWorkbookFields {
+	return TableauWorkbookFields{
+		TableauFields:                    newTableauFields(),
+		SITE_QUALIFIED_NAME:              assets.NewKeywordField("siteQualifiedName", "siteQualifiedName"),
+		PROJECT_QUALIFIED_NAME:           assets.NewKeywordField("projectQualifiedName", "projectQualifiedName"),
+		TOP_LEVEL_PROJECT_NAME:           assets.NewKeywordField("topLevelProjectName", "topLevelProjectName"),
+		TOP_LEVEL_PROJECT_QUALIFIED_NAME: assets.NewKeywordField("topLevelProjectQualifiedName", "topLevelProjectQualifiedName"),
+		PROJECT_HIERARCHY:                assets.NewKeywordField("projectHierarchy", "projectHierarchy"),
+	}
+}
+
+// ViewFields holds the searchable fields of View assets.
+// View: Instance of a database view in Atlan.
+type ViewFields struct {
+	SQLFields
+	// COLUMN_COUNT Number of columns in this table.
+	COLUMN_COUNT *assets.NumericField
+	// ROW_COUNT Number of rows in this table.
+	ROW_COUNT *assets.NumericField
+	// SIZE_BYTES Size of this table, in bytes.
+	SIZE_BYTES *assets.NumericField
+	// ALIAS Alias for this table.
+	ALIAS *assets.KeywordField
+	// IS_TEMPORARY Whether this table is temporary (true) or not (false).
+	IS_TEMPORARY *assets.BooleanField
+	// IS_QUERY_PREVIEW Whether preview queries are allowed for this table (true) or not (false).
+	IS_QUERY_PREVIEW *assets.BooleanField
+	// QUERY_PREVIEW_CONFIG Configuration for preview queries.
+	QUERY_PREVIEW_CONFIG *assets.KeywordField
+	// DEFINITION SQL definition of this view.
+	DEFINITION *assets.KeywordField
+	// COLUMNS Columns that exist within this view.
+	COLUMNS *assets.RelationField
+	// ATLAN_SCHEMA Schema in which this view exists.
+	ATLAN_SCHEMA *assets.RelationField
+}
+
+func newViewFields() ViewFields {
+	return ViewFields{
+		SQLFields:            newSQLFields(),
+		COLUMN_COUNT:         assets.NewNumericField("columnCount", "columnCount"),
+		ROW_COUNT:            assets.NewNumericField("rowCount", "rowCount"),
+		SIZE_BYTES:           assets.NewNumericField("sizeBytes", "sizeBytes"),
+		ALIAS:                assets.NewKeywordField("alias", "alias"),
+		IS_TEMPORARY:         assets.NewBooleanField("isTemporary", "isTemporary"),
+		IS_QUERY_PREVIEW:     assets.NewBooleanField("isQueryPreview", "isQueryPreview"),
+		QUERY_PREVIEW_CONFIG: assets.NewKeywordField("queryPreviewConfig", "queryPreviewConfig"),
+		DEFINITION:           assets.NewKeywordField("definition", "definition"),
+		COLUMNS:              assets.NewRelationField("columns"),
+		ATLAN_SCHEMA:         assets.NewRelationField("atlanSchema"),
+	}
+}
+
+// WorkflowFields holds the searchable fields of Workflow assets.
+// Workflow: Instance of a workflow in Atlan.
+type WorkflowFields struct {
+	AssetFields
+	// WORKFLOW_TEMPLATE_GUID GUID of the workflow template from which the workflow was created.
+	WORKFLOW_TEMPLATE_GUID *assets.KeywordField
+	// WORKFLOW_TYPE Type of the workflow.
+	WORKFLOW_TYPE *assets.KeywordField
+	// WORKFLOW_CONFIG Workflow configuration.
+	WORKFLOW_CONFIG *assets.TextField
+	// WORKFLOW_STATUS Status of the workflow.
+	WORKFLOW_STATUS *assets.KeywordField
+	// WORKFLOW_RUN_EXPIRES_IN Time duration after which a run of the workflow will expire.
+	WORKFLOW_RUN_EXPIRES_IN *assets.TextField
+	// WORKFLOW_CREATED_BY Username of the user who created the workflow.
+	WORKFLOW_CREATED_BY *assets.KeywordField
+	// WORKFLOW_UPDATED_BY Username of the user who updated the workflow.
+	WORKFLOW_UPDATED_BY *assets.KeywordField
+	// WORKFLOW_DELETED_AT Deletion time of the workflow.
+	WORKFLOW_DELETED_AT *assets.NumericField
+}
+
+func newWorkflowFields() WorkflowFields {
+	return WorkflowFields{
+		AssetFields:             newAssetFields(),
+		WORKFLOW_TEMPLATE_GUID:  assets.NewKeywordField("workflowTemplateGuid", "workflowTemplateGuid"),
+		WORKFLOW_TYPE:           assets.NewKeywordField("workflowType", "workflowType"),
+		WORKFLOW_CONFIG:         assets.NewTextField("workflowConfig", "workflowConfig"),
+		WORKFLOW_STATUS:         assets.NewKeywordField("workflowStatus", "workflowStatus"),
+		WORKFLOW_RUN_EXPIRES_IN: assets.NewTextField("workflowRunExpiresIn", "workflowRunExpiresIn"),
+		WORKFLOW_CREATED_BY:     assets.NewKeywordField("workflowCreatedBy", "workflowCreatedBy"),
+		WORKFLOW_UPDATED_BY:     assets.NewKeywordField("workflowUpdatedBy", "workflowUpdatedBy"),
+		WORKFLOW_DELETED_AT:     assets.NewNumericField("workflowDeletedAt", "workflowDeletedAt"),
+	}
+}
+
+// WorkflowRunFields holds the searchable fields of WorkflowRun assets.
+// WorkflowRun: Instance of a run of a workflow in Atlan.
+type WorkflowRunFields struct {
+	AssetFields
+	// WORKFLOW_RUN_WORKFLOW_GUID GUID of the workflow of the run.
+	WORKFLOW_RUN_WORKFLOW_GUID *assets.KeywordField
+	// WORKFLOW_RUN_TYPE Type of the run.
+	WORKFLOW_RUN_TYPE *assets.KeywordField
+	// WORKFLOW_RUN_ON_ASSET_GUID GUID of the asset the run was triggered on.
+	WORKFLOW_RUN_ON_ASSET_GUID *assets.KeywordField
+	// WORKFLOW_RUN_COMMENT Comment on the run.
+	WORKFLOW_RUN_COMMENT *assets.TextField
+	// WORKFLOW_RUN_CONFIG Configuration of the run.
+	WORKFLOW_RUN_CONFIG *assets.TextField
+	// WORKFLOW_RUN_STATUS Status of the run.
+	WORKFLOW_RUN_STATUS *assets.KeywordField
+	// WORKFLOW_RUN_EXPIRES_AT Time at which the run expires.
+	WORKFLOW_RUN_EXPIRES_AT *assets.NumericField
+	// WORKFLOW_RUN_CREATED_BY Username of the user who created the run.
+	WORKFLOW_RUN_CREATED_BY *assets.KeywordField
+	// WORKFLOW_RUN_UPDATED_BY Username of the user who updated the run.
+	WORKFLOW_RUN_UPDATED_BY *assets.KeywordField
+	// WORKFLOW_RUN_DELETED_AT Deletion time of the run.
+	WORKFLOW_RUN_DELETED_AT *assets.NumericField
+}
+
+func newWorkflowRunFields() WorkflowRunFields {
+	return WorkflowRunFields{
+		AssetFields:                newAssetFields(),
+		WORKFLOW_RUN_WORKFLOW_GUID: assets.NewKeywordField("workflowRunWorkflowGuid", "workflowRunWorkflowGuid"),
+		WORKFLOW_RUN_TYPE:          assets.NewKeywordField("workflowRunType", "workflowRunType"),
+		WORKFLOW_RUN_ON_ASSET_GUID: assets.NewKeywordField("workflowRunOnAssetGuid", "workflowRunOnAssetGuid"),
+		WORKFLOW_RUN_COMMENT:       assets.NewTextField("workflowRunComment", "workflowRunComment"),
+		WORKFLOW_RUN_CONFIG:        assets.NewTextField("workflowRunConfig", "workflowRunConfig"),
+		WORKFLOW_RUN_STATUS:        assets.NewKeywordField("workflowRunStatus", "workflowRunStatus"),
+		WORKFLOW_RUN_EXPIRES_AT:    assets.NewNumericField("workflowRunExpiresAt", "workflowRunExpiresAt"),
+		WORKFLOW_RUN_CREATED_BY:    assets.NewKeywordField("workflowRunCreatedBy", "workflowRunCreatedBy"),
+		WORKFLOW_RUN_UPDATED_BY:    assets.NewKeywordField("workflowRunUpdatedBy", "workflowRunUpdatedBy"),
+		WORKFLOW_RUN_DELETED_AT:    assets.NewNumericField("workflowRunDeletedAt", "workflowRunDeletedAt"),
+	}
+}
+
+var (
+	// AWS holds the searchable fields of AWS assets.
+	AWS = newAWSFields()
+	// AccessControl holds the searchable fields of AccessControl assets.
+	AccessControl = newAccessControlFields()
+	// Asset holds the searchable fields of Asset assets.
+	Asset = newAssetFields()
+	// AtlasGlossary holds the searchable fields of AtlasGlossary assets.
+	AtlasGlossary = newAtlasGlossaryFields()
+	// AtlasGlossaryCategory holds the searchable fields of AtlasGlossaryCategory assets.
+	AtlasGlossaryCategory = newAtlasGlossaryCategoryFields()
+	// AtlasGlossaryTerm holds the searchable fields of AtlasGlossaryTerm assets.
+	AtlasGlossaryTerm = newAtlasGlossaryTermFields()
+	// AuthPolicy holds the searchable fields of AuthPolicy assets.
+	AuthPolicy = newAuthPolicyFields()
+	// AuthService holds the searchable fields of AuthService assets.
+	AuthService = newAuthServiceFields()
+	// BI holds the searchable fields of BI assets.
+	BI = newBIFields()
+	// BIProcess holds the searchable fields of BIProcess assets.
+	BIProcess = newBIProcessFields()
+	// Catalog holds the searchable fields of Catalog assets.
+	Catalog = newCatalogFields()
+	// Cloud holds the searchable fields of Cloud assets.
+	Cloud = newCloudFields()
+	// Column holds the searchable fields of Column assets.
+	Column = newColumnFields()
+	// ColumnProcess holds the searchable fields of ColumnProcess assets.
+	ColumnProcess = newColumnProcessFields()
+	// Connection holds the searchable fields of Connection assets.
+	Connection = newConnectionFields()
+	// DataContract holds the searchable fields of DataContract assets.
+	DataContract = newDataContractFields()
+	// Database holds the searchable fields of Database assets.
+	Database = newDatabaseFields()
+	// Dbt holds the searchable fields of Dbt assets.
+	Dbt = newDbtFields()
+	// DbtModel holds the searchable fields of DbtModel assets.
+	DbtModel = newDbtModelFields()
+	// DbtModelColumn holds the searchable fields of DbtModelColumn assets.
+	DbtModelColumn = newDbtModelColumnFields()
+	// DbtSource holds the searchable fields of DbtSource assets.
+	DbtSource = newDbtSourceFields()
+	// DbtTest holds the searchable fields of DbtTest assets.
+	DbtTest = newDbtTestFields()
+	// EventStore holds the searchable fields of EventStore assets.
+	EventStore = newEventStoreFields()
+	// Kafka holds the searchable fields of Kafka assets.
+	Kafka = newKafkaFields()
+	// KafkaConsumerGroup holds the searchable fields of KafkaConsumerGroup assets.
+	KafkaConsumerGroup = newKafkaConsumerGroupFields()
+	// KafkaTopic holds the searchable fields of KafkaTopic assets.
+	KafkaTopic = newKafkaTopicFields()
+	// MaterialisedView holds the searchable fields of MaterialisedView assets.
+	MaterialisedView = newMaterialisedViewFields()
+	// ObjectStore holds the searchable fields of ObjectStore assets.
+	ObjectStore = newObjectStoreFields()
+	// Persona holds the searchable fields of Persona assets.
+	Persona = newPersonaFields()
+	// PowerBI holds the searchable fields of PowerBI assets.
+	PowerBI = newPowerBIFields()
+	// PowerBIDashboard holds the searchable fields of PowerBIDashboard assets.
+	PowerBIDashboard = newPowerBIDashboardFields()
+	// PowerBIReport holds the searchable fields of PowerBIReport assets.
+	PowerBIReport = newPowerBIReportFields()
+	// PowerBIWorkspace holds the searchable fields of PowerBIWorkspace assets.
+	PowerBIWorkspace = newPowerBIWorkspaceFields()
+	// Process holds the searchable fields of Process assets.
+	Process = newProcessFields()
+	// Purpose holds the searchable fields of Purpose assets.
+	Purpose = newPurposeFields()
+	// Referenceable holds the searchable fields of Referenceable assets.
+	Referenceable = newReferenceableFields()
+	// S3 holds the searchable fields of S3 assets.
+	S3 = newS3Fields()
+	// S3Bucket holds the searchable fields of S3Bucket assets.
+	S3Bucket = newS3BucketFields()
+	// S3Object holds the searchable fields of S3Object assets.
+	S3Object = newS3ObjectFields()
+	// SQL holds the searchable fields of SQL assets.
+	SQL = newSQLFields()
+	// Schema holds the searchable fields of Schema assets.
+	Schema = newSchemaFields()
+	// Table holds the searchable fields of Table assets.
+	Table = newTableFields()
+	// Tableau holds the searchable fields of Tableau assets.
+	Tableau = newTableauFields()
+	// TableauWorkbook holds the searchable fields of TableauWorkbook assets.
+	TableauWorkbook = newTableauWorkbookFields()
+	// View holds the searchable fields of View assets.
+	View = newViewFields()
+	// Workflow holds the searchable fields of Workflow assets.
+	Workflow = newWorkflowFields()
+	// WorkflowRun holds the searchable fields of WorkflowRun assets.
+	WorkflowRun = newWorkflowRunFields()
+)
@@ -96,7 +96,7 @@ func (client *FileClient) UploadFile(presignedUrl string, filePath string) error
 	case strings.Contains(presignedUrl, string(model.GCS)):
 		err = client.gcsPresignedUrlFileUpload(&PRESIGNED_URL_UPLOAD_GCS, file, fileInfo.Size())
 	default:
-		return InvalidRequestError{AtlanError{ErrorCode: errorCodes[UNSUPPORTED_PRESIGNED_URL]}}
+		return &InvalidRequestError{AtlanError{ErrorCode: errorCodes[UNSUPPORTED_PRESIGNED_URL]}}
 	}
 
 	if err != nil {
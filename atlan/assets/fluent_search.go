@@ -1,6 +1,8 @@
 package assets
 
 import (
+	"context"
+
 	"github.com/atlanhq/atlan-go/atlan"
 	"github.com/atlanhq/atlan-go/atlan/model"
 )
@@ -17,6 +19,8 @@ type FluentSearch struct {
 	IncludesOnResults   []string
 	IncludesOnRelations []string
 	UtmTags             []string
+	TimestampSliced     bool
+	client              *AtlanClient
 }
 
 // SetUtmTags sets the UTM tags for tracking the source of requests.
@@ -30,7 +34,9 @@ func (fs *FluentSearch) SetUtmTags(tags ...atlan.UTMTags) *FluentSearch {
 	return fs
 }
 
-type Aggregation struct{}
+// Aggregation is an aggregation that can be included in a FluentSearch, such as those built
+// from the fields of assets (for example, BucketBy or Avg).
+type Aggregation = model.Aggregation
 
 // ActiveAssets Returns a query that will only match assets that are active in Atlan.
 func (fs *FluentSearch) ActiveAssets() *FluentSearch {
@@ -46,7 +52,7 @@ func (fs *FluentSearch) ActiveAssets() *FluentSearch {
 func (fs *FluentSearch) ArchivedAssets() *FluentSearch {
 	archivedAssetsCondition := &model.TermQuery{
 		Field: STATE,
-		Value: "Deleted",
+		Value: "DELETED",
 	}
 	fs.Wheres = append(fs.Wheres, archivedAssetsCondition)
 	return fs
@@ -76,6 +82,11 @@ func NewFluentSearch() *FluentSearch {
 	return &FluentSearch{}
 }
 
+// NewFluentSearch creates a FluentSearch that is executed using this client.
+func (ac *AtlanClient) NewFluentSearch() *FluentSearch {
+	return &FluentSearch{client: ac}
+}
+
 // Where adds a TermQuery to the Wheres slice.
 func (fs *FluentSearch) Where(queries ...model.Query) *FluentSearch {
 	boolQuery := &model.BoolQuery{Filter: queries}
@@ -118,6 +129,12 @@ func (fs *FluentSearch) Aggregate(name string, aggregation map[string]interface{
 	return fs
 }
 
+// AggregateBy adds a typed aggregation to the Aggregations map, whose result is available
+// by name in the Aggregations of the response.
+func (fs *FluentSearch) AggregateBy(name string, aggregation Aggregation) *FluentSearch {
+	return fs.Aggregate(name, aggregation.ToJSON())
+}
+
 // PageSize sets the PageSize field.
 func (fs *FluentSearch) PageSizes(size int) *FluentSearch {
 	fs.PageSize = size
@@ -136,9 +153,34 @@ func (fs *FluentSearch) IncludeOnRelations(fields ...string) *FluentSearch {
 	return fs
 }
 
+// TimestampSlicing pages through the results in slices of creation time, to reliably enumerate
+// very large result sets. Results are then sorted by creation time, ignoring any other sort.
+// See IndexSearchIterator.WithTimestampSlicing.
+func (fs *FluentSearch) TimestampSlicing() *FluentSearch {
+	fs.TimestampSliced = true
+	return fs
+}
+
 // Execute performs the search and returns the results.
 func (fs *FluentSearch) Execute() (*IndexSearchIterator, error) {
-	return Search(*fs.ToRequest())
+	return fs.ExecuteWithContext(context.Background())
+}
+
+// ExecuteWithContext performs the search bound to the provided context and returns the results.
+// Like AtlanClient.SearchWithContext, no page is fetched until the results are used.
+func (fs *FluentSearch) ExecuteWithContext(ctx context.Context) (*IndexSearchIterator, error) {
+	client := fs.client
+	if client == nil {
+		client = DefaultAtlanClient
+	}
+	if client == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	if fs.TimestampSliced {
+		request := fs.ToRequest()
+		return client.NewIndexSearchIteratorWithContext(ctx, request.Dsl.Size, *request).WithTimestampSlicing(), nil
+	}
+	return client.SearchWithContext(ctx, *fs.ToRequest())
 }
 
 // Sort by GUID by default only if not already specified by the developer
@@ -1,13 +1,18 @@
 package assets
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model"
 	"github.com/atlanhq/atlan-go/atlan/model/structs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const GlossaryDescription = "Automated testing of GO SDK."
@@ -84,3 +89,176 @@ func TestIntegrationFluentSearch(t *testing.T) {
 	deleteresponse, _ := PurgeByGuid([]string{response.MutatedEntities.CREATE[0].Guid})
 	assert.NotNil(t, deleteresponse, "fetched glossary should not be nil")
 }
+
+func TestAggregationRequests(t *testing.T) {
+	connectorName := NewKeywordField("connectorName", "connectorName")
+	certificateStatus := NewKeywordField("certificateStatus", "certificateStatus")
+	ownerUsers := NewKeywordTextField("ownerUsers", "ownerUsers", "ownerUsers.text")
+	createTime := NewNumericField("createTime", "__timestamp")
+	rowCount := NewNumericField("rowCount", "rowCount")
+
+	request := NewFluentSearch().
+		PageSizes(0).
+		AggregateBy("connectors", connectorName.BucketBy(10).WithSubAggregation("certificates", certificateStatus.BucketBy(3))).
+		AggregateBy("owners", ownerUsers.Distinct()).
+		AggregateBy("created", createTime.DateHistogram("month")).
+		AggregateBy("rows", rowCount.Avg()).
+		AggregateBy("verified", Filters(map[string]model.Query{"yes": certificateStatus.Eq("VERIFIED")})).
+		AggregateBy("nested", Nested("columns", map[string]Aggregation{"largest": rowCount.Max()})).
+		ToRequest()
+
+	data, err := json.Marshal(request.Dsl)
+	require.NoError(t, err)
+	var dsl map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &dsl))
+	aggregations, err := json.Marshal(dsl["aggregations"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"connectors": {
+			"terms": {"field": "connectorName", "size": 10},
+			"aggs": {"certificates": {"terms": {"field": "certificateStatus", "size": 3}}}
+		},
+		"owners": {"cardinality": {"field": "ownerUsers"}},
+		"created": {"date_histogram": {"field": "__timestamp", "calendar_interval": "month"}},
+		"rows": {"avg": {"field": "rowCount"}},
+		"verified": {"filters": {"filters": {"yes": {"term": {"certificateStatus": {"value": "VERIFIED"}}}}}},
+		"nested": {"nested": {"path": "columns"}, "aggs": {"largest": {"max": {"field": "rowCount"}}}}
+	}`, string(aggregations))
+}
+
+func TestAggregationResults(t *testing.T) {
+	var requests []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Dsl map[string]interface{} `json:"dsl"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request.Dsl)
+		if len(requests) > 1 {
+			fmt.Fprint(w, `{"searchParameters": {}, "entities": [{"typeName": "Table", "guid": "2"}], "approximateCount": 2}`)
+			return
+		}
+		fmt.Fprint(w, `{"searchParameters": {}, "entities": [{"typeName": "Table", "guid": "1"}], "approximateCount": 2,
+			"aggregations": {
+				"connectors": {
+					"doc_count_error_upper_bound": 0, "sum_other_doc_count": 3,
+					"buckets": [
+						{"key": "snowflake", "doc_count": 7, "certificates": {"buckets": [{"key": "VERIFIED", "doc_count": 4}]}},
+						{"key": "postgres", "doc_count": 2, "certificates": {"buckets": []}}
+					]
+				},
+				"owners": {"value": 12},
+				"created": {"buckets": [{"key_as_string": "2024-01-01T00:00:00.000Z", "key": 1704067200000, "doc_count": 5}]},
+				"verified": {"buckets": {"yes": {"doc_count": 4}}},
+				"nested": {"doc_count": 30, "largest": {"value": 1000.5}}
+			}}`)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	connectorName := NewKeywordField("connectorName", "connectorName")
+	iterator, err := client.NewFluentSearch().
+		PageSizes(1).
+		AggregateBy("connectors", connectorName.BucketBy(10)).
+		Execute()
+	require.NoError(t, err)
+
+	aggregations := iterator.Aggregations()
+	connectors := aggregations["connectors"]
+	assert.Equal(t, map[string]int64{"snowflake": 7, "postgres": 2}, connectors.Counts())
+	assert.Equal(t, int64(3), *connectors.SumOtherDocCount)
+	snowflake := connectors.Bucket("snowflake")
+	require.NotNil(t, snowflake)
+	assert.Equal(t, map[string]int64{"VERIFIED": 4}, snowflake.Aggregations["certificates"].Counts())
+	assert.Nil(t, connectors.Bucket("oracle"))
+
+	assert.Equal(t, 12.0, *aggregations["owners"].Value)
+	created := aggregations["created"]
+	assert.Equal(t, map[string]int64{"2024-01-01T00:00:00.000Z": 5}, created.Counts())
+	assert.Equal(t, 1704067200000.0, created.Buckets[0].Key)
+	verified := aggregations["verified"]
+	assert.Equal(t, map[string]int64{"yes": 4}, verified.Counts())
+	nested := aggregations["nested"]
+	assert.Equal(t, int64(30), *nested.DocCount)
+	assert.Equal(t, 1000.5, *nested.Aggregations["largest"].Value)
+
+	// They were fetched without any result, and the pages don't ask for them again
+	assert.Equal(t, float64(0), requests[0]["size"])
+	_, err = iterator.NextPage()
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.Contains(t, requests[0], "aggregations")
+	assert.NotContains(t, requests[1], "aggregations")
+	assert.Contains(t, iterator.Aggregations(), "connectors")
+}
+
+func TestQueryHelpers(t *testing.T) {
+	name := NewKeywordTextField("name", "name.keyword", "name")
+	qualifiedName := NewKeywordField("qualifiedName", "qualifiedName")
+	certificateStatus := NewKeywordField("certificateStatus", "certificateStatus")
+	updateTime := NewNumericField("updateTime", "__modificationTimestamp")
+	popularity := 1.5
+	caseInsensitive := true
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		query    model.Query
+		expected string
+	}{
+		{"wildcard", qualifiedName.Wildcard("default/snowflake/*/RAW/*", nil),
+			`{"wildcard": {"qualifiedName": {"value": "default/snowflake/*/RAW/*"}}}`},
+		{"regexp", name.Regexp("cust_[0-9]+", &caseInsensitive),
+			`{"regexp": {"name.keyword": {"value": "cust_[0-9]+", "case_insensitive": true}}}`},
+		{"fuzzy", qualifiedName.Fuzzy("custmer", nil),
+			`{"fuzzy": {"qualifiedName": {"value": "custmer"}}}`},
+		{"between dates", updateTime.BetweenDates(from, to),
+			`{"range": {"__modificationTimestamp": {"gte": 1704067200000, "lte": 1704153600000}}}`},
+		{"after", updateTime.After(from),
+			`{"range": {"__modificationTimestamp": {"gt": 1704067200000}}}`},
+		{"before", updateTime.Before(to),
+			`{"range": {"__modificationTimestamp": {"lt": 1704153600000}}}`},
+		{"boost", Boost(certificateStatus.Eq("VERIFIED"), 2),
+			`{"bool": {"must": [{"term": {"certificateStatus": {"value": "VERIFIED"}}}], "boost": 2}}`},
+		{"constant score", ConstantScore(certificateStatus.Eq("DRAFT"), 0.5),
+			`{"constant_score": {"filter": {"term": {"certificateStatus": {"value": "DRAFT"}}}, "boost": 0.5}}`},
+		{"dis max", DisMax(name.Eq("customers"), name.StartsWith("cust", nil)),
+			`{"dis_max": {"queries": [{"term": {"name.keyword": {"value": "customers"}}}, {"prefix": {"name.keyword": {"value": "cust"}}}]}}`},
+		{"function score", FunctionScore(name.Eq("customers"),
+			model.ScoreFunction{Filter: certificateStatus.Eq("VERIFIED"), Weight: &popularity},
+			model.ScoreFunction{FieldValueFactor: &model.FieldValueFactor{Field: "popularityScore"}}),
+			`{"function_score": {"query": {"term": {"name.keyword": {"value": "customers"}}}, "functions": [
+				{"filter": {"term": {"certificateStatus": {"value": "VERIFIED"}}}, "weight": 1.5},
+				{"field_value_factor": {"field": "popularityScore"}}]}}`},
+		{"boosting", Boosting(name.Eq("customers"), certificateStatus.Eq("DEPRECATED"), 0.2),
+			`{"boosting": {"positive": {"term": {"name.keyword": {"value": "customers"}}},
+				"negative": {"term": {"certificateStatus": {"value": "DEPRECATED"}}}, "negative_boost": 0.2}}`},
+		{"nested", NestedQuery("columns", certificateStatus.Eq("VERIFIED")),
+			`{"nested": {"path": "columns", "query": {"term": {"certificateStatus": {"value": "VERIFIED"}}}}}`},
+		{"script", Script("doc['popularityScore'].value > params.min", map[string]interface{}{"min": 10}),
+			`{"script": {"script": {"source": "doc['popularityScore'].value > params.min", "params": {"min": 10}}}}`},
+		{"span near", SpanNear(1, true, name.SpanTerm("customer"), SpanMulti(name.StartsWith("ord", nil))),
+			`{"span_near": {"clauses": [{"span_term": {"name": {"value": "customer"}}},
+				{"span_multi": {"match": {"prefix": {"name.keyword": {"value": "ord"}}}}}], "slop": 1, "in_order": true}}`},
+		{"span first", SpanFirst(SpanOr(name.SpanTerm("raw"), name.SpanTerm("stg")), 1),
+			`{"span_first": {"match": {"span_or": {"clauses": [{"span_term": {"name": {"value": "raw"}}},
+				{"span_term": {"name": {"value": "stg"}}}]}}, "end": 1}}`},
+		{"span not", SpanNot(name.SpanTerm("orders"), name.SpanTerm("archive")),
+			`{"span_not": {"include": {"span_term": {"name": {"value": "orders"}}}, "exclude": {"span_term": {"name": {"value": "archive"}}}}}`},
+		{"span within", SpanWithin(SpanNear(2, false, name.SpanTerm("daily"), name.SpanTerm("orders")), name.SpanTerm("customer")),
+			`{"span_within": {"big": {"span_near": {"clauses": [{"span_term": {"name": {"value": "daily"}}},
+				{"span_term": {"name": {"value": "orders"}}}], "slop": 2, "in_order": false}},
+				"little": {"span_term": {"name": {"value": "customer"}}}}}`},
+		{"relation has any value", NewRelationField("meanings").HasAnyValue(),
+			`{"exists": {"field": "meanings"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := NewFluentSearch().Where(tt.query).ToRequest()
+			data, err := json.Marshal(request.Dsl.Query)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"bool": {"filter": [{"bool": {"filter": [`+tt.expected+`]}}]}}`, string(data))
+		})
+	}
+}
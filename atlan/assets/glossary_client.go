@@ -1,11 +1,14 @@
 package assets
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"time"
 
 	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
 	"github.com/atlanhq/atlan-go/atlan/model/structs"
 )
 
@@ -129,3 +132,421 @@ func (ag *AtlasGlossary) ToJSON() ([]byte, error) {
 func (ag *AtlasGlossary) FromJSON(data []byte) error {
 	return json.Unmarshal(data, ag)
 }
+
+// GlossaryClient finds and organizes the terms and categories of glossaries, and links terms to
+// assets and to each other. Terms and categories themselves are created and updated with the
+// Creator and Updater of entities.AtlasGlossaryTerm and entities.AtlasGlossaryCategory, and
+// saved with Save or a Batch like any other asset.
+type GlossaryClient struct {
+	*AtlanClient
+}
+
+// client returns the AtlanClient backing this GlossaryClient,
+// falling back to the default client for a zero-value GlossaryClient.
+func (gc *GlossaryClient) client() (*AtlanClient, error) {
+	if gc != nil && gc.AtlanClient != nil {
+		return gc.AtlanClient, nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
+// GetTerm retrieves the term with the given GUID.
+func (gc *GlossaryClient) GetTerm(guid string) (*entities.AtlasGlossaryTerm, error) {
+	return gc.GetTermWithContext(context.Background(), guid)
+}
+
+// GetTermWithContext is like GetTerm, but bound to the provided context.
+func (gc *GlossaryClient) GetTermWithContext(ctx context.Context, guid string) (*entities.AtlasGlossaryTerm, error) {
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
+	return getEntityOfType[*entities.AtlasGlossaryTerm](ctx, client, guid, "AtlasGlossaryTerm")
+}
+
+// GetCategory retrieves the category with the given GUID.
+func (gc *GlossaryClient) GetCategory(guid string) (*entities.AtlasGlossaryCategory, error) {
+	return gc.GetCategoryWithContext(context.Background(), guid)
+}
+
+// GetCategoryWithContext is like GetCategory, but bound to the provided context.
+func (gc *GlossaryClient) GetCategoryWithContext(ctx context.Context, guid string) (*entities.AtlasGlossaryCategory, error) {
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
+	return getEntityOfType[*entities.AtlasGlossaryCategory](ctx, client, guid, "AtlasGlossaryCategory")
+}
+
+// getEntityOfType retrieves the asset with the given GUID, which must be of type T.
+func getEntityOfType[T entities.Object](ctx context.Context, ac *AtlanClient, guid string, typeName string) (T, error) {
+	var typed T
+	object, err := ac.GetEntityByGuidWithContext(ctx, guid)
+	if err != nil {
+		return typed, err
+	}
+	typed, ok := object.(T)
+	if !ok {
+		return typed, &NotFoundError{AtlanError{ErrorCode: errorCodes[ASSET_NOT_TYPE_REQUESTED], Args: []interface{}{guid, typeName}}}
+	}
+	return typed, nil
+}
+
+// FindTermFastByName finds the active term with the given name in the glossary with the given
+// qualified name. The term is read from the search index rather than retrieved, so it only has
+// its anchor and the given attributes.
+func (gc *GlossaryClient) FindTermFastByName(name, glossaryQualifiedName string, attributes ...string) (*entities.AtlasGlossaryTerm, error) {
+	return gc.FindTermFastByNameWithContext(context.Background(), name, glossaryQualifiedName, attributes...)
+}
+
+// FindTermFastByNameWithContext is like FindTermFastByName, but bound to the provided context.
+func (gc *GlossaryClient) FindTermFastByNameWithContext(ctx context.Context, name, glossaryQualifiedName string, attributes ...string) (*entities.AtlasGlossaryTerm, error) {
+	if name == "" || glossaryQualifiedName == "" {
+		return nil, errors.New("name and glossaryQualifiedName are required fields")
+	}
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
+	iterator, err := client.NewFluentSearch().
+		ActiveAssets().
+		AssetType("AtlasGlossaryTerm").
+		Where(WithName(name), WithGlossary(glossaryQualifiedName)).
+		PageSizes(2).
+		IncludeOnResults(append([]string{"anchor"}, attributes...)...).
+		ExecuteWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := iterator.WithEntities().CurrentPage()
+	if err != nil {
+		return nil, err
+	}
+	for i := range page.Entities {
+		object, err := page.Entities[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		if term, ok := object.(*entities.AtlasGlossaryTerm); ok {
+			return term, nil
+		}
+	}
+	return nil, &NotFoundError{AtlanError{ErrorCode: errorCodes[ASSET_NOT_FOUND_BY_NAME], Args: []interface{}{"AtlasGlossaryTerm", name}}}
+}
+
+// CategoryHierarchy is the tree of the categories of a glossary. Categories are ordered by name
+// at every level.
+type CategoryHierarchy struct {
+	roots    []*entities.AtlasGlossaryCategory
+	byGuid   map[string]*entities.AtlasGlossaryCategory
+	children map[string][]*entities.AtlasGlossaryCategory
+}
+
+// newCategoryHierarchy arranges the categories of a glossary in a tree by their parent category.
+// Categories whose parent isn't among them are top-level.
+func newCategoryHierarchy(categories []*entities.AtlasGlossaryCategory) *CategoryHierarchy {
+	hierarchy := &CategoryHierarchy{
+		byGuid:   make(map[string]*entities.AtlasGlossaryCategory),
+		children: make(map[string][]*entities.AtlasGlossaryCategory),
+	}
+	for _, category := range categories {
+		if category.Guid != nil {
+			hierarchy.byGuid[*category.Guid] = category
+		}
+	}
+	for _, category := range categories {
+		if parent := category.ParentCategory; parent != nil && hierarchy.byGuid[parent.Guid] != nil {
+			hierarchy.children[parent.Guid] = append(hierarchy.children[parent.Guid], category)
+		} else {
+			hierarchy.roots = append(hierarchy.roots, category)
+		}
+	}
+	return hierarchy
+}
+
+// RootCategories returns the top-level categories of the glossary.
+func (h *CategoryHierarchy) RootCategories() []*entities.AtlasGlossaryCategory {
+	return h.roots
+}
+
+// Category returns the category with the given GUID, or nil if it isn't in the glossary.
+func (h *CategoryHierarchy) Category(guid string) *entities.AtlasGlossaryCategory {
+	return h.byGuid[guid]
+}
+
+// Children returns the categories directly within the category with the given GUID.
+func (h *CategoryHierarchy) Children(guid string) []*entities.AtlasGlossaryCategory {
+	return h.children[guid]
+}
+
+// BreadthFirst returns every category of the glossary, level by level from the top-level ones.
+func (h *CategoryHierarchy) BreadthFirst() []*entities.AtlasGlossaryCategory {
+	var ordered []*entities.AtlasGlossaryCategory
+	level := h.roots
+	for len(level) > 0 {
+		ordered = append(ordered, level...)
+		var next []*entities.AtlasGlossaryCategory
+		for _, category := range level {
+			next = append(next, h.children[*category.Guid]...)
+		}
+		level = next
+	}
+	return ordered
+}
+
+// DepthFirst returns every category of the glossary, each followed by the categories within it.
+func (h *CategoryHierarchy) DepthFirst() []*entities.AtlasGlossaryCategory {
+	var ordered []*entities.AtlasGlossaryCategory
+	var visit func(categories []*entities.AtlasGlossaryCategory)
+	visit = func(categories []*entities.AtlasGlossaryCategory) {
+		for _, category := range categories {
+			ordered = append(ordered, category)
+			if category.Guid != nil {
+				visit(h.children[*category.Guid])
+			}
+		}
+	}
+	visit(h.roots)
+	return ordered
+}
+
+// GetCategoryHierarchy retrieves the tree of the active categories of the glossary with the given
+// qualified name. Each category has its parent category and the given attributes.
+func (gc *GlossaryClient) GetCategoryHierarchy(glossaryQualifiedName string, attributes ...string) (*CategoryHierarchy, error) {
+	return gc.GetCategoryHierarchyWithContext(context.Background(), glossaryQualifiedName, attributes...)
+}
+
+// GetCategoryHierarchyWithContext is like GetCategoryHierarchy, but bound to the provided context.
+func (gc *GlossaryClient) GetCategoryHierarchyWithContext(ctx context.Context, glossaryQualifiedName string, attributes ...string) (*CategoryHierarchy, error) {
+	if glossaryQualifiedName == "" {
+		return nil, errors.New("glossaryQualifiedName is a required field")
+	}
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
+	iterator, err := client.NewFluentSearch().
+		ActiveAssets().
+		AssetType("AtlasGlossaryCategory").
+		Where(WithGlossary(glossaryQualifiedName)).
+		Sort(NAME, atlan.SortOrderAscending).
+		SortByGuidDefault().
+		IncludeOnResults(append([]string{"parentCategory"}, attributes...)...).
+		ExecuteWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []*entities.AtlasGlossaryCategory
+	objects, errs := iterator.IterEntities()
+	for object := range objects {
+		if category, ok := object.(*entities.AtlasGlossaryCategory); ok {
+			categories = append(categories, category)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return newCategoryHierarchy(categories), nil
+}
+
+// AppendTerms assigns the terms to the asset of the given type and qualified name, keeping the
+// terms already assigned to it.
+func (gc *GlossaryClient) AppendTerms(typeName, qualifiedName string, terms ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.AppendTermsWithContext(context.Background(), typeName, qualifiedName, terms...)
+}
+
+// AppendTermsWithContext is like AppendTerms, but bound to the provided context.
+func (gc *GlossaryClient) AppendTermsWithContext(ctx context.Context, typeName, qualifiedName string, terms ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.updateAssignedTerms(ctx, typeName, qualifiedName, appendRelationships, terms)
+}
+
+// ReplaceTerms assigns the terms to the asset of the given type and qualified name, removing the
+// terms already assigned to it. Replacing them with no terms removes all of them.
+func (gc *GlossaryClient) ReplaceTerms(typeName, qualifiedName string, terms ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.ReplaceTermsWithContext(context.Background(), typeName, qualifiedName, terms...)
+}
+
+// ReplaceTermsWithContext is like ReplaceTerms, but bound to the provided context.
+func (gc *GlossaryClient) ReplaceTermsWithContext(ctx context.Context, typeName, qualifiedName string, terms ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.updateAssignedTerms(ctx, typeName, qualifiedName, replaceRelationships, terms)
+}
+
+// RemoveTerms removes the terms from the asset of the given type and qualified name, keeping any
+// other terms assigned to it.
+func (gc *GlossaryClient) RemoveTerms(typeName, qualifiedName string, terms ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.RemoveTermsWithContext(context.Background(), typeName, qualifiedName, terms...)
+}
+
+// RemoveTermsWithContext is like RemoveTerms, but bound to the provided context.
+func (gc *GlossaryClient) RemoveTermsWithContext(ctx context.Context, typeName, qualifiedName string, terms ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.updateAssignedTerms(ctx, typeName, qualifiedName, removeRelationships, terms)
+}
+
+// updateAssignedTerms updates the meanings of an asset, which requires its name.
+func (gc *GlossaryClient) updateAssignedTerms(ctx context.Context, typeName, qualifiedName, operation string, terms []*entities.Reference) (*model.AssetMutationResponse, error) {
+	if typeName == "" || qualifiedName == "" {
+		return nil, errors.New("typeName and qualifiedName are required fields")
+	}
+	if operation != replaceRelationships && len(terms) == 0 {
+		return nil, errors.New("at least one term is required")
+	}
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
+	name, err := client.assetName(ctx, typeName, qualifiedName)
+	if err != nil {
+		return nil, err
+	}
+	return client.SaveWithContext(ctx, &relationshipUpdate{
+		typeName:      typeName,
+		name:          name,
+		qualifiedName: qualifiedName,
+		operation:     operation,
+		attribute:     "meanings",
+		references:    terms,
+	})
+}
+
+// assetName retrieves the name of the asset of the given type and qualified name, without its
+// relationships.
+func (ac *AtlanClient) assetName(ctx context.Context, typeName, qualifiedName string) (string, error) {
+	api := GET_ENTITY_BY_UNIQUE_ATTRIBUTE
+	api.Path += typeName
+	queryParams := map[string]string{
+		"attr:qualifiedName":  qualifiedName,
+		"minExtInfo":          "true",
+		"ignoreRelationships": "true",
+	}
+	response, err := ac.CallAPIWithContext(ctx, &api, queryParams, nil)
+	if err != nil {
+		return "", err
+	}
+	var asset struct {
+		Entity struct {
+			Attributes struct {
+				Name string `json:"name"`
+			} `json:"attributes"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(response, &asset); err != nil {
+		return "", err
+	}
+	return asset.Entity.Attributes.Name, nil
+}
+
+// TermRelationship is a relationship between glossary terms, named after the relationship
+// attribute of the term that the related terms are added to.
+type TermRelationship string
+
+const (
+	TermSynonyms         TermRelationship = "synonyms"
+	TermAntonyms         TermRelationship = "antonyms"
+	TermSeeAlso          TermRelationship = "seeAlso"
+	TermReplacedBy       TermRelationship = "replacedBy"
+	TermReplacementTerms TermRelationship = "replacementTerms"
+	TermPreferredTerms   TermRelationship = "preferredTerms"
+	TermTranslatedTerms  TermRelationship = "translatedTerms"
+)
+
+// LinkTerms relates the term to the other terms, keeping the terms it is already related to in
+// the same way. The term needs its name, qualified name and anchor, as it is found by
+// FindTermFastByName or retrieved by GetTerm.
+func (gc *GlossaryClient) LinkTerms(term *entities.AtlasGlossaryTerm, relationship TermRelationship, related ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.LinkTermsWithContext(context.Background(), term, relationship, related...)
+}
+
+// LinkTermsWithContext is like LinkTerms, but bound to the provided context.
+func (gc *GlossaryClient) LinkTermsWithContext(ctx context.Context, term *entities.AtlasGlossaryTerm, relationship TermRelationship, related ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.updateRelatedTerms(ctx, term, relationship, appendRelationships, related)
+}
+
+// UnlinkTerms removes the relationship between the term and the other terms.
+func (gc *GlossaryClient) UnlinkTerms(term *entities.AtlasGlossaryTerm, relationship TermRelationship, related ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.UnlinkTermsWithContext(context.Background(), term, relationship, related...)
+}
+
+// UnlinkTermsWithContext is like UnlinkTerms, but bound to the provided context.
+func (gc *GlossaryClient) UnlinkTermsWithContext(ctx context.Context, term *entities.AtlasGlossaryTerm, relationship TermRelationship, related ...*entities.Reference) (*model.AssetMutationResponse, error) {
+	return gc.updateRelatedTerms(ctx, term, relationship, removeRelationships, related)
+}
+
+// updateRelatedTerms updates a relationship between a term and other terms.
+func (gc *GlossaryClient) updateRelatedTerms(ctx context.Context, term *entities.AtlasGlossaryTerm, relationship TermRelationship, operation string, related []*entities.Reference) (*model.AssetMutationResponse, error) {
+	if term == nil || term.Name == nil || term.QualifiedName == nil || term.Anchor == nil {
+		return nil, errors.New("the name, qualifiedName and anchor of the term are required")
+	}
+	if relationship == "" || len(related) == 0 {
+		return nil, errors.New("a relationship and at least one related term are required")
+	}
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.SaveWithContext(ctx, &relationshipUpdate{
+		typeName:      "AtlasGlossaryTerm",
+		name:          *term.Name,
+		qualifiedName: *term.QualifiedName,
+		anchor:        &entities.Reference{TypeName: "AtlasGlossary", Guid: term.Anchor.Guid, UniqueAttributes: term.Anchor.UniqueAttributes},
+		operation:     operation,
+		attribute:     string(relationship),
+		references:    related,
+	})
+}
+
+// Ways in which a relationshipUpdate changes a relationship attribute, named after the property
+// of the entity that holds the attribute.
+const (
+	replaceRelationships = "relationshipAttributes"
+	appendRelationships  = "appendRelationshipAttributes"
+	removeRelationships  = "removeRelationshipAttributes"
+)
+
+// relationshipUpdate changes a single relationship attribute of an asset, leaving the rest of the
+// asset as it is.
+type relationshipUpdate struct {
+	typeName      string
+	name          string
+	qualifiedName string
+	anchor        *entities.Reference // glossary of a term or category, which must be sent with any update of them
+	operation     string
+	attribute     string
+	references    []*entities.Reference
+}
+
+// MarshalJSON encodes the update as an entity with only its required attributes and the change.
+func (u *relationshipUpdate) MarshalJSON() ([]byte, error) {
+	references := u.references
+	if references == nil {
+		references = []*entities.Reference{}
+	}
+	entity := map[string]interface{}{
+		"typeName": u.typeName,
+		"attributes": map[string]interface{}{
+			"name":          u.name,
+			"qualifiedName": u.qualifiedName,
+		},
+	}
+	relationshipAttributes := make(map[string]interface{})
+	if u.anchor != nil {
+		relationshipAttributes["anchor"] = u.anchor
+	}
+	if u.operation == replaceRelationships {
+		relationshipAttributes[u.attribute] = references
+	} else {
+		entity[u.operation] = map[string]interface{}{u.attribute: references}
+	}
+	if len(relationshipAttributes) > 0 {
+		entity["relationshipAttributes"] = relationshipAttributes
+	}
+	return json.Marshal(entity)
+}
+
+// FromJSON is not supported, as updates are only ever sent.
+func (u *relationshipUpdate) FromJSON(data []byte) error {
+	return errors.New("a relationship update can't be decoded")
+}
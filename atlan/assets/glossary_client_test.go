@@ -1,11 +1,19 @@
 package assets
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var GlossaryName = atlan.MakeUnique("GLS")
@@ -87,3 +95,79 @@ func testDeleteGlossary(t *testing.T, glossaryGUID string) {
 	assert.Len(t, deleteresponse.MutatedEntities.DELETE, 1, "number of glossaries deleted should be 1")
 	assert.Equal(t, glossaryGUID, deleteresponse.MutatedEntities.DELETE[0].Guid, "glossary guid should match")
 }
+
+func TestFindTermFastByName(t *testing.T) {
+	var request model.IndexSearchRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if request.Dsl.Query == nil || !strings.Contains(fmt.Sprint(request.Dsl.Query), "Revenue") {
+			fmt.Fprint(w, `{"queryType": "INDEX", "searchParameters": {}, "approximateCount": 0, "entities": []}`)
+			return
+		}
+		fmt.Fprint(w, `{"queryType": "INDEX", "searchParameters": {}, "approximateCount": 1, "entities": [
+			{"typeName": "AtlasGlossaryTerm", "guid": "t1", "attributes": {"name": "Revenue", "qualifiedName": "t1@g1",
+				"anchor": {"typeName": "AtlasGlossary", "guid": "g1"}, "shortDescription": "Money in"}}
+		]}`)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	term, err := client.GlossaryClient.FindTermFastByName("Revenue", "g1", "shortDescription")
+	require.NoError(t, err)
+	assert.Equal(t, "t1", *term.Guid)
+	assert.Equal(t, "g1", term.Anchor.Guid)
+	assert.Equal(t, "Money in", *term.ShortDescription)
+	assert.Equal(t, []string{"anchor", "shortDescription"}, request.Attributes)
+	query, _ := json.Marshal(request.Dsl.Query)
+	assert.Contains(t, string(query), `{"term":{"__glossary":{"value":"g1"}}}`)
+	assert.Contains(t, string(query), `{"term":{"__typeName.keyword":{"value":"AtlasGlossaryTerm"}}}`)
+
+	_, err = client.GlossaryClient.FindTermFastByName("Cost", "g1")
+	assert.True(t, errors.Is(err, ErrNotFound), "%v", err)
+}
+
+func TestGetCategoryHierarchy(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request model.IndexSearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, []string{"parentCategory"}, request.Attributes)
+		if len(request.Dsl.SearchAfter) > 0 {
+			fmt.Fprint(w, `{"queryType": "INDEX", "searchParameters": {}, "approximateCount": 5, "entities": []}`)
+			return
+		}
+		category := func(guid, name, parent string) string {
+			if parent == "" {
+				return fmt.Sprintf(`{"typeName": "AtlasGlossaryCategory", "guid": %q, "attributes": {"name": %q}}`, guid, name)
+			}
+			return fmt.Sprintf(`{"typeName": "AtlasGlossaryCategory", "guid": %q, "attributes": {"name": %q,
+				"parentCategory": {"typeName": "AtlasGlossaryCategory", "guid": %q}}}`, guid, name, parent)
+		}
+		fmt.Fprintf(w, `{"queryType": "INDEX", "searchParameters": {}, "approximateCount": 5, "entities": [%s]}`,
+			strings.Join([]string{
+				category("c1", "Finance", ""),
+				category("c2", "Marketing", ""),
+				category("c3", "Payables", "c1"),
+				category("c4", "Receivables", "c1"),
+				category("c5", "Invoices", "c3"),
+			}, ","))
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	hierarchy, err := client.GlossaryClient.GetCategoryHierarchy("g1")
+	require.NoError(t, err)
+
+	guids := func(categories []*entities.AtlasGlossaryCategory) []string {
+		var guids []string
+		for _, category := range categories {
+			guids = append(guids, *category.Guid)
+		}
+		return guids
+	}
+	assert.Equal(t, []string{"c1", "c2"}, guids(hierarchy.RootCategories()))
+	assert.Equal(t, []string{"c3", "c4"}, guids(hierarchy.Children("c1")))
+	assert.Equal(t, []string{"c1", "c2", "c3", "c4", "c5"}, guids(hierarchy.BreadthFirst()))
+	assert.Equal(t, []string{"c1", "c3", "c5", "c4", "c2"}, guids(hierarchy.DepthFirst()))
+	assert.Equal(t, "Invoices", *hierarchy.Category("c5").Name)
+	assert.Nil(t, hierarchy.Category("c9"))
+}
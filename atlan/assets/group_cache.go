@@ -15,65 +15,81 @@ type GroupCache struct {
 	mutex        sync.Mutex
 }
 
-var (
-	groupCaches = make(map[string]*GroupCache)
-	groupMutex  sync.Mutex
-)
-
-// GetGroupCache retrieves the GroupCache for the default Atlan client.
-func GetGroupCache() (*GroupCache, error) {
-	groupMutex.Lock()
-	defer groupMutex.Unlock()
-
-	client := DefaultAtlanClient
-	cacheKey := generateCacheKey(client.host, client.ApiKey)
-
-	if groupCaches[cacheKey] == nil {
-		groupCaches[cacheKey] = &GroupCache{
-			groupClient:  client.GroupClient,
+// GroupCache returns the GroupCache owned by this client.
+func (ac *AtlanClient) GroupCache() *GroupCache {
+	ac.caches.mutex.Lock()
+	defer ac.caches.mutex.Unlock()
+
+	if ac.caches.group == nil {
+		ac.caches.group = &GroupCache{
+			groupClient:  (*GroupClient)(ac),
 			cacheByID:    make(map[string]AtlanGroup),
 			mapIDToName:  make(map[string]string),
 			mapNameToID:  make(map[string]string),
 			mapAliasToID: make(map[string]string),
 		}
 	}
-	return groupCaches[cacheKey], nil
+	return ac.caches.group
 }
 
-// GetGroupIDForGroupName translates the provided group name to its GUID.
+// GetGroupCache retrieves the GroupCache for the default Atlan client.
+func GetGroupCache() (*GroupCache, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.GroupCache(), nil
+}
+
+// GetGroupIDForGroupName translates the provided group name to its GUID, using the default client.
 func GetGroupIDForGroupName(name string) (string, error) {
-	cache, err := GetGroupCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getIDForName(name), nil
+	return DefaultAtlanClient.GetGroupIDForGroupName(name)
 }
 
-// GetGroupIDForAlias translates the provided group alias to its GUID.
+// GetGroupIDForGroupName translates the provided group name to its GUID.
+func (ac *AtlanClient) GetGroupIDForGroupName(name string) (string, error) {
+	return ac.GroupCache().getIDForName(name), nil
+}
+
+// GetGroupIDForAlias translates the provided group alias to its GUID, using the default client.
 func GetGroupIDForAlias(alias string) (string, error) {
-	cache, err := GetGroupCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getIDForAlias(alias), nil
+	return DefaultAtlanClient.GetGroupIDForAlias(alias)
 }
 
-// GetGroupNameForGroupID translates the provided group GUID to its name.
+// GetGroupIDForAlias translates the provided group alias to its GUID.
+func (ac *AtlanClient) GetGroupIDForAlias(alias string) (string, error) {
+	return ac.GroupCache().getIDForAlias(alias), nil
+}
+
+// GetGroupNameForGroupID translates the provided group GUID to its name, using the default client.
 func GetGroupNameForGroupID(id string) (string, error) {
-	cache, err := GetGroupCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getNameForID(id), nil
+	return DefaultAtlanClient.GetGroupNameForGroupID(id)
 }
 
-// ValidateGroupAliases validates that the given group aliases are valid.
+// GetGroupNameForGroupID translates the provided group GUID to its name.
+func (ac *AtlanClient) GetGroupNameForGroupID(id string) (string, error) {
+	return ac.GroupCache().getNameForID(id), nil
+}
+
+// ValidateGroupAliases validates that the given group aliases are valid, using the default client.
 func ValidateGroupAliases(aliases []string) error {
-	cache, err := GetGroupCache()
-	if err != nil {
-		return err
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
 	}
-	return cache.validateAliases(aliases)
+	return DefaultAtlanClient.ValidateGroupAliases(aliases)
+}
+
+// ValidateGroupAliases validates that the given group aliases are valid.
+func (ac *AtlanClient) ValidateGroupAliases(aliases []string) error {
+	return ac.GroupCache().validateAliases(aliases)
 }
 
 func (gc *GroupCache) refreshCache() error {
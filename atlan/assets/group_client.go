@@ -1,6 +1,7 @@
 package assets
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -12,6 +13,18 @@ type AtlanGroup structs.AtlanGroup
 
 type GroupClient AtlanClient
 
+// client returns the AtlanClient backing this GroupClient,
+// falling back to the default client for a zero-value GroupClient.
+func (gc *GroupClient) client() (*AtlanClient, error) {
+	if gc != nil && gc.Session != nil {
+		return (*AtlanClient)(gc), nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
 // Create creates a new Atlan group with the given alias.
 func (g *AtlanGroup) Create(alias string) (*AtlanGroup, error) {
 	// Generate group name and attributes.
@@ -52,6 +65,15 @@ func (g *AtlanGroup) Updater(guid, path string) (*AtlanGroup, error) {
 // Update updates the details of an existing group.
 // The provided `group` must have its ID populated.
 func (gc *GroupClient) Update(group *AtlanGroup) error {
+	return gc.UpdateWithContext(context.Background(), group)
+}
+
+// UpdateWithContext is like Update, but bound to the provided context.
+func (gc *GroupClient) UpdateWithContext(ctx context.Context, group *AtlanGroup) error {
+	client, err := gc.client()
+	if err != nil {
+		return err
+	}
 	if group.ID == nil {
 		return fmt.Errorf("group ID must be populated")
 	}
@@ -59,7 +81,7 @@ func (gc *GroupClient) Update(group *AtlanGroup) error {
 	api := &UPDATE_GROUP
 	api.Path = fmt.Sprintf("groups/%s", *group.ID)
 
-	_, err := DefaultAtlanClient.CallAPI(api, nil, group)
+	_, err = client.CallAPIWithContext(ctx, api, nil, group)
 	if err != nil {
 		return fmt.Errorf("failed to update group: %w", err)
 	}
@@ -68,6 +90,15 @@ func (gc *GroupClient) Update(group *AtlanGroup) error {
 
 // Purge deletes a group by its unique identifier (GUID).
 func (gc *GroupClient) Purge(guid string) error {
+	return gc.PurgeWithContext(context.Background(), guid)
+}
+
+// PurgeWithContext is like Purge, but bound to the provided context.
+func (gc *GroupClient) PurgeWithContext(ctx context.Context, guid string) error {
+	client, err := gc.client()
+	if err != nil {
+		return err
+	}
 	if guid == "" {
 		return fmt.Errorf("GUID cannot be empty")
 	}
@@ -76,7 +107,7 @@ func (gc *GroupClient) Purge(guid string) error {
 	api := &DELETE_GROUP
 	api.Path = fmt.Sprintf("groups/%s/delete", guid)
 
-	_, err := DefaultAtlanClient.CallAPI(api, nil, requestPayload)
+	_, err = client.CallAPIWithContext(ctx, api, nil, requestPayload)
 	if err != nil {
 		return fmt.Errorf("failed to delete group: %w", err)
 	}
@@ -98,6 +129,15 @@ type CreateGroupRequest struct {
 
 // Create creates a new group in Atlan.
 func (gc *GroupClient) Create(group *AtlanGroup, userIDs []string) (*structs.CreateGroupResponse, error) {
+	return gc.CreateWithContext(context.Background(), group, userIDs)
+}
+
+// CreateWithContext is like Create, but bound to the provided context.
+func (gc *GroupClient) CreateWithContext(ctx context.Context, group *AtlanGroup, userIDs []string) (*structs.CreateGroupResponse, error) {
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
 	if group == nil {
 		return nil, fmt.Errorf("group cannot be nil")
 	}
@@ -111,7 +151,7 @@ func (gc *GroupClient) Create(group *AtlanGroup, userIDs []string) (*structs.Cre
 		payload.Users = userIDs
 	}
 
-	responseData, err := DefaultAtlanClient.CallAPI(&CREATE_GROUP, nil, payload)
+	responseData, err := client.CallAPIWithContext(ctx, &CREATE_GROUP, nil, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +166,15 @@ func (gc *GroupClient) Create(group *AtlanGroup, userIDs []string) (*structs.Cre
 
 // Get retrieves a list of groups with optional filters.
 func (gc *GroupClient) Get(limit int, postFilter, sort string, count bool, offset int) (*GroupResponse, error) {
+	return gc.GetWithContext(context.Background(), limit, postFilter, sort, count, offset)
+}
+
+// GetWithContext is like Get, but bound to the provided context.
+func (gc *GroupClient) GetWithContext(ctx context.Context, limit int, postFilter, sort string, count bool, offset int) (*GroupResponse, error) {
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
 	request := &structs.GroupRequest{
 		PostFilter: &postFilter,
 		Sort:       sort,
@@ -136,7 +185,7 @@ func (gc *GroupClient) Get(limit int, postFilter, sort string, count bool, offse
 
 	queryParams := request.QueryParams()
 
-	responseData, err := DefaultAtlanClient.CallAPI(&GET_GROUPS, queryParams, nil)
+	responseData, err := client.CallAPIWithContext(ctx, &GET_GROUPS, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +199,12 @@ func (gc *GroupClient) Get(limit int, postFilter, sort string, count bool, offse
 
 // GetAll retrieves all groups in Atlan.
 func (gc *GroupClient) GetAll(limit, offset int, sort string) ([]*AtlanGroup, error) {
-	groupResponse, err := gc.Get(limit, "", sort, true, offset)
+	return gc.GetAllWithContext(context.Background(), limit, offset, sort)
+}
+
+// GetAllWithContext is like GetAll, but bound to the provided context.
+func (gc *GroupClient) GetAllWithContext(ctx context.Context, limit, offset int, sort string) ([]*AtlanGroup, error) {
+	groupResponse, err := gc.GetWithContext(ctx, limit, "", sort, true, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -159,8 +213,13 @@ func (gc *GroupClient) GetAll(limit, offset int, sort string) ([]*AtlanGroup, er
 
 // GetByName retrieves groups with names containing the provided alias.
 func (gc *GroupClient) GetByName(alias string, limit, offset int) ([]*AtlanGroup, error) {
+	return gc.GetByNameWithContext(context.Background(), alias, limit, offset)
+}
+
+// GetByNameWithContext is like GetByName, but bound to the provided context.
+func (gc *GroupClient) GetByNameWithContext(ctx context.Context, alias string, limit, offset int) ([]*AtlanGroup, error) {
 	postFilter := fmt.Sprintf(`{"$and":[{"alias":{"$ilike":"%%%s%%"}}]}`, alias)
-	groupResponse, err := gc.Get(limit, postFilter, "", true, offset)
+	groupResponse, err := gc.GetWithContext(ctx, limit, postFilter, "", true, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +228,15 @@ func (gc *GroupClient) GetByName(alias string, limit, offset int) ([]*AtlanGroup
 
 // GetMembers retrieves members of a group by GUID.
 func (gc *GroupClient) GetMembers(guid string, request *structs.UserRequest) ([]AtlanUser, error) {
+	return gc.GetMembersWithContext(context.Background(), guid, request)
+}
+
+// GetMembersWithContext is like GetMembers, but bound to the provided context.
+func (gc *GroupClient) GetMembersWithContext(ctx context.Context, guid string, request *structs.UserRequest) ([]AtlanUser, error) {
+	client, err := gc.client()
+	if err != nil {
+		return nil, err
+	}
 	if guid == "" {
 		return nil, fmt.Errorf("guid cannot be empty")
 	}
@@ -180,7 +248,7 @@ func (gc *GroupClient) GetMembers(guid string, request *structs.UserRequest) ([]
 	api := &GET_GROUP_MEMBERS
 	api.Path = fmt.Sprintf("groups/%s/members", guid)
 
-	responseData, err := DefaultAtlanClient.CallAPI(api, request.QueryParams(), nil)
+	responseData, err := client.CallAPIWithContext(ctx, api, request.QueryParams(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +267,15 @@ type RemoveFromGroupRequest struct {
 
 // RemoveUsers removes users from a group by GUID.
 func (gc *GroupClient) RemoveUsers(guid string, userIDs []string) error {
+	return gc.RemoveUsersWithContext(context.Background(), guid, userIDs)
+}
+
+// RemoveUsersWithContext is like RemoveUsers, but bound to the provided context.
+func (gc *GroupClient) RemoveUsersWithContext(ctx context.Context, guid string, userIDs []string) error {
+	client, err := gc.client()
+	if err != nil {
+		return err
+	}
 	if guid == "" {
 		return fmt.Errorf("guid cannot be empty")
 	}
@@ -209,7 +286,7 @@ func (gc *GroupClient) RemoveUsers(guid string, userIDs []string) error {
 
 	api := &REMOVE_USERS_FROM_GROUP
 	api.Path = fmt.Sprintf("groups/%s/members/remove", guid)
-	_, err := DefaultAtlanClient.CallAPI(api, nil, request)
+	_, err = client.CallAPIWithContext(ctx, api, nil, request)
 	return err
 }
 
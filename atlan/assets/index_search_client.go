@@ -1,51 +1,89 @@
 package assets
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"sync"
+	"io"
 
 	"github.com/atlanhq/atlan-go/atlan"
 	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
 )
 
 // Call the search API
 func Search(request model.IndexSearchRequest) (*IndexSearchIterator, error) {
-	// Define the API endpoint
-	api := &INDEX_SEARCH
+	return SearchWithContext(context.Background(), request)
+}
+
+// SearchWithContext calls the search API bound to the provided context.
+// The returned iterator keeps the context, so the pages it fetches
+// are also cancelled along with it.
+func SearchWithContext(ctx context.Context, request model.IndexSearchRequest) (*IndexSearchIterator, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.SearchWithContext(ctx, request)
+}
+
+// Search calls the search API using this client.
+func (ac *AtlanClient) Search(request model.IndexSearchRequest) (*IndexSearchIterator, error) {
+	return ac.SearchWithContext(context.Background(), request)
+}
 
+// SearchWithContext is like Search, but bound to the provided context.
+// Any further pages fetched by the returned iterator use this client and context.
+// No page is fetched until the iterator is used, so that Iter and IterEntities stream every
+// page, including the first: errors of the search are returned when its first page is fetched.
+func (ac *AtlanClient) SearchWithContext(ctx context.Context, request model.IndexSearchRequest) (*IndexSearchIterator, error) {
 	if request.Dsl.Size == 0 {
 		request.Dsl.Size = 300 // Switch to default page size
 	}
+	return ac.NewIndexSearchIteratorWithContext(ctx, request.Dsl.Size, request), nil
+}
 
-	// Call the API
-	responseBytes, err := DefaultAtlanClient.CallAPI(api, nil, &request)
+// search calls the search API, loading the whole page of results in memory. When typed, each
+// asset is also decoded into the type generated for its typeName.
+func (ac *AtlanClient) search(ctx context.Context, request model.IndexSearchRequest, typed bool) (*model.IndexSearchResponse, error) {
+	responseBytes, err := ac.CallAPIWithContext(ctx, &INDEX_SEARCH, nil, &request)
 	if err != nil {
 		return nil, err
 	}
+	return model.UnmarshalIndexSearchResponse(responseBytes, typed)
+}
+
+// searchStream calls the search API, passing each entity of the response to onEntity as it is
+// decoded instead of loading the whole page in memory. The returned page has no entities. When
+// typed, each asset is also decoded into the type generated for its typeName.
+func (ac *AtlanClient) searchStream(ctx context.Context, request model.IndexSearchRequest, typed bool, onEntity func(*model.SearchAssets) error) (*model.IndexSearchResponse, error) {
+	if request.Dsl.Size == 0 {
+		request.Dsl.Size = 300 // Switch to default page size
+	}
 
-	// Unmarshal response
-	var response model.IndexSearchResponse
-	err = json.Unmarshal(responseBytes, &response)
+	var response *model.IndexSearchResponse
+	_, err := ac.CallAPIWithContext(ctx, &INDEX_SEARCH, nil, &request, map[string]interface{}{
+		"stream_response": func(body io.Reader) error {
+			var err error
+			response, err = model.DecodeIndexSearchResponse(body, request.Attributes, typed, onEntity)
+			return err
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	// Initialize the iterator with the first page (since we already fetch the first page)
-	return &IndexSearchIterator{
-		request:        request,
-		currentPage:    &response,
-		currentIndex:   0,
-		currentPageNum: 1,
-		pageSize:       request.Dsl.Size,
-		totalResults:   response.ApproximateCount,
-		hasMoreResults: len(response.Entities) > 0,
-	}, nil
+	return response, nil
 }
 
 // FindGlossaryByName searches for a glossary by name.
 func FindGlossaryByName(glossaryName string) (*model.IndexSearchResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.FindGlossaryByName(glossaryName)
+}
+
+// FindGlossaryByName searches for a glossary by name.
+func (ac *AtlanClient) FindGlossaryByName(glossaryName string) (*model.IndexSearchResponse, error) {
 	boolQuery, err := WithActiveGlossary(glossaryName)
 	if err != nil {
 		return nil, err
@@ -76,7 +114,7 @@ func FindGlossaryByName(glossaryName string) (*model.IndexSearchResponse, error)
 		},
 	}
 
-	iterator := NewIndexSearchIterator(pageSize, request)
+	iterator := ac.NewIndexSearchIterator(pageSize, request)
 
 	for iterator.HasMoreResults() {
 		responses, err := iterator.IteratePages()
@@ -103,6 +141,14 @@ func FindGlossaryByName(glossaryName string) (*model.IndexSearchResponse, error)
 
 // FindCategoryByName searches for a category by name.
 func FindCategoryByName(categoryName string, glossaryQualifiedName string) (*model.IndexSearchResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.FindCategoryByName(categoryName, glossaryQualifiedName)
+}
+
+// FindCategoryByName searches for a category by name.
+func (ac *AtlanClient) FindCategoryByName(categoryName string, glossaryQualifiedName string) (*model.IndexSearchResponse, error) {
 	boolQuery, err := WithActiveCategory(categoryName, glossaryQualifiedName)
 	if err != nil {
 		return nil, err
@@ -122,14 +168,14 @@ func FindCategoryByName(categoryName string, glossaryQualifiedName string) (*mod
 		ExcludeAtlanTags: false,
 	}
 
-	iterator := NewIndexSearchIterator(pageSize, request)
+	iterator := ac.NewIndexSearchIterator(pageSize, request)
 
 	for iterator.HasMoreResults() {
 		response, err := iterator.NextPage()
 		if err != nil {
 			return nil, fmt.Errorf("error executing search: %v", err)
 		}
-		fmt.Println("Current Page: ", iterator.CurrentPageNumber())
+		ac.logger.Debugf("Current page: %d", iterator.CurrentPageNumber())
 		for _, entity := range response.Entities {
 			if *entity.TypeName == "AtlasGlossaryCategory" {
 				return response, err
@@ -242,7 +288,15 @@ func WithGlossary(value string) *model.TermQuery {
 }
 
 // Pagination Implemented here:
+//
+// Pages are fetched with search_after cursors on the sort values of the last asset of the previous
+// page whenever the request is sorted with a GUID tiebreaker (as FluentSearch sorts are), which avoids
+// Elasticsearch's deep pagination limit. Other requests are paged with from and size.
+// With timestamp slicing, assets are instead sorted by creation time, and each page is restricted
+// to the assets created at or after the last one returned.
 type IndexSearchIterator struct {
+	client         *AtlanClient
+	ctx            context.Context
 	request        model.IndexSearchRequest
 	currentPage    *model.IndexSearchResponse // Use a pointer for pagination
 	currentIndex   int                        // Track position in current page
@@ -250,52 +304,160 @@ type IndexSearchIterator struct {
 	pageSize       int
 	totalResults   int64
 	hasMoreResults bool
+	cursor         []interface{}                      // Sort values of the last asset returned, for search_after
+	slicing        bool                               // Whether pages are fetched in timestamp slices
+	slicingStarted bool                               // Whether the first page has been fetched in timestamp slices
+	sliceStart     int64                              // Creation timestamp from which the current slice starts
+	sliceOffset    int                                // Offset of the next page within the current slice
+	lastTimestamp  int64                              // Creation timestamp of the last asset returned
+	lastGUIDs      map[string]bool                    // GUIDs of the assets returned with the last timestamp
+	concurrency    int                                // Pages fetched at once in parallel, 0 for the client's default
+	unordered      bool                               // Whether pages fetched in parallel are delivered as they are fetched
+	aggregations   map[string]model.AggregationResult // Aggregation results of the first page
+	counted        bool                               // Whether the count and aggregations were fetched before any page
+	typed          bool                               // Whether assets are also decoded into their generated types
 }
 
 // Iter returns a channel to iterate over search results.
+// Pages that have not been fetched yet are streamed: their entities are decoded one at a time
+// from the response and sent on the channel as they are decoded, so memory use stays bounded
+// regardless of the page size. Streamed pages are not retained by the iterator.
+// If the iterator's context is cancelled, no further pages are fetched
+// and the context's error is sent on the error channel.
 func (it *IndexSearchIterator) Iter() (<-chan *model.SearchAssets, <-chan error) {
 	assetsCh := make(chan *model.SearchAssets)
 	errCh := make(chan error, 1) // Buffered to avoid deadlocks
+	ctx := it.context()
+
+	send := func(asset *model.SearchAssets) error {
+		select {
+		case assetsCh <- asset:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 
 	go func() {
 		defer close(assetsCh)
 		defer close(errCh)
+		if err := it.each(ctx, send); err != nil {
+			errCh <- err // Send error and stop iteration
+		}
+	}()
 
-		for {
-			// Fetch the first page if needed
-			if it.currentPage == nil {
-				_, err := it.NextPage()
-				if err != nil {
-					errCh <- err // Send error and stop iteration
-					return
-				}
-			}
+	return assetsCh, errCh
+}
 
-			// Iterate over current page assets
-			for it.currentIndex < len(it.currentPage.Entities) {
-				assetsCh <- &it.currentPage.Entities[it.currentIndex]
-				it.currentIndex++
-			}
+// IterEntities is like Iter, but decodes each asset into the type generated for its typeName
+// (see entities.Decode), for use in a type switch. Attributes that the type doesn't declare are
+// kept in its UnknownAttributes rather than dropped. The assets of a page fetched before
+// IterEntities is called (with NextPage or CurrentPage) are only available if it was fetched
+// WithEntities.
+func (it *IndexSearchIterator) IterEntities() (<-chan entities.Object, <-chan error) {
+	it.typed = true
+	entitiesCh := make(chan entities.Object)
+	errCh := make(chan error, 1) // Buffered to avoid deadlocks
+	ctx := it.context()
 
-			// Fetch the next page if available
-			if it.hasMoreResults {
-				_, err := it.NextPage()
-				if err != nil {
-					errCh <- err // Send error and stop iteration
-					return
-				}
-				it.currentIndex = 0 // Reset index for the new page
-			} else {
-				return
-			}
+	send := func(asset *model.SearchAssets) error {
+		entity, err := asset.ToEntity()
+		if err != nil {
+			return err
+		}
+		select {
+		case entitiesCh <- entity:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	go func() {
+		defer close(entitiesCh)
+		defer close(errCh)
+		if err := it.each(ctx, send); err != nil {
+			errCh <- err
 		}
 	}()
 
-	return assetsCh, errCh
+	return entitiesCh, errCh
+}
+
+// each passes every remaining asset of the search to send, starting with those of the page
+// already fetched (if any), until there are no more results or send fails.
+func (it *IndexSearchIterator) each(ctx context.Context, send func(*model.SearchAssets) error) error {
+	if it.currentPage != nil {
+		for it.currentIndex < len(it.currentPage.Entities) {
+			if err := send(&it.currentPage.Entities[it.currentIndex]); err != nil {
+				return err
+			}
+			it.currentIndex++
+		}
+	}
+
+	// Stream the next pages while available
+	for it.hasMoreResults {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := it.streamNextPage(send); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamNextPage fetches the next page of search results, passing each of its assets to onEntity
+// as it is decoded. The page is kept as the current page, without its entities.
+func (it *IndexSearchIterator) streamNextPage(onEntity func(*model.SearchAssets) error) error {
+	request := it.nextPageRequest()
+
+	client, err := it.atlanClient()
+	if err != nil {
+		return err
+	}
+	count := 0
+	var last *model.SearchAssets
+	page, err := client.searchStream(it.context(), request, it.typed, func(asset *model.SearchAssets) error {
+		count++
+		last = asset
+		if it.alreadyReturned(asset) {
+			return nil
+		}
+		it.observe(asset)
+		return onEntity(asset)
+	})
+	if err != nil {
+		return err
+	}
+
+	it.currentPage = page
+	it.currentIndex = 0
+	it.pageFetched(request, page, count, last)
+	return nil
 }
 
 func NewIndexSearchIterator(pageSize int, initialRequest model.IndexSearchRequest) *IndexSearchIterator {
+	return NewIndexSearchIteratorWithContext(context.Background(), pageSize, initialRequest)
+}
+
+// NewIndexSearchIteratorWithContext creates an iterator whose page fetches are bound to the provided context.
+func NewIndexSearchIteratorWithContext(ctx context.Context, pageSize int, initialRequest model.IndexSearchRequest) *IndexSearchIterator {
+	return DefaultAtlanClient.NewIndexSearchIteratorWithContext(ctx, pageSize, initialRequest)
+}
+
+// NewIndexSearchIterator creates an iterator whose pages are fetched using this client.
+func (ac *AtlanClient) NewIndexSearchIterator(pageSize int, initialRequest model.IndexSearchRequest) *IndexSearchIterator {
+	return ac.NewIndexSearchIteratorWithContext(context.Background(), pageSize, initialRequest)
+}
+
+// NewIndexSearchIteratorWithContext creates an iterator whose pages are fetched using this client,
+// bound to the provided context.
+func (ac *AtlanClient) NewIndexSearchIteratorWithContext(ctx context.Context, pageSize int, initialRequest model.IndexSearchRequest) *IndexSearchIterator {
 	return &IndexSearchIterator{
+		client:         ac,
+		ctx:            ctx,
 		request:        initialRequest,
 		currentPage:    nil,
 		currentPageNum: 0,
@@ -305,26 +467,184 @@ func NewIndexSearchIterator(pageSize int, initialRequest model.IndexSearchReques
 	}
 }
 
+// WithTimestampSlicing pages through the results in slices of creation time, like the bulk searches
+// of the other SDKs: results are sorted by creation time (and GUID), replacing any other sort, and each
+// page only asks for the assets created at or after the last one returned. This keeps every request
+// shallow and the enumeration stable while assets are created, for result sets of millions of assets.
+// It must be called before any page is fetched.
+func (it *IndexSearchIterator) WithTimestampSlicing() *IndexSearchIterator {
+	it.slicing = true
+	it.request.Dsl.Sort = []map[string]interface{}{
+		(&model.SortItem{Field: CREATE_TIME_AS_TIMESTAMP, Order: atlan.SortOrderAscending}).ToJSON(),
+		(&model.SortItem{Field: GUID, Order: atlan.SortOrderAscending}).ToJSON(),
+	}
+	return it
+}
+
+// atlanClient returns the client the iterator fetches its pages with.
+func (it *IndexSearchIterator) atlanClient() (*AtlanClient, error) {
+	if it.client != nil {
+		return it.client, nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
+// context returns the context the iterator is bound to.
+func (it *IndexSearchIterator) context() context.Context {
+	if it.ctx == nil {
+		return context.Background()
+	}
+	return it.ctx
+}
+
 // NextPage returns the next page of search results.
 func (it *IndexSearchIterator) NextPage() (*model.IndexSearchResponse, error) {
 	if !it.hasMoreResults {
 		return nil, fmt.Errorf("no more results available")
 	}
 
-	it.request.Dsl.From = it.currentPageNum * it.pageSize
-	it.request.Dsl.Size = it.pageSize
-
-	response, err := Search(it.request)
+	client, err := it.atlanClient()
+	if err != nil {
+		return nil, err
+	}
+	request := it.nextPageRequest()
+	page, err := client.search(it.context(), request, it.typed)
 	if err != nil {
 		return nil, err
 	}
 
-	it.currentPage = response.currentPage
-	it.totalResults = response.currentPage.ApproximateCount
-	it.hasMoreResults = int64(it.request.Dsl.From+it.pageSize) < it.totalResults
+	count := len(page.Entities)
+	var last *model.SearchAssets
+	if count > 0 {
+		last = &page.Entities[count-1]
+	}
+	entities := page.Entities[:0]
+	for i := range page.Entities {
+		if !it.alreadyReturned(&page.Entities[i]) {
+			it.observe(&page.Entities[i])
+			entities = append(entities, page.Entities[i])
+		}
+	}
+	page.Entities = entities
+
+	it.currentPage = page
+	it.currentIndex = 0
+	it.pageFetched(request, page, count, last)
+
+	return page, nil
+}
+
+// nextPageRequest returns the request for the next page of results.
+func (it *IndexSearchIterator) nextPageRequest() model.IndexSearchRequest {
+	if it.pageSize <= 0 {
+		it.pageSize = 300 // Switch to default page size
+	}
+	request := it.request
+	request.Dsl.Size = it.pageSize
+	request.Dsl.SearchAfter = nil
+	if it.currentPageNum > 0 || it.counted {
+		// Aggregations cover all the results, so they are only needed once
+		request.Dsl.Aggregation = nil
+	}
+	switch {
+	case it.slicing && it.slicingStarted:
+		request.Dsl.From = it.sliceOffset
+		timestamp := float64(it.sliceStart)
+		since := &model.RangeQuery{Field: CREATE_TIME_AS_TIMESTAMP, Gte: &timestamp}
+		if request.Dsl.Query == nil {
+			request.Dsl.Query = since.ToJSON()
+		} else {
+			request.Dsl.Query = map[string]interface{}{
+				"bool": map[string]interface{}{
+					"filter": []map[string]interface{}{request.Dsl.Query, since.ToJSON()},
+				},
+			}
+		}
+	case it.slicing:
+		request.Dsl.From = 0
+	case it.cursor != nil:
+		request.Dsl.From = 0
+		request.Dsl.SearchAfter = it.cursor
+	default:
+		request.Dsl.From = it.currentPageNum * it.pageSize
+	}
+	it.request.Dsl.From = request.Dsl.From
+	return request
+}
+
+// pageFetched updates the paging state after fetching a page of count assets, the last of which is last.
+func (it *IndexSearchIterator) pageFetched(request model.IndexSearchRequest, page *model.IndexSearchResponse, count int, last *model.SearchAssets) {
+	if it.currentPageNum == 0 && !it.counted {
+		it.aggregations = page.Aggregations
+	}
 	it.currentPageNum++
+	if !it.slicingStarted {
+		// The counts of later slices only cover the rest of the results
+		it.totalResults = page.ApproximateCount
+	}
+
+	switch {
+	case it.slicing:
+		if it.slicingStarted && it.lastTimestamp == it.sliceStart {
+			// All the assets of the page were created at the same time, move on within the slice
+			it.sliceOffset += count
+		} else {
+			it.sliceStart = it.lastTimestamp
+			it.sliceOffset = 0
+		}
+		it.slicingStarted = true
+		it.hasMoreResults = count > 0 && count == request.Dsl.Size
+	case it.sortedWithTiebreaker():
+		it.cursor = nil
+		if last != nil {
+			// Fall back to from and size if the cursor can't be computed from the last asset
+			if cursor, ok := last.SortValues(it.request.Dsl.Sort); ok {
+				it.cursor = cursor
+			}
+		}
+		it.hasMoreResults = count > 0 && int64(it.currentPageNum*it.pageSize) < it.totalResults
+	default:
+		// Stop on an empty page, even if the approximate count suggests more results
+		it.hasMoreResults = count > 0 && int64(request.Dsl.From+it.pageSize) < it.totalResults
+	}
+}
 
-	return response.currentPage, nil
+// sortedWithTiebreaker reports whether the request is sorted with the GUID as last criteria,
+// so that the sort values of an asset identify its position among the results.
+func (it *IndexSearchIterator) sortedWithTiebreaker() bool {
+	sorts := it.request.Dsl.Sort
+	if len(sorts) == 0 {
+		return false
+	}
+	_, ok := sorts[len(sorts)-1][GUID]
+	return ok
+}
+
+// observe tracks the creation timestamp of an asset returned by a timestamp sliced iteration.
+func (it *IndexSearchIterator) observe(asset *model.SearchAssets) {
+	if !it.slicing {
+		return
+	}
+	var timestamp int64
+	if asset.CreateTime != nil {
+		timestamp = int64(*asset.CreateTime)
+	}
+	if it.lastGUIDs == nil || timestamp != it.lastTimestamp {
+		it.lastTimestamp = timestamp
+		it.lastGUIDs = make(map[string]bool)
+	}
+	if asset.Guid != nil {
+		it.lastGUIDs[*asset.Guid] = true
+	}
+}
+
+// alreadyReturned reports whether an asset of a timestamp slice was returned by the previous slice,
+// since slices start with the assets created at the last timestamp of the previous slice.
+func (it *IndexSearchIterator) alreadyReturned(asset *model.SearchAssets) bool {
+	return it.slicing && it.slicingStarted && asset.Guid != nil && it.lastGUIDs[*asset.Guid]
 }
 
 // CurrentPageNumber returns the current page number.
@@ -342,70 +662,169 @@ func (it *IndexSearchIterator) CurrentPage() (*model.IndexSearchResponse, error)
 	return it.currentPage, nil
 }
 
-// Return the approximate count for the search results
+// Count returns the approximate count of the search results. If no page has been fetched yet,
+// it is fetched with a request for no results. It returns 0 if that request fails: the error is
+// returned when fetching the first page.
 func (it *IndexSearchIterator) Count() int64 {
-	// Return the approximate count for the current page
-	return it.currentPage.ApproximateCount
+	it.fetchCount()
+	// Return the approximate count from the first page, later timestamp slices only count the rest
+	return it.totalResults
+}
+
+// Aggregations returns the results of the aggregations of the search, by name. They cover all
+// the results of the search, and are returned with its first page, or fetched like Count if no
+// page has been fetched yet.
+func (it *IndexSearchIterator) Aggregations() map[string]model.AggregationResult {
+	it.fetchCount()
+	return it.aggregations
 }
 
-// IteratePages returns all pages of search results.
+// fetchCount fetches the count and the aggregations of the search with a request for no
+// results, if no page has been fetched yet, leaving the first page to be fetched by iterating.
+func (it *IndexSearchIterator) fetchCount() {
+	if it.currentPageNum > 0 || it.counted {
+		return
+	}
+	client, err := it.atlanClient()
+	if err != nil {
+		return
+	}
+	request := it.request
+	request.Dsl.From = 0
+	request.Dsl.Size = 0
+	request.Dsl.SearchAfter = nil
+	response, err := client.search(it.context(), request, false)
+	if err != nil {
+		return
+	}
+	it.counted = true
+	it.totalResults = response.ApproximateCount
+	it.aggregations = response.Aggregations
+}
+
+// WithEntities makes the iterator decode each asset into the type generated for its typeName as
+// well, so that ToEntity returns it for the assets of the pages returned by NextPage, CurrentPage
+// and ParallelPages. IterEntities does so on its own.
+func (it *IndexSearchIterator) WithEntities() *IndexSearchIterator {
+	it.typed = true
+	return it
+}
+
+// WithConcurrency sets how many pages IteratePages and ParallelPages fetch at once,
+// instead of the page fetch concurrency of the client.
+func (it *IndexSearchIterator) WithConcurrency(pages int) *IndexSearchIterator {
+	it.concurrency = pages
+	return it
+}
+
+// WithUnorderedPages makes IteratePages and ParallelPages deliver pages as soon as they
+// are fetched, rather than in order.
+func (it *IndexSearchIterator) WithUnorderedPages() *IndexSearchIterator {
+	it.unordered = true
+	return it
+}
+
+// IteratePages returns all pages of search results, fetching them in parallel.
+// See ParallelPages.
 func (it *IndexSearchIterator) IteratePages() ([]*model.IndexSearchResponse, error) {
 	if !it.hasMoreResults {
 		return nil, fmt.Errorf("no more results available")
 	}
-	// Perform an initial search to get the approximateCount
-	it.request.Dsl.From = 0
-	it.request.Dsl.Size = it.pageSize
-	response, err := Search(it.request)
+	var responses []*model.IndexSearchResponse
+	err := it.fetchPagesInParallel(it.context(), func(page *model.IndexSearchResponse) error {
+		responses = append(responses, page)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	it.totalResults = response.currentPage.ApproximateCount
-	it.hasMoreResults = it.totalResults > 0
-	if !it.hasMoreResults {
+	if len(responses) == 0 {
 		return nil, fmt.Errorf("no more results available")
 	}
+	return responses, nil
+}
 
-	// If approximateCount is 1, return the response immediately
-	if it.totalResults == 1 {
-		return []*model.IndexSearchResponse{response.currentPage}, nil
-	}
-
-	// Num of pages to fetch
-	numPageGroups := int((it.totalResults + int64(it.pageSize) - 1) / int64(it.pageSize))
-	var wg sync.WaitGroup
-	responses := make([]*model.IndexSearchResponse, numPageGroups)
-	errors := make([]error, numPageGroups)
-
-	// Fetch all pages in parallel
-	for i := 0; i < numPageGroups; i++ {
-		wg.Add(1)
-		go func(i int) {
-			defer wg.Done()
-			it.request.Dsl.From = i * it.pageSize
-			it.request.Dsl.Size = it.pageSize
-			response, err := Search(it.request)
-			if err != nil {
-				errors[i] = err
-				return
+// ParallelPages fetches all pages of search results in parallel, sending them on the returned
+// channel in order, or as soon as they are fetched with WithUnorderedPages. At most the configured
+// concurrency of pages (see WithConcurrency) are fetched ahead of the consumer. Fetching stops
+// at the first error, which is sent on the error channel, or when the iterator's context is cancelled.
+// Pages are fetched by offset, so this is limited to the first 10,000 results by Elasticsearch;
+// use Iter to enumerate larger result sets. The iterator must not be used until the channels are closed.
+func (it *IndexSearchIterator) ParallelPages() (<-chan *model.IndexSearchResponse, <-chan error) {
+	pagesCh := make(chan *model.IndexSearchResponse)
+	errCh := make(chan error, 1) // Buffered to avoid deadlocks
+	ctx := it.context()
+
+	go func() {
+		defer close(pagesCh)
+		defer close(errCh)
+		err := it.fetchPagesInParallel(ctx, func(page *model.IndexSearchResponse) error {
+			select {
+			case pagesCh <- page:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
 			}
-			responses[i] = response.currentPage
-		}(i)
-	}
+		})
+		if err != nil {
+			errCh <- err
+		}
+	}()
 
-	wg.Wait()
+	return pagesCh, errCh
+}
 
-	for _, err := range errors {
-		if err != nil {
-			return nil, err
+// fetchPagesInParallel fetches the first page to know how many there are, then the others in
+// parallel, each with its own copy of the request, passing every page to deliver.
+func (it *IndexSearchIterator) fetchPagesInParallel(ctx context.Context, deliver func(*model.IndexSearchResponse) error) error {
+	if it.pageSize <= 0 {
+		it.pageSize = 300 // Switch to default page size
+	}
+	pageRequest := func(page int) model.IndexSearchRequest {
+		request := it.request
+		request.Dsl.From = page * it.pageSize
+		request.Dsl.Size = it.pageSize
+		request.Dsl.SearchAfter = nil
+		if page > 0 {
+			request.Dsl.Aggregation = nil
 		}
+		return request
 	}
 
-	// Update the iterator state
-	it.hasMoreResults = int64(it.request.Dsl.From+it.pageSize) < it.totalResults
-	it.currentPageNum++
+	client, err := it.atlanClient()
+	if err != nil {
+		return err
+	}
+	first, err := client.search(ctx, pageRequest(0), it.typed)
+	if err != nil {
+		return err
+	}
+	it.totalResults = first.ApproximateCount
+	it.aggregations = first.Aggregations
+	if it.totalResults == 0 {
+		it.hasMoreResults = false
+		return nil
+	}
+	if err := deliver(first); err != nil {
+		return err
+	}
 
-	return responses, nil
+	numPages := int((it.totalResults + int64(it.pageSize) - 1) / int64(it.pageSize))
+	concurrency := it.concurrency
+	if concurrency < 1 {
+		concurrency = client.pageFetchWorkers()
+	}
+	fetch := func(ctx context.Context, i int) (*model.IndexSearchResponse, error) {
+		return client.search(ctx, pageRequest(i+1), it.typed)
+	}
+	if err := fetchInParallel(ctx, numPages-1, concurrency, !it.unordered, fetch, deliver); err != nil {
+		return err
+	}
+
+	// Every page has been fetched
+	it.currentPageNum = numPages
+	it.hasMoreResults = false
+	return nil
 }
 
 // HasMoreResults returns whether there are more results available.
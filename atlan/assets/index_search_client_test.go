@@ -0,0 +1,426 @@
+package assets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagedSearchServer serves index search pages over count tables, honoring the from and size of each request.
+func newPagedSearchServer(t *testing.T, count int, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		var request model.IndexSearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		var entities []string
+		for i := request.Dsl.From; i < count && i < request.Dsl.From+request.Dsl.Size; i++ {
+			entities = append(entities, fmt.Sprintf(
+				`{"typeName": "Table", "guid": "%d", "attributes": {"name": "T%d", "Governance.owner": "team-%d"}}`, i, i, i))
+		}
+		fmt.Fprintf(w, `{"queryType": "INDEX", "searchParameters": {"attributes": ["Governance.owner"]}, "entities": [%s], "approximateCount": %d}`,
+			strings.Join(entities, ","), count)
+	}))
+}
+
+func TestIterStreamsPages(t *testing.T) {
+	var requests int32
+	ts := newPagedSearchServer(t, 5, &requests)
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	request := model.IndexSearchRequest{SearchRequest: model.SearchRequest{Attributes: []string{"Governance.owner"}}}
+	iterator := client.NewIndexSearchIterator(2, request)
+
+	assetsCh, errCh := iterator.Iter()
+	var guids []string
+	for asset := range assetsCh {
+		guids = append(guids, *asset.Guid)
+		assert.Equal(t, "team-"+*asset.Guid, asset.CustomMetadataSets["Governance"]["owner"])
+	}
+	require.NoError(t, <-errCh)
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, guids)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	assert.Equal(t, int64(5), iterator.Count())
+	assert.False(t, iterator.HasMoreResults())
+}
+
+func TestIterEntitiesDecodesByTypeName(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"queryType": "INDEX", "searchParameters": {}, "approximateCount": 3, "entities": [
+			{"typeName": "Table", "guid": "1", "attributes": {"name": "ORDERS", "columnCount": 4, "atlanSchema": {"typeName": "Schema", "guid": "2"}}},
+			{"typeName": "Column", "guid": "3", "attributes": {"name": "ID", "dataType": "NUMBER", "Governance.owner": "team"}},
+			{"typeName": "SnowflakeDynamicTable", "guid": "4", "attributes": {"name": "DT", "definition": "select 1"}}
+		]}`)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	entitiesCh, errCh := client.NewIndexSearchIterator(10, model.IndexSearchRequest{}).IterEntities()
+
+	var decoded []entities.Object
+	for entity := range entitiesCh {
+		decoded = append(decoded, entity)
+	}
+	require.NoError(t, <-errCh)
+	require.Len(t, decoded, 3)
+
+	table, ok := decoded[0].(*entities.Table)
+	require.True(t, ok)
+	assert.Equal(t, int64(4), *table.ColumnCount)
+	assert.Equal(t, "2", table.AtlanSchema.Guid)
+	column, ok := decoded[1].(*entities.Column)
+	require.True(t, ok)
+	assert.Equal(t, "NUMBER", *column.DataType)
+	assert.JSONEq(t, `"team"`, string(column.UnknownAttributes["Governance.owner"]))
+	// Types without a generated type are decoded as an Asset, keeping all their attributes
+	asset, ok := decoded[2].(*entities.Asset)
+	require.True(t, ok)
+	assert.Equal(t, "SnowflakeDynamicTable", *asset.TypeName)
+	assert.Equal(t, "DT", *asset.Name)
+	assert.JSONEq(t, `"select 1"`, string(asset.UnknownAttributes["definition"]))
+}
+
+func TestAssetsAreDecodedIntoTheirTypesWhenAskedFor(t *testing.T) {
+	var requests int32
+	ts := newPagedSearchServer(t, 3, &requests)
+	defer ts.Close()
+	client, _ := Context(ts.URL, "api_key")
+	request := model.IndexSearchRequest{Dsl: model.Dsl{Size: 2}}
+
+	iterator, _ := client.Search(request)
+	page, err := iterator.NextPage()
+	require.NoError(t, err)
+	_, err = page.Entities[0].ToEntity()
+	assert.EqualError(t, err, "asset was not decoded into its type")
+
+	iterator, _ = client.Search(request)
+	page, err = iterator.WithEntities().NextPage()
+	require.NoError(t, err)
+	object, err := page.Entities[0].ToEntity()
+	require.NoError(t, err)
+	assert.Equal(t, "T0", *object.(*entities.Table).Name)
+
+	// Counting doesn't fetch a page, which would not have been decoded into the types
+	iterator, _ = client.Search(request)
+	assert.Equal(t, int64(3), iterator.Count())
+	entitiesCh, errCh := iterator.IterEntities()
+	var names []string
+	for entity := range entitiesCh {
+		names = append(names, *entity.(*entities.Table).Name)
+	}
+	require.NoError(t, <-errCh)
+	assert.Equal(t, []string{"T0", "T1", "T2"}, names)
+}
+
+func TestGetEntityByGuid(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, "/entity/guid/1234"), r.URL.Path)
+		fmt.Fprint(w, `{"referredEntities": {}, "entity": {"typeName": "AtlasGlossaryTerm", "guid": "1234",
+			"attributes": {"name": "Revenue", "qualifiedName": "abc@xyz"},
+			"relationshipAttributes": {"anchor": {"typeName": "AtlasGlossary", "guid": "5678"}}}}`)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	entity, err := client.GetEntityByGuid("1234")
+	require.NoError(t, err)
+	term, ok := entity.(*entities.AtlasGlossaryTerm)
+	require.True(t, ok)
+	assert.Equal(t, "Revenue", *term.Name)
+	assert.Equal(t, "5678", term.Anchor.Guid)
+}
+
+func TestIterContinuesAfterFetchedPage(t *testing.T) {
+	var requests int32
+	ts := newPagedSearchServer(t, 3, &requests)
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	iterator, err := client.Search(model.IndexSearchRequest{Dsl: model.Dsl{Size: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests), "no page is fetched until the iterator is used")
+	page, err := iterator.NextPage()
+	require.NoError(t, err)
+	assert.Len(t, page.Entities, 2)
+
+	assetsCh, errCh := iterator.Iter()
+	var guids []string
+	for asset := range assetsCh {
+		guids = append(guids, *asset.Guid)
+	}
+	require.NoError(t, <-errCh)
+	assert.Equal(t, []string{"0", "1", "2"}, guids)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestIterDeliversEntitiesBeforeThePageIsRead(t *testing.T) {
+	received := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"queryType": "INDEX", "approximateCount": 2, "entities": [{"typeName": "Table", "guid": "1"}`)
+		w.(http.Flusher).Flush()
+		// The rest of the page is only sent once the first entity has been consumed
+		<-received
+		fmt.Fprint(w, `, {"typeName": "Table", "guid": "2"}]}`)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	iterator, err := client.Search(model.IndexSearchRequest{Dsl: model.Dsl{Size: 2}})
+	require.NoError(t, err)
+	assetsCh, errCh := iterator.Iter()
+
+	first := <-assetsCh
+	require.NotNil(t, first)
+	assert.Equal(t, "1", *first.Guid)
+	close(received)
+
+	second := <-assetsCh
+	require.NotNil(t, second)
+	assert.Equal(t, "2", *second.Guid)
+	_, open := <-assetsCh
+	assert.False(t, open)
+	require.NoError(t, <-errCh)
+}
+
+func TestIterReportsMalformedPages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"approximateCount": 2, "entities": [{"typeName": "Table", "guid": "1"}, {"typeName": `)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	assetsCh, errCh := client.NewIndexSearchIterator(2, model.IndexSearchRequest{}).Iter()
+
+	var guids []string
+	for asset := range assetsCh {
+		guids = append(guids, *asset.Guid)
+	}
+	assert.Equal(t, []string{"1"}, guids)
+	assert.Error(t, <-errCh)
+}
+
+func TestNextPageUsesSearchAfter(t *testing.T) {
+	var requests []model.IndexSearchRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request model.IndexSearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request)
+		page := len(requests)
+		fmt.Fprintf(w, `{"searchParameters": {}, "approximateCount": 4, "entities": [
+			{"typeName": "Table", "guid": "g%d", "attributes": {"name": "T%d"}},
+			{"typeName": "Table", "guid": "g%d", "attributes": {"name": "T%d"}}]}`, 2*page-1, 2*page-1, 2*page, 2*page)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	request := client.NewFluentSearch().PageSizes(2).Sort(NAME, atlan.SortOrderAscending).ToRequest()
+	iterator := client.NewIndexSearchIterator(2, *request)
+	for iterator.HasMoreResults() {
+		_, err := iterator.NextPage()
+		require.NoError(t, err)
+	}
+
+	require.Len(t, requests, 2)
+	assert.Nil(t, requests[0].Dsl.SearchAfter)
+	assert.Equal(t, 0, requests[1].Dsl.From)
+	assert.Equal(t, []interface{}{"T2", "g2"}, requests[1].Dsl.SearchAfter)
+}
+
+func TestNextPageFallsBackToOffsets(t *testing.T) {
+	var requests []model.IndexSearchRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request model.IndexSearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request)
+		// The sorted attribute isn't included on results, so no cursor can be computed
+		fmt.Fprint(w, `{"searchParameters": {}, "approximateCount": 4, "entities": [{"typeName": "Table", "guid": "1"}, {"typeName": "Table", "guid": "2"}]}`)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	request := client.NewFluentSearch().Sort("popularityScore", atlan.SortOrderDescending).ToRequest()
+	iterator := client.NewIndexSearchIterator(2, *request)
+	for iterator.HasMoreResults() {
+		_, err := iterator.NextPage()
+		require.NoError(t, err)
+	}
+
+	require.Len(t, requests, 2)
+	assert.Nil(t, requests[1].Dsl.SearchAfter)
+	assert.Equal(t, 2, requests[1].Dsl.From)
+}
+
+func TestTimestampSlicingRequests(t *testing.T) {
+	var requests []model.IndexSearchRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request model.IndexSearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request)
+		if len(requests) == 1 {
+			fmt.Fprint(w, `{"searchParameters": {}, "approximateCount": 3, "entities": [{"typeName": "Table", "guid": "1", "createTime": 100}, {"typeName": "Table", "guid": "2", "createTime": 200}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"searchParameters": {}, "approximateCount": 2, "entities": [{"typeName": "Table", "guid": "2", "createTime": 200}, {"typeName": "Table", "guid": "3", "createTime": 300}]}`)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	iterator := client.NewIndexSearchIterator(2, model.IndexSearchRequest{}).WithTimestampSlicing()
+	var guids []string
+	for i := 0; i < 2; i++ {
+		page, err := iterator.NextPage()
+		require.NoError(t, err)
+		for _, asset := range page.Entities {
+			guids = append(guids, *asset.Guid)
+		}
+	}
+
+	// The second slice starts at the timestamp of the last asset, without repeating it
+	assert.Equal(t, []string{"1", "2", "3"}, guids)
+	assert.Equal(t, int64(3), iterator.Count())
+	require.Len(t, requests, 2)
+	assert.Equal(t, CREATE_TIME_AS_TIMESTAMP, firstKey(requests[1].Dsl.Sort[0]))
+	assert.Equal(t, 0, requests[1].Dsl.From)
+	assert.Equal(t, map[string]interface{}{"range": map[string]interface{}{CREATE_TIME_AS_TIMESTAMP: map[string]interface{}{"gte": float64(200)}}},
+		requests[1].Dsl.Query)
+}
+
+func firstKey(m map[string]interface{}) string {
+	for key := range m {
+		return key
+	}
+	return ""
+}
+
+// newSlowSearchServer serves count tables like newPagedSearchServer, answering later pages faster
+// so that parallel fetches complete out of order. A page starting at failFrom fails, if set.
+func newSlowSearchServer(t *testing.T, count int, failFrom int, requests *sync.Map) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request model.IndexSearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		hits, _ := requests.LoadOrStore(request.Dsl.From, new(int32))
+		atomic.AddInt32(hits.(*int32), 1)
+		if request.Dsl.From == failFrom {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		time.Sleep(time.Duration(count-request.Dsl.From) * time.Millisecond)
+
+		var entities []string
+		for i := request.Dsl.From; i < count && i < request.Dsl.From+request.Dsl.Size; i++ {
+			entities = append(entities, fmt.Sprintf(`{"typeName": "Table", "guid": "%d"}`, i))
+		}
+		fmt.Fprintf(w, `{"searchParameters": {}, "entities": [%s], "approximateCount": %d}`, strings.Join(entities, ","), count)
+	}))
+}
+
+func pageGUIDs(page *model.IndexSearchResponse) []string {
+	var guids []string
+	for _, asset := range page.Entities {
+		guids = append(guids, *asset.Guid)
+	}
+	return guids
+}
+
+func TestParallelPagesInOrder(t *testing.T) {
+	var requests sync.Map
+	ts := newSlowSearchServer(t, 20, -1, &requests)
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	iterator := client.NewIndexSearchIterator(2, model.IndexSearchRequest{}).WithConcurrency(3)
+	pagesCh, errCh := iterator.ParallelPages()
+
+	var guids []string
+	for page := range pagesCh {
+		guids = append(guids, pageGUIDs(page)...)
+	}
+	require.NoError(t, <-errCh)
+	for i, guid := range guids {
+		assert.Equal(t, fmt.Sprint(i), guid)
+	}
+	assert.Len(t, guids, 20)
+	assert.False(t, iterator.HasMoreResults())
+
+	// Every page was fetched exactly once
+	for from := 0; from < 20; from += 2 {
+		hits, ok := requests.Load(from)
+		require.True(t, ok, "page from %d was not fetched", from)
+		assert.Equal(t, int32(1), atomic.LoadInt32(hits.(*int32)))
+	}
+}
+
+func TestParallelPagesUnordered(t *testing.T) {
+	var requests sync.Map
+	ts := newSlowSearchServer(t, 20, -1, &requests)
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	pages, err := client.NewIndexSearchIterator(2, model.IndexSearchRequest{}).
+		WithConcurrency(5).
+		WithUnorderedPages().
+		IteratePages()
+	require.NoError(t, err)
+
+	var guids []string
+	for _, page := range pages {
+		guids = append(guids, pageGUIDs(page)...)
+	}
+	var expected []string
+	for i := 0; i < 20; i++ {
+		expected = append(expected, fmt.Sprint(i))
+	}
+	assert.ElementsMatch(t, expected, guids)
+}
+
+func TestParallelPagesStopOnFirstError(t *testing.T) {
+	var requests sync.Map
+	ts := newSlowSearchServer(t, 20, 6, &requests)
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	client.SetRetryPolicy(NoRetryPolicy())
+	pagesCh, errCh := client.NewIndexSearchIterator(2, model.IndexSearchRequest{}).WithConcurrency(1).ParallelPages()
+
+	var guids []string
+	for page := range pagesCh {
+		guids = append(guids, pageGUIDs(page)...)
+	}
+	assert.ErrorIs(t, <-errCh, ErrServer)
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "5"}, guids)
+	// No page was requested after the failing one
+	_, fetched := requests.Load(8)
+	assert.False(t, fetched)
+}
+
+func TestParallelPagesStopWhenCancelled(t *testing.T) {
+	var requests sync.Map
+	ts := newSlowSearchServer(t, 20, -1, &requests)
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pagesCh, errCh := client.NewIndexSearchIteratorWithContext(ctx, 2, model.IndexSearchRequest{}).ParallelPages()
+
+	first := <-pagesCh
+	require.NotNil(t, first)
+	cancel()
+	for range pagesCh {
+	}
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+}
@@ -0,0 +1,142 @@
+package assets
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+)
+
+// LineageClient fetches the lineage of assets through the lineage list API.
+type LineageClient struct {
+	*AtlanClient
+}
+
+// client returns the AtlanClient backing this LineageClient,
+// falling back to the default client for a zero-value LineageClient.
+func (lc *LineageClient) client() (*AtlanClient, error) {
+	if lc != nil && lc.AtlanClient != nil {
+		return lc.AtlanClient, nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
+// List fetches a single page of lineage for the request.
+func (lc *LineageClient) List(request *model.LineageListRequest) (*model.LineageListResponse, error) {
+	return lc.ListWithContext(context.Background(), request)
+}
+
+// ListWithContext is like List, but bound to the provided context.
+func (lc *LineageClient) ListWithContext(ctx context.Context, request *model.LineageListRequest) (*model.LineageListResponse, error) {
+	client, err := lc.client()
+	if err != nil {
+		return nil, err
+	}
+	rawJSON, err := client.CallAPIWithContext(ctx, &GET_LINEAGE_LIST, nil, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response model.LineageListResponse
+	if err := json.Unmarshal(rawJSON, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Fetch fetches every page of lineage for the request, from its From onwards, as a graph.
+// The inputs and outputs of processes are always included, to link them to the assets in the graph.
+func (lc *LineageClient) Fetch(request *model.LineageListRequest) (*model.LineageGraph, error) {
+	return lc.FetchWithContext(context.Background(), request)
+}
+
+// FetchWithContext is like Fetch, but bound to the provided context.
+func (lc *LineageClient) FetchWithContext(ctx context.Context, request *model.LineageListRequest) (*model.LineageGraph, error) {
+	objects, err := lc.fetchAll(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewLineageGraph(request.Guid, objects), nil
+}
+
+// Upstream returns the assets upstream of the asset with the given GUID, up to depth hops away
+// (as counted by Atlan), leaving out the processes between them.
+func (lc *LineageClient) Upstream(guid string, depth int) ([]entities.Object, error) {
+	return lc.UpstreamWithContext(context.Background(), guid, depth)
+}
+
+// UpstreamWithContext is like Upstream, but bound to the provided context.
+func (lc *LineageClient) UpstreamWithContext(ctx context.Context, guid string, depth int) ([]entities.Object, error) {
+	request := model.NewLineageListRequest(guid, atlan.LineageDirectionUpstream)
+	request.Depth = depth
+	return lc.assets(ctx, request)
+}
+
+// Downstream returns the assets downstream of the asset with the given GUID, up to depth hops
+// away (as counted by Atlan), leaving out the processes between them.
+func (lc *LineageClient) Downstream(guid string, depth int) ([]entities.Object, error) {
+	return lc.DownstreamWithContext(context.Background(), guid, depth)
+}
+
+// DownstreamWithContext is like Downstream, but bound to the provided context.
+func (lc *LineageClient) DownstreamWithContext(ctx context.Context, guid string, depth int) ([]entities.Object, error) {
+	request := model.NewLineageListRequest(guid, atlan.LineageDirectionDownstream)
+	request.Depth = depth
+	return lc.assets(ctx, request)
+}
+
+// ImpactedAssets returns every asset downstream of the asset with the given GUID, which a change
+// to it could impact.
+func (lc *LineageClient) ImpactedAssets(guid string) ([]entities.Object, error) {
+	return lc.ImpactedAssetsWithContext(context.Background(), guid)
+}
+
+// ImpactedAssetsWithContext is like ImpactedAssets, but bound to the provided context.
+func (lc *LineageClient) ImpactedAssetsWithContext(ctx context.Context, guid string) ([]entities.Object, error) {
+	return lc.assets(ctx, model.NewLineageListRequest(guid, atlan.LineageDirectionDownstream))
+}
+
+// assets fetches every page of lineage for the request, keeping only the assets.
+func (lc *LineageClient) assets(ctx context.Context, request *model.LineageListRequest) ([]entities.Object, error) {
+	objects, err := lc.fetchAll(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	assets := objects[:0]
+	for _, object := range objects {
+		if _, _, isProcess := entities.ProcessIO(object); !isProcess {
+			assets = append(assets, object)
+		}
+	}
+	return assets, nil
+}
+
+// fetchAll fetches every page of lineage for a copy of the request, including the inputs and
+// outputs of processes.
+func (lc *LineageClient) fetchAll(ctx context.Context, request *model.LineageListRequest) ([]entities.Object, error) {
+	page := *request
+	page.Attributes = append([]string{}, request.Attributes...)
+	for _, attribute := range []string{"inputs", "outputs"} {
+		if !atlan.Contains(page.Attributes, attribute) {
+			page.Attributes = append(page.Attributes, attribute)
+		}
+	}
+
+	var objects []entities.Object
+	for {
+		response, err := lc.ListWithContext(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, response.Entities...)
+		if !response.HasMore || len(response.Entities) == 0 {
+			return objects, nil
+		}
+		page.From += len(response.Entities)
+	}
+}
@@ -0,0 +1,159 @@
+package assets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/assets"
+	"github.com/atlanhq/atlan-go/atlan/atlantest"
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer starts a fake Atlan tenant, stopped at the end of the test, and returns it with a
+// client for it.
+func newTestServer(t *testing.T) (*atlantest.Server, *assets.AtlanClient) {
+	t.Helper()
+	server := atlantest.NewServer()
+	t.Cleanup(server.Close)
+	client, err := assets.NewClient(server.URL, "api_key")
+	require.NoError(t, err)
+	return server, client
+}
+
+// newLineageServer stores the downstream lineage of table 1: process p1 reads from 1 and writes
+// to 2 and 3, column process p2 reads from 2 and writes to 4, and process p3 (of a type without a
+// generated type) reads from 3 and writes to 5.
+func newLineageServer(t *testing.T) (*atlantest.Server, *assets.AtlanClient) {
+	server, client := newTestServer(t)
+	asset := func(typeName, guid string) map[string]interface{} {
+		return map[string]interface{}{"typeName": typeName, "guid": guid}
+	}
+	for _, entity := range []map[string]interface{}{
+		{"typeName": "Table", "guid": "1", "attributes": map[string]interface{}{"name": "T1"}},
+		{"typeName": "Process", "guid": "p1", "attributes": map[string]interface{}{"name": "load",
+			"inputs": []interface{}{asset("Table", "1")}, "outputs": []interface{}{asset("Table", "2"), asset("View", "3")}}},
+		{"typeName": "Table", "guid": "2", "attributes": map[string]interface{}{"name": "T2"}},
+		{"typeName": "View", "guid": "3", "attributes": map[string]interface{}{"name": "V3"}},
+		{"typeName": "ColumnProcess", "guid": "p2", "attributes": map[string]interface{}{"name": "copy",
+			"inputs": []interface{}{asset("Table", "2")}, "outputs": []interface{}{asset("Table", "4")}}},
+		{"typeName": "Table", "guid": "4", "attributes": map[string]interface{}{"name": "T4"}},
+		{"typeName": "DbtProcess", "guid": "p3", "attributes": map[string]interface{}{"name": "model",
+			"inputs": []interface{}{asset("View", "3")}, "outputs": []interface{}{asset("Table", "5")}}},
+		{"typeName": "Table", "guid": "5", "attributes": map[string]interface{}{"name": "T5"}},
+	} {
+		server.AddEntity(entity)
+	}
+	return server, client
+}
+
+// lineageRequests returns the requests sent to the lineage list API.
+func lineageRequests(t *testing.T, server *atlantest.Server) []model.LineageListRequest {
+	var requests []model.LineageListRequest
+	for _, received := range server.Received("POST /api/meta/lineage/list") {
+		var request model.LineageListRequest
+		require.NoError(t, received.DecodeBody(&request))
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func lineageGuids(objects []entities.Object) []string {
+	guids := make([]string, len(objects))
+	for i, object := range objects {
+		guids[i] = *object.GetEntity().Guid
+	}
+	return guids
+}
+
+func TestLineageFetchPagesIntoAGraph(t *testing.T) {
+	server, client := newLineageServer(t)
+
+	request := model.NewLineageListRequest("1", atlan.LineageDirectionDownstream)
+	request.Size = 3
+	request.Attributes = []string{"name"}
+	graph, err := client.LineageClient.Fetch(request)
+	require.NoError(t, err)
+
+	requests := lineageRequests(t, server)
+	require.Len(t, requests, 3)
+	assert.Equal(t, []int{0, 3, 6}, []int{requests[0].From, requests[1].From, requests[2].From})
+	assert.Equal(t, atlan.LineageDirectionDownstream, requests[0].Direction)
+	assert.Equal(t, []string{"name", "inputs", "outputs"}, requests[0].Attributes)
+	assert.Equal(t, []string{"name"}, request.Attributes, "the request itself is left unchanged")
+
+	assert.Len(t, graph.Assets, 4)
+	assert.Len(t, graph.Processes, 3)
+	assert.IsType(t, &entities.ColumnProcess{}, graph.Processes["p2"])
+	assert.Equal(t, int64(2), *graph.Assets["4"].GetEntity().Depth)
+
+	assert.Equal(t, []string{"2", "3"}, lineageGuids(graph.Downstream("1", 1)))
+	assert.Equal(t, []string{"2", "3", "4", "5"}, lineageGuids(graph.ImpactedAssets("1")))
+	assert.Equal(t, []string{"3"}, lineageGuids(graph.Upstream("5", 1)))
+	assert.Equal(t, []string{"3"}, lineageGuids(graph.Upstream("5", 0)), "the base asset is not in the results")
+	assert.Empty(t, graph.Downstream("4", 0))
+}
+
+func TestLineageImpactedAssetsLeavesOutProcesses(t *testing.T) {
+	server, client := newLineageServer(t)
+
+	impacted, err := client.LineageClient.ImpactedAssets("1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "3", "4", "5"}, lineageGuids(impacted))
+
+	upstream, err := client.LineageClient.Upstream("5", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "1"}, lineageGuids(upstream))
+	requests := lineageRequests(t, server)
+	last := requests[len(requests)-1]
+	assert.Equal(t, atlan.LineageDirectionUpstream, last.Direction)
+	assert.Equal(t, 2, last.Depth)
+	assert.Equal(t, "5", last.Guid)
+}
+
+func TestBatchSavesProcesses(t *testing.T) {
+	server, client := newTestServer(t)
+
+	inputs := []entities.Reference{*entities.RefByGuid("Table", "1")}
+	outputs := []entities.Reference{*entities.RefByGuid("Table", "2")}
+	process := &entities.Process{}
+	require.NoError(t, process.Creator("load", "default/snowflake/123", "", inputs, outputs, nil))
+	columnProcess := &entities.ColumnProcess{}
+	require.NoError(t, columnProcess.Creator("load.id", "default/snowflake/123", "", inputs, outputs,
+		entities.RefByQualifiedName("Process", *process.QualifiedName)))
+
+	batch := assets.NewBatch(client, 2, false, atlan.IGNORE, false)
+	require.NoError(t, batch.Add(process))
+	require.NoError(t, batch.Add(columnProcess))
+
+	saved := server.Received("POST /api/meta/entity/bulk")
+	require.Len(t, saved, 1)
+	assert.JSONEq(t, fmt.Sprintf(`{"entities": [{
+		"typeName": "Process",
+		"attributes": {
+			"name": "load",
+			"qualifiedName": %[1]q,
+			"connectorName": "snowflake",
+			"connectionQualifiedName": "default/snowflake/123",
+			"inputs": [{"typeName": "Table", "guid": "1"}],
+			"outputs": [{"typeName": "Table", "guid": "2"}]
+		}
+	}, {
+		"typeName": "ColumnProcess",
+		"attributes": {
+			"name": "load.id",
+			"qualifiedName": %[2]q,
+			"connectorName": "snowflake",
+			"connectionQualifiedName": "default/snowflake/123",
+			"inputs": [{"typeName": "Table", "guid": "1"}],
+			"outputs": [{"typeName": "Table", "guid": "2"}]
+		},
+		"relationshipAttributes": {
+			"process": {"typeName": "Process", "uniqueAttributes": {"qualifiedName": %[1]q}}
+		}
+	}]}`, *process.QualifiedName, *columnProcess.QualifiedName), string(saved[0].Body))
+	assert.Len(t, batch.Created(), 2)
+}
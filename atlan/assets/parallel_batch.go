@@ -0,0 +1,266 @@
+package assets
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/atlanhq/atlan-go/atlan/model"
+)
+
+// ParallelBatchOptions configure a ParallelBatch.
+type ParallelBatchOptions struct {
+	// Number of assets saved in each request. Defaults to 20.
+	MaxSize int
+	// Number of batches saved at the same time. Defaults to 4.
+	Workers int
+	// Number of full batches that can wait for a worker before Add blocks. Defaults to Workers.
+	QueueSize int
+	// Interval after which the assets waiting are saved, even if there aren't MaxSize of them.
+	// Zero (the default) saves assets only once there are MaxSize of them, or on Close.
+	FlushInterval time.Duration
+	// How Atlan tags and custom metadata are saved.
+	SaveOptions SaveOptions
+	// Whether to record the assets that fail as Failures (splitting batches to isolate them),
+	// rather than returning the first error from Close.
+	CaptureFailures bool
+	// How an asset added with the same type and qualified name as one waiting to be saved is treated.
+	Deduplication Deduplication
+	// Whether to report the archived assets that saving restores as Restored (see Batch.TrackRestores).
+	TrackRestores bool
+}
+
+// ParallelBatch saves assets in batches like a Batch, but it is safe for concurrent use by many
+// goroutines adding assets, and saves full batches in the background with several workers. When
+// the workers fall behind, Add blocks until a worker is free. Close saves the assets that are
+// left and waits for the workers to finish:
+//
+//	batch := assets.NewParallelBatch(client, assets.ParallelBatchOptions{Workers: 8, CaptureFailures: true})
+//	for _, table := range tables {
+//		if err := batch.Add(table); err != nil {
+//			return err
+//		}
+//	}
+//	if err := batch.Close(); err != nil {
+//		return err
+//	}
+//	fmt.Println(len(batch.Created()), "created")
+type ParallelBatch struct {
+	maxSize int
+	queue   chan []AtlanObject
+	stop    chan struct{}
+	workers []*Batch
+	running sync.WaitGroup // workers and the flush timer
+	sending sync.WaitGroup // Adds and flushes sending a batch to the queue
+
+	mutex   sync.Mutex
+	pending *Batch
+	closed  bool
+	err     error
+}
+
+// NewParallelBatch starts the workers of a ParallelBatch that saves assets with the given client.
+func NewParallelBatch(client *AtlanClient, options ParallelBatchOptions) *ParallelBatch {
+	if options.MaxSize <= 0 {
+		options.MaxSize = 20
+	}
+	if options.Workers <= 0 {
+		options.Workers = 4
+	}
+	if options.QueueSize <= 0 {
+		options.QueueSize = options.Workers
+	}
+	pb := &ParallelBatch{
+		maxSize: options.MaxSize,
+		queue:   make(chan []AtlanObject, options.QueueSize),
+		stop:    make(chan struct{}),
+		pending: NewBatchWithOptions(client, options.MaxSize, options.SaveOptions, options.CaptureFailures).Deduplicate(options.Deduplication),
+	}
+	for i := 0; i < options.Workers; i++ {
+		worker := NewBatchWithOptions(client, options.MaxSize, options.SaveOptions, options.CaptureFailures).TrackRestores(options.TrackRestores)
+		pb.workers = append(pb.workers, worker)
+		pb.running.Add(1)
+		go pb.work(worker)
+	}
+	if options.FlushInterval > 0 {
+		pb.running.Add(1)
+		go pb.flushEvery(options.FlushInterval)
+	}
+	return pb
+}
+
+// Add adds an asset to the batch, and queues the batch to be saved once it is full. It blocks
+// while the queue of batches waiting for a worker is full.
+func (pb *ParallelBatch) Add(asset AtlanObject) error {
+	pb.mutex.Lock()
+	if pb.closed {
+		pb.mutex.Unlock()
+		return errors.New("assets can't be added to a closed batch")
+	}
+	if err := pb.pending.add(asset); err != nil {
+		pb.mutex.Unlock()
+		return err
+	}
+	var full []AtlanObject
+	if len(pb.pending.batch) >= pb.maxSize {
+		full = pb.pending.take()
+		pb.sending.Add(1)
+	}
+	pb.mutex.Unlock()
+
+	if full != nil {
+		pb.queue <- full
+		pb.sending.Done()
+	}
+	return nil
+}
+
+// Flush queues the assets waiting in the batch to be saved, even if the batch isn't full.
+func (pb *ParallelBatch) Flush() {
+	pb.mutex.Lock()
+	if pb.closed || len(pb.pending.batch) == 0 {
+		pb.mutex.Unlock()
+		return
+	}
+	assets := pb.pending.take()
+	pb.sending.Add(1)
+	pb.mutex.Unlock()
+
+	pb.queue <- assets
+	pb.sending.Done()
+}
+
+// Close saves the assets waiting in the batch and waits for every batch to be saved. Unless
+// failures are captured, it returns the first error that saving a batch returned.
+func (pb *ParallelBatch) Close() error {
+	pb.mutex.Lock()
+	if pb.closed {
+		pb.mutex.Unlock()
+		pb.running.Wait()
+		return pb.err
+	}
+	pb.closed = true
+	remaining := pb.pending.take()
+	pb.mutex.Unlock()
+
+	close(pb.stop)
+	if len(remaining) > 0 {
+		pb.queue <- remaining
+	}
+	pb.sending.Wait()
+	close(pb.queue)
+	pb.running.Wait()
+	return pb.err
+}
+
+// work saves the batches from the queue until it is closed.
+func (pb *ParallelBatch) work(worker *Batch) {
+	defer pb.running.Done()
+	for assets := range pb.queue {
+		if _, err := worker.save(assets); err != nil {
+			pb.mutex.Lock()
+			if pb.err == nil {
+				pb.err = err
+			}
+			pb.mutex.Unlock()
+		}
+	}
+}
+
+// flushEvery flushes the batch at every interval until the batch is closed.
+func (pb *ParallelBatch) flushEvery(interval time.Duration) {
+	defer pb.running.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			pb.Flush()
+		case <-pb.stop:
+			return
+		}
+	}
+}
+
+// Created returns the assets that were created, once the batch is closed.
+func (pb *ParallelBatch) Created() []*model.MutatedAssets {
+	var created []*model.MutatedAssets
+	pb.eachWorker(func(worker *Batch) { created = append(created, worker.created...) })
+	return created
+}
+
+// Updated returns the assets that were updated, once the batch is closed.
+func (pb *ParallelBatch) Updated() []*model.MutatedAssets {
+	var updated []*model.MutatedAssets
+	pb.eachWorker(func(worker *Batch) { updated = append(updated, worker.updated...) })
+	return updated
+}
+
+// PartiallyUpdated returns the assets that were partially updated, once the batch is closed.
+func (pb *ParallelBatch) PartiallyUpdated() []*model.MutatedAssets {
+	var partialUpdated []*model.MutatedAssets
+	pb.eachWorker(func(worker *Batch) { partialUpdated = append(partialUpdated, worker.partialUpdated...) })
+	return partialUpdated
+}
+
+// Deleted returns the assets that were deleted, once the batch is closed.
+func (pb *ParallelBatch) Deleted() []*model.MutatedAssets {
+	var deleted []*model.MutatedAssets
+	pb.eachWorker(func(worker *Batch) { deleted = append(deleted, worker.deleted...) })
+	return deleted
+}
+
+// Restored returns the assets that were restored, once the batch is closed, when restores are tracked.
+func (pb *ParallelBatch) Restored() []*model.MutatedAssets {
+	var restored []*model.MutatedAssets
+	pb.eachWorker(func(worker *Batch) { restored = append(restored, worker.restored...) })
+	return restored
+}
+
+// Skipped returns the assets that were saved without any change, once the batch is closed.
+func (pb *ParallelBatch) Skipped() []AtlanObject {
+	var skipped []AtlanObject
+	pb.eachWorker(func(worker *Batch) { skipped = append(skipped, worker.skipped...) })
+	return skipped
+}
+
+// Failures returns the assets that failed to be saved, once the batch is closed, when failures
+// are captured.
+func (pb *ParallelBatch) Failures() []FailedBatch {
+	var failures []FailedBatch
+	pb.eachWorker(func(worker *Batch) { failures = append(failures, worker.failures...) })
+	return failures
+}
+
+// ResolvedGuids returns the GUIDs that Atlan assigned to the assets created, keyed by the
+// placeholder (negative) GUIDs the assets were added with, once the batch is closed.
+func (pb *ParallelBatch) ResolvedGuids() map[string]string {
+	resolved := make(map[string]string)
+	pb.eachWorker(func(worker *Batch) {
+		for placeholder, guid := range worker.resolvedGuids {
+			resolved[placeholder] = guid
+		}
+	})
+	return resolved
+}
+
+// Report returns a summary of what the batch changed, once the batch is closed.
+func (pb *ParallelBatch) Report() *BatchReport {
+	var workers []*Batch
+	pb.eachWorker(func(worker *Batch) { workers = append(workers, worker) })
+	return newBatchReport(workers...)
+}
+
+// eachWorker calls fn with the batch of each worker, if the workers have finished.
+func (pb *ParallelBatch) eachWorker(fn func(worker *Batch)) {
+	pb.mutex.Lock()
+	closed := pb.closed
+	pb.mutex.Unlock()
+	if !closed {
+		return
+	}
+	pb.running.Wait()
+	for _, worker := range pb.workers {
+		fn(worker)
+	}
+}
@@ -0,0 +1,112 @@
+package assets_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/atlanhq/atlan-go/atlan/assets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelBatchSavesAssetsAddedConcurrently(t *testing.T) {
+	server, client := newBulkServer(t)
+
+	batch := assets.NewParallelBatch(client, assets.ParallelBatchOptions{MaxSize: 10, Workers: 4, CaptureFailures: true})
+	var producers sync.WaitGroup
+	for p := 0; p < 20; p++ {
+		producers.Add(1)
+		go func(p int) {
+			defer producers.Done()
+			for i := 0; i < 25; i++ {
+				name := fmt.Sprintf("T%d_%d", p, i)
+				if p == 7 && i == 7 {
+					name = "BAD"
+				}
+				assert.NoError(t, batch.Add(newTable(t, name)))
+			}
+		}(p)
+	}
+	producers.Wait()
+	require.NoError(t, batch.Close())
+
+	assert.Len(t, batch.Created(), 499)
+	assert.Len(t, batch.ResolvedGuids(), 499)
+	require.Len(t, batch.Failures(), 1)
+	assert.Len(t, batch.Failures()[0].FailedAssets, 1)
+	assert.Equal(t, map[string]int{"CREATE": 499, "FAILED": 1}, batch.Report().Operations)
+	for _, entities := range bulkEntities(t, server) {
+		assert.LessOrEqual(t, len(entities), 10)
+	}
+	assert.EqualError(t, batch.Add(newTable(t, "LATE")), "assets can't be added to a closed batch")
+}
+
+func TestParallelBatchFlushesOnAnInterval(t *testing.T) {
+	received := make(chan int, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Entities []json.RawMessage `json:"entities"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		received <- len(request.Entities)
+		w.Write([]byte(`{"mutatedEntities": {}}`))
+	}))
+	defer ts.Close()
+	client, _ := assets.Context(ts.URL, "api_key")
+
+	batch := assets.NewParallelBatch(client, assets.ParallelBatchOptions{MaxSize: 100, FlushInterval: 10 * time.Millisecond})
+	for _, name := range []string{"A", "B", "C"} {
+		require.NoError(t, batch.Add(newTable(t, name)))
+	}
+	assert.Nil(t, batch.Created(), "results are only available once the batch is closed")
+	select {
+	case saved := <-received:
+		assert.Equal(t, 3, saved)
+	case <-time.After(time.Second):
+		t.Fatal("the assets should be saved before the batch is full")
+	}
+	require.NoError(t, batch.Close())
+}
+
+func TestParallelBatchBlocksWhenWorkersFallBehind(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"mutatedEntities": {}}`))
+	}))
+	defer ts.Close()
+	client, _ := assets.Context(ts.URL, "api_key")
+
+	// The worker waits on the first asset and the second waits in the queue, so the third blocks
+	batch := assets.NewParallelBatch(client, assets.ParallelBatchOptions{MaxSize: 1, Workers: 1, QueueSize: 1})
+	require.NoError(t, batch.Add(newTable(t, "A")))
+	require.NoError(t, batch.Add(newTable(t, "B")))
+	added := make(chan error)
+	go func() { added <- batch.Add(newTable(t, "C")) }()
+	select {
+	case <-added:
+		t.Fatal("Add should block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-added)
+	require.NoError(t, batch.Close())
+}
+
+func TestParallelBatchReturnsTheFirstErrorFromClose(t *testing.T) {
+	server, client := newTestServer(t)
+	server.FailRequests("POST /api/meta/entity/bulk", http.StatusForbidden)
+
+	batch := assets.NewParallelBatch(client, assets.ParallelBatchOptions{MaxSize: 2})
+	for _, name := range []string{"A", "B", "C"} {
+		require.NoError(t, batch.Add(newTable(t, name)))
+	}
+	assert.ErrorIs(t, batch.Close(), assets.ErrPermission)
+	assert.ErrorIs(t, batch.Close(), assets.ErrPermission, "closing again returns the same error")
+}
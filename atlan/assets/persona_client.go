@@ -211,7 +211,16 @@ func (p *Persona) Updater(qualifiedName, name string, isEnabled bool) error {
 	return nil
 }
 
+// FindPersonasByName searches for an active persona by name.
 func FindPersonasByName(name string) (*model.IndexSearchResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.FindPersonasByName(name)
+}
+
+// FindPersonasByName searches for an active persona by name.
+func (ac *AtlanClient) FindPersonasByName(name string) (*model.IndexSearchResponse, error) {
 	if name == "" {
 		return nil, fmt.Errorf("name cannot be empty")
 	}
@@ -234,14 +243,14 @@ func FindPersonasByName(name string) (*model.IndexSearchResponse, error) {
 		ExcludeAtlanTags: false,
 	}
 
-	iterator := NewIndexSearchIterator(pageSize, request)
+	iterator := ac.NewIndexSearchIterator(pageSize, request)
 
 	for iterator.HasMoreResults() {
 		response, err := iterator.NextPage()
 		if err != nil {
 			return nil, fmt.Errorf("error executing search: %v", err)
 		}
-		fmt.Println("Current Page: ", iterator.CurrentPageNumber())
+		ac.logger.Debugf("Current page: %d", iterator.CurrentPageNumber())
 		for _, entity := range response.Entities {
 			if *entity.TypeName == "Persona" {
 				return response, err
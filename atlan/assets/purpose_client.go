@@ -171,7 +171,16 @@ func (p *Purpose) Updater(qualifiedName, name string, isEnabled bool) error {
 	return nil
 }
 
+// FindPurposesByName searches for an active purpose by name.
 func FindPurposesByName(name string) (*model.IndexSearchResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.FindPurposesByName(name)
+}
+
+// FindPurposesByName searches for an active purpose by name.
+func (ac *AtlanClient) FindPurposesByName(name string) (*model.IndexSearchResponse, error) {
 	if name == "" {
 		return nil, fmt.Errorf("name cannot be empty")
 	}
@@ -198,7 +207,7 @@ func FindPurposesByName(name string) (*model.IndexSearchResponse, error) {
 		ExcludeAtlanTags: false,
 	}
 
-	iterator := NewIndexSearchIterator(pageSize, request)
+	iterator := ac.NewIndexSearchIterator(pageSize, request)
 
 	// Iterate through the pages
 	for iterator.HasMoreResults() {
@@ -206,7 +215,7 @@ func FindPurposesByName(name string) (*model.IndexSearchResponse, error) {
 		if err != nil {
 			return nil, fmt.Errorf("error executing search: %v", err)
 		}
-		fmt.Println("Current Page: ", iterator.CurrentPageNumber())
+		ac.logger.Debugf("Current page: %d", iterator.CurrentPageNumber())
 
 		// Check each entity in the current page
 		for _, entity := range response.Entities {
@@ -0,0 +1,138 @@
+package assets
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// EndpointClass groups APIs that share a rate limit budget.
+type EndpointClass string
+
+const (
+	// EndpointClassDefault covers every API without a more specific class, such as typedefs.
+	EndpointClassDefault EndpointClass = ""
+	// EndpointClassSearch covers the Atlas index search.
+	EndpointClassSearch EndpointClass = "search"
+	// EndpointClassEntity covers entity reads and (bulk) writes.
+	EndpointClassEntity EndpointClass = "entity"
+	// EndpointClassAdmin covers the Heracles user, group, role and API token administration.
+	EndpointClassAdmin EndpointClass = "admin"
+	// EndpointClassWorkflow covers workflows and their runs.
+	EndpointClassWorkflow EndpointClass = "workflow"
+)
+
+// RateLimit is the budget of a token bucket: requests are allowed at
+// RequestsPerSecond on average, with bursts of up to Burst requests.
+// A RequestsPerSecond of 0 or less means unlimited.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimiter throttles the API calls of one or more AtlanClients with a token bucket
+// per EndpointClass. It is safe for concurrent use, and can be shared between clients
+// talking to the same tenant so that they respect a common budget.
+type RateLimiter struct {
+	buckets map[EndpointClass]*tokenBucket
+}
+
+// NewRateLimiter creates a rate limiter with the given budgets per endpoint class.
+// Classes without their own budget use the EndpointClassDefault one, if any,
+// and are not limited otherwise.
+func NewRateLimiter(limits map[EndpointClass]RateLimit) *RateLimiter {
+	buckets := make(map[EndpointClass]*tokenBucket, len(limits))
+	for class, limit := range limits {
+		if bucket := newTokenBucket(limit); bucket != nil {
+			buckets[class] = bucket
+		}
+	}
+	return &RateLimiter{buckets: buckets}
+}
+
+// Wait blocks until a request of the given class is allowed, or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context, class EndpointClass) error {
+	if l == nil {
+		return nil
+	}
+	bucket, ok := l.buckets[class]
+	if !ok {
+		bucket, ok = l.buckets[EndpointClassDefault]
+		if !ok {
+			return nil
+		}
+	}
+	delay := bucket.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleepWithContext(ctx, delay); err != nil {
+		bucket.cancel()
+		return err
+	}
+	return nil
+}
+
+// WithRateLimiter throttles every API call made by the client with the given rate limiter.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(o *clientOptions) error {
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+// SetRateLimiter throttles every API call made by the client with the given rate limiter.
+// Passing nil disables rate limiting.
+func (ac *AtlanClient) SetRateLimiter(limiter *RateLimiter) {
+	ac.rateLimiter = limiter
+}
+
+// RateLimiter returns the rate limiter currently used by the client, if any.
+func (ac *AtlanClient) RateLimiter() *RateLimiter {
+	return ac.rateLimiter
+}
+
+// tokenBucket is a token bucket that hands out reservations: a caller
+// takes a token right away, and waits for as long as the bucket is in debt.
+type tokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: limit.RequestsPerSecond, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a token that was reserved but not used.
+func (b *tokenBucket) cancel() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
@@ -0,0 +1,123 @@
+package assets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterBurstThenThrottles(t *testing.T) {
+	limiter := NewRateLimiter(map[EndpointClass]RateLimit{
+		EndpointClassSearch: {RequestsPerSecond: 20, Burst: 2},
+	})
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, limiter.Wait(context.Background(), EndpointClassSearch))
+	}
+	// The burst of 2 is immediate, the 2 other requests wait for 50ms each
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiterClassesHaveSeparateBudgets(t *testing.T) {
+	limiter := NewRateLimiter(map[EndpointClass]RateLimit{
+		EndpointClassSearch:  {RequestsPerSecond: 0.1, Burst: 1},
+		EndpointClassDefault: {RequestsPerSecond: 0.1, Burst: 1},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, limiter.Wait(ctx, EndpointClassSearch))
+	// Entity calls fall back to the default budget, which is independent of the search one
+	require.NoError(t, limiter.Wait(ctx, EndpointClassEntity))
+	// Admin calls share the default budget, which is now exhausted
+	err := limiter.Wait(ctx, EndpointClassAdmin)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	unlimited := NewRateLimiter(map[EndpointClass]RateLimit{EndpointClassSearch: {RequestsPerSecond: 0.1}})
+	for i := 0; i < 10; i++ {
+		require.NoError(t, unlimited.Wait(ctx, EndpointClassWorkflow))
+	}
+}
+
+func TestRateLimiterSharedAcrossGoroutines(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	limiter := NewRateLimiter(map[EndpointClass]RateLimit{EndpointClassEntity: {RequestsPerSecond: 50, Burst: 1}})
+	client, err := NewClient(ts.URL, "api_key", WithRateLimiter(limiter))
+	require.NoError(t, err)
+	assert.Same(t, limiter, client.RateLimiter())
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.CallAPI(&GET_ENTITY_BY_GUID, nil, nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(6), atomic.LoadInt32(&requests))
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestIteratePagesBoundsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight, requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"queryType": "INDEX", "searchParameters": {}, "approximateCount": 20, "entities": [{"typeName": "Table", "guid": "1"}, {"typeName": "Table", "guid": "2"}]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "api_key", WithPageFetchConcurrency(2))
+	require.NoError(t, err)
+
+	iterator := client.NewIndexSearchIterator(2, model.IndexSearchRequest{})
+	pages, err := iterator.IteratePages()
+	require.NoError(t, err)
+	assert.Len(t, pages, 10)
+	// One request per page, the first one also giving the count
+	assert.Equal(t, int32(10), atomic.LoadInt32(&requests))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+
+	_, err = NewClient(ts.URL, "api_key", WithPageFetchConcurrency(0))
+	assert.Error(t, err)
+}
+
+func TestAPIEndpointClasses(t *testing.T) {
+	assert.Equal(t, EndpointClassSearch, INDEX_SEARCH.Class)
+	assert.Equal(t, EndpointClassEntity, CREATE_ENTITIES.Class)
+	assert.Equal(t, EndpointClassAdmin, GET_USERS.Class)
+	assert.Equal(t, EndpointClassWorkflow, WORKFLOW_RUN.Class)
+	assert.Equal(t, EndpointClassDefault, GET_ALL_TYPE_DEFS.Class)
+
+	api, err := GET_ENTITY_BY_GUID.FormatPathWithParams("guid")
+	require.NoError(t, err)
+	assert.Equal(t, EndpointClassEntity, api.Class)
+}
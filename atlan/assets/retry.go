@@ -0,0 +1,168 @@
+package assets
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy defines how failed API calls are retried by the AtlanClient.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including a delay asked for by the
+	// Retry-After header of a response.
+	MaxBackoff time.Duration
+	// Multiplier is applied to the delay after every attempt.
+	Multiplier float64
+	// Jitter is the fraction (0 to 1) of each delay that is randomized,
+	// to avoid many clients retrying in lockstep.
+	Jitter float64
+	// RetryableStatuses lists the HTTP status codes that trigger a retry.
+	RetryableStatuses []int
+	// RetryNonIdempotent allows retrying POST requests as well.
+	// By default, only idempotent methods (GET, PUT, DELETE) are retried.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by new clients:
+// up to 3 attempts on 429, 502, 503 and 504 responses for idempotent requests.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// NoRetryPolicy returns a retry policy that makes exactly one attempt per call.
+func NoRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 1}
+}
+
+// SetRetryPolicy sets the retry policy used for every API call made by the client.
+// Passing nil disables retries.
+func (ac *AtlanClient) SetRetryPolicy(policy *RetryPolicy) {
+	ac.retryPolicy = policy
+}
+
+// RetryPolicy returns the retry policy currently used by the client.
+func (ac *AtlanClient) RetryPolicy() *RetryPolicy {
+	return ac.retryPolicy
+}
+
+// isIdempotentMethod reports whether the HTTP method can safely be repeated.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry decides whether the attempt that produced the given response
+// or error should be retried, and if so how long to wait before the next one.
+func (p *RetryPolicy) shouldRetry(method string, attempt int, response *http.Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if !p.RetryNonIdempotent && !isIdempotentMethod(method) {
+		return 0, false
+	}
+	if err != nil {
+		// Only network failures (connection reset, timeouts, ...) can succeed on retry, unlike
+		// errors building the request, from middlewares or from replaying a cassette
+		if !isNetworkError(err) {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+	if response == nil || !p.isRetryableStatus(response.StatusCode) {
+		return 0, false
+	}
+	if delay, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+		if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+			delay = p.MaxBackoff
+		}
+		return delay, true
+	}
+	return p.backoff(attempt), true
+}
+
+// isNetworkError reports whether the error comes from the network rather than from the request
+// itself, including a connection closed before the response (io.EOF). The http.Client wraps every
+// error in a *url.Error, which is a net.Error, so the error it wraps is checked instead.
+func isNetworkError(err error) bool {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+func (p *RetryPolicy) isRetryableStatus(status int) bool {
+	for _, s := range p.RetryableStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff computes the exponential delay with jitter after the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay -= delay * jitter * rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+// parseRetryAfter parses a Retry-After header, given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
@@ -0,0 +1,158 @@
+package assets
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTestPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestCallAPIRetriesTransientFailures(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"message": "success"}`))
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	client.SetRetryPolicy(newRetryTestPolicy())
+
+	api := &API{Method: http.MethodGet, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+	response, err := client.CallAPI(api, nil, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"message": "success"}`, string(response))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestCallAPIGivesUpAfterMaxAttempts(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	client.SetRetryPolicy(newRetryTestPolicy())
+
+	api := &API{Method: http.MethodGet, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+	_, err := client.CallAPI(api, nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestCallAPIRetriesOnlyNetworkErrors(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 2 {
+			// Drop the connection without responding
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	client.SetRetryPolicy(newRetryTestPolicy())
+	api := &API{Method: http.MethodGet, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+	_, err := client.CallAPI(api, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "the dropped connection is retried")
+
+	var calls int32
+	client.Use(func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, errors.New("no credentials")
+		}
+	})
+	_, err = client.CallAPI(api, nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls), "errors from middlewares are not retried")
+}
+
+func TestCallAPIDoesNotRetryNonIdempotentByDefault(t *testing.T) {
+	var requests int32
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if atomic.AddInt32(&requests, 1) < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client, _ := Context(ts.URL, "api_key")
+	client.SetRetryPolicy(newRetryTestPolicy())
+
+	api := &API{Method: http.MethodPost, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+	_, err := client.CallAPI(api, nil, map[string]string{"key": "value"})
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Opting in retries the POST, replaying the same body
+	atomic.StoreInt32(&requests, 0)
+	bodies = nil
+	policy := newRetryTestPolicy()
+	policy.RetryNonIdempotent = true
+	client.SetRetryPolicy(policy)
+
+	_, err = client.CallAPI(api, nil, map[string]string{"key": "value"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.Equal(t, []string{`{"key":"value"}`, `{"key":"value"}`}, bodies)
+}
+
+func TestRetryPolicyRespectsRetryAfter(t *testing.T) {
+	policy := newRetryTestPolicy()
+	policy.MaxBackoff = 10 * time.Second
+	response := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	response.Header.Set("Retry-After", "7")
+
+	delay, retry := policy.shouldRetry(http.MethodGet, 1, response, nil)
+	assert.True(t, retry)
+	assert.Equal(t, 7*time.Second, delay)
+
+	response.Header.Set("Retry-After", "3600")
+	delay, _ = policy.shouldRetry(http.MethodGet, 1, response, nil)
+	assert.Equal(t, policy.MaxBackoff, delay, "the delay asked for is capped by MaxBackoff")
+
+	_, retry = policy.shouldRetry(http.MethodGet, policy.MaxAttempts, response, nil)
+	assert.False(t, retry)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(3))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		delay := policy.backoff(2)
+		assert.True(t, delay > 100*time.Millisecond && delay <= 200*time.Millisecond)
+	}
+}
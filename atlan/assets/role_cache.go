@@ -16,55 +16,67 @@ type RoleCache struct {
 	mutex       sync.Mutex
 }
 
-var (
-	roleCaches = make(map[string]*RoleCache)
-	cacheMutex sync.Mutex
-)
-
-// GetCache retrieves the RoleCache for the default Atlan client.
-func GetCache() (*RoleCache, error) {
-	cacheMutex.Lock()
-	defer cacheMutex.Unlock()
-
-	client := DefaultAtlanClient
-	cacheKey := generateCacheKey(client.host, client.ApiKey)
-
-	if roleCaches[cacheKey] == nil {
-		roleCaches[cacheKey] = &RoleCache{
-			roleClient:  client.RoleClient,
+// RoleCache returns the RoleCache owned by this client.
+func (ac *AtlanClient) RoleCache() *RoleCache {
+	ac.caches.mutex.Lock()
+	defer ac.caches.mutex.Unlock()
+
+	if ac.caches.role == nil {
+		ac.caches.role = &RoleCache{
+			roleClient:  NewRoleClient(ac),
 			cacheByID:   make(map[string]structs.AtlanRole),
 			mapIDToName: make(map[string]string),
 			mapNameToID: make(map[string]string),
 		}
 	}
-	return roleCaches[cacheKey], nil
+	return ac.caches.role
 }
 
-// GetRoleIDForRoleName  translates the provided role name to its GUID.
+// GetCache retrieves the RoleCache for the default Atlan client.
+func GetCache() (*RoleCache, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.RoleCache(), nil
+}
+
+// GetRoleIDForRoleName translates the provided role name to its GUID, using the default client.
 func GetRoleIDForRoleName(name string) (string, error) {
-	cache, err := GetCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getIDForName(name), nil
+	return DefaultAtlanClient.GetRoleIDForRoleName(name)
 }
 
-// GetRoleNameForRoleID translates the provided role GUID to its human-readable name.
+// GetRoleIDForRoleName translates the provided role name to its GUID.
+func (ac *AtlanClient) GetRoleIDForRoleName(name string) (string, error) {
+	return ac.RoleCache().getIDForName(name), nil
+}
+
+// GetRoleNameForRoleID translates the provided role GUID to its human-readable name, using the default client.
 func GetRoleNameForRoleID(id string) (string, error) {
-	cache, err := GetCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getNameForID(id), nil
+	return DefaultAtlanClient.GetRoleNameForRoleID(id)
 }
 
-// ValidateIDStrings validates that the given role GUIDs are valid.
+// GetRoleNameForRoleID translates the provided role GUID to its human-readable name.
+func (ac *AtlanClient) GetRoleNameForRoleID(id string) (string, error) {
+	return ac.RoleCache().getNameForID(id), nil
+}
+
+// ValidateIDStrings validates that the given role GUIDs are valid, using the default client.
 func ValidateIDStrings(ids []string) error {
-	cache, err := GetCache()
-	if err != nil {
-		return err
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
 	}
-	return cache.validateIDStrings(ids)
+	return DefaultAtlanClient.ValidateIDStrings(ids)
+}
+
+// ValidateIDStrings validates that the given role GUIDs are valid.
+func (ac *AtlanClient) ValidateIDStrings(ids []string) error {
+	return ac.RoleCache().validateIDStrings(ids)
 }
 
 func (rc *RoleCache) refreshCache() error {
@@ -18,8 +18,24 @@ func NewRoleClient(caller *AtlanClient) *RoleClient {
 	return &RoleClient{roleClient: caller}
 }
 
+// client returns the AtlanClient backing this RoleClient,
+// falling back to the default client when none was provided.
+func (r *RoleClient) client() (*AtlanClient, error) {
+	if r != nil && r.roleClient != nil {
+		return r.roleClient, nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
 // Get retrieves a RoleResponse containing a list of roles defined in Atlan.
 func (r *RoleClient) Get(limit int, postFilter, sort string, count bool, offset int) (*structs.RoleResponse, error) {
+	client, err := r.client()
+	if err != nil {
+		return nil, err
+	}
 	queryParams := map[string]string{
 		"count":  strconv.FormatBool(count),
 		"offset": strconv.Itoa(offset),
@@ -32,7 +48,7 @@ func (r *RoleClient) Get(limit int, postFilter, sort string, count bool, offset
 		queryParams["sort"] = sort
 	}
 
-	resp, err := DefaultAtlanClient.CallAPI(&GET_ROLES, queryParams, nil)
+	resp, err := client.CallAPI(&GET_ROLES, queryParams, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch roles: %w", err)
 	}
@@ -46,7 +62,11 @@ func (r *RoleClient) Get(limit int, postFilter, sort string, count bool, offset
 
 // GetAll retrieves all roles defined in Atlan.
 func (r *RoleClient) GetAll() (*structs.RoleResponse, error) {
-	resp, err := DefaultAtlanClient.CallAPI(&GET_ROLES, nil, nil)
+	client, err := r.client()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.CallAPI(&GET_ROLES, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch all roles: %w", err)
 	}
@@ -0,0 +1,248 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the SDK as the source of the spans and metrics it emits.
+const instrumentationName = "github.com/atlanhq/atlan-go/atlan/assets"
+
+// Attribute keys recorded on the spans and metrics of every API call.
+const (
+	AttributeAPIName      = attribute.Key("atlan.api.name")
+	AttributeAPIPath      = attribute.Key("atlan.api.path")
+	AttributeRetryCount   = attribute.Key("atlan.retry.count")
+	AttributeRequestSize  = attribute.Key("atlan.request.size")
+	AttributeResponseSize = attribute.Key("atlan.response.size")
+	AttributeLatency      = attribute.Key("atlan.latency_ms")
+	AttributeError        = attribute.Key("atlan.error")
+)
+
+// Names of the metrics recorded for every API call.
+const (
+	MetricAPICalls        = "atlan.sdk.api.calls"
+	MetricAPIErrors       = "atlan.sdk.api.errors"
+	MetricAPIRetries      = "atlan.sdk.api.retries"
+	MetricAPIDuration     = "atlan.sdk.api.duration"
+	MetricAPIRequestSize  = "atlan.sdk.api.request.size"
+	MetricAPIResponseSize = "atlan.sdk.api.response.size"
+)
+
+// telemetry holds the OpenTelemetry instruments of an AtlanClient.
+// A nil telemetry disables instrumentation altogether.
+type telemetry struct {
+	tracer       trace.Tracer
+	calls        metric.Int64Counter
+	errors       metric.Int64Counter
+	retries      metric.Int64Counter
+	duration     metric.Float64Histogram
+	requestSize  metric.Int64Histogram
+	responseSize metric.Int64Histogram
+}
+
+// WithTracerProvider emits a span for every API call made by the client, using the given provider.
+func WithTracerProvider(provider trace.TracerProvider) ClientOption {
+	return func(o *clientOptions) error {
+		if provider == nil {
+			return fmt.Errorf("tracer provider cannot be nil")
+		}
+		o.tracerProvider = provider
+		return nil
+	}
+}
+
+// WithMeterProvider records call counts, latencies and payload sizes per API, using the given provider.
+func WithMeterProvider(provider metric.MeterProvider) ClientOption {
+	return func(o *clientOptions) error {
+		if provider == nil {
+			return fmt.Errorf("meter provider cannot be nil")
+		}
+		o.meterProvider = provider
+		return nil
+	}
+}
+
+// SetTelemetry enables OpenTelemetry tracing and metrics for every API call made by the client.
+// Either provider may be nil to only enable the other one; passing both as nil disables telemetry.
+func (ac *AtlanClient) SetTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) error {
+	t, err := newTelemetry(tracerProvider, meterProvider)
+	if err != nil {
+		return err
+	}
+	ac.telemetry = t
+	return nil
+}
+
+// newTelemetry creates the instruments for the given providers.
+func newTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) (*telemetry, error) {
+	if tracerProvider == nil && meterProvider == nil {
+		return nil, nil
+	}
+	t := &telemetry{}
+	if tracerProvider != nil {
+		t.tracer = tracerProvider.Tracer(instrumentationName)
+	}
+	if meterProvider == nil {
+		return t, nil
+	}
+
+	var err error
+	meter := meterProvider.Meter(instrumentationName)
+	if t.calls, err = meter.Int64Counter(MetricAPICalls,
+		metric.WithDescription("Number of API calls made to Atlan."),
+		metric.WithUnit("{call}")); err != nil {
+		return nil, err
+	}
+	if t.errors, err = meter.Int64Counter(MetricAPIErrors,
+		metric.WithDescription("Number of API calls to Atlan that failed."),
+		metric.WithUnit("{call}")); err != nil {
+		return nil, err
+	}
+	if t.retries, err = meter.Int64Counter(MetricAPIRetries,
+		metric.WithDescription("Number of retried attempts of API calls to Atlan."),
+		metric.WithUnit("{attempt}")); err != nil {
+		return nil, err
+	}
+	if t.duration, err = meter.Float64Histogram(MetricAPIDuration,
+		metric.WithDescription("Duration of API calls to Atlan, including retries."),
+		metric.WithUnit("ms")); err != nil {
+		return nil, err
+	}
+	if t.requestSize, err = meter.Int64Histogram(MetricAPIRequestSize,
+		metric.WithDescription("Size of the JSON payloads sent to Atlan."),
+		metric.WithUnit("By")); err != nil {
+		return nil, err
+	}
+	if t.responseSize, err = meter.Int64Histogram(MetricAPIResponseSize,
+		metric.WithDescription("Size of the payloads received from Atlan."),
+		metric.WithUnit("By")); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// apiCall tracks a single CallAPI invocation, across all of its attempts.
+// All of its methods are safe to call on a nil apiCall, when telemetry is disabled.
+type apiCall struct {
+	telemetry    *telemetry
+	ctx          context.Context
+	span         trace.Span
+	api          *API
+	start        time.Time
+	attempts     int
+	statusCode   int
+	requestSize  int
+	responseSize int
+}
+
+// startAPICall starts tracking the given API call, returning the context
+// that carries its span.
+func (t *telemetry) startAPICall(ctx context.Context, api *API) (context.Context, *apiCall) {
+	if t == nil {
+		return ctx, nil
+	}
+	call := &apiCall{telemetry: t, api: api, start: time.Now()}
+	if t.tracer != nil {
+		ctx, call.span = t.tracer.Start(ctx, apiSpanName(api),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				AttributeAPIName.String(api.Name),
+				AttributeAPIPath.String(api.Endpoint.Atlas+api.Path),
+				semconv.HTTPMethod(api.Method),
+			))
+	}
+	call.ctx = ctx
+	return ctx, call
+}
+
+// apiSpanName names the span after the API definition, falling back to
+// the method and path for APIs defined outside of the SDK.
+func apiSpanName(api *API) string {
+	if api.Name != "" {
+		return api.Name
+	}
+	return api.Method + " " + api.Endpoint.Atlas + api.Path
+}
+
+func (c *apiCall) recordRequest(size int) {
+	if c != nil {
+		c.requestSize = size
+	}
+}
+
+func (c *apiCall) recordAttempt(response *http.Response) {
+	if c == nil {
+		return
+	}
+	c.attempts++
+	if response != nil {
+		c.statusCode = response.StatusCode
+	}
+}
+
+func (c *apiCall) recordResponse(size int) {
+	if c != nil {
+		c.responseSize = size
+	}
+}
+
+// end completes the span and records the metrics of the call.
+func (c *apiCall) end(err error) {
+	if c == nil {
+		return
+	}
+	latency := float64(time.Since(c.start)) / float64(time.Millisecond)
+	retries := 0
+	if c.attempts > 1 {
+		retries = c.attempts - 1
+	}
+
+	if c.span != nil {
+		c.span.SetAttributes(
+			AttributeRetryCount.Int(retries),
+			AttributeRequestSize.Int(c.requestSize),
+			AttributeResponseSize.Int(c.responseSize),
+			AttributeLatency.Float64(latency),
+		)
+		if c.statusCode != 0 {
+			c.span.SetAttributes(semconv.HTTPStatusCode(c.statusCode))
+		}
+		if err != nil {
+			c.span.RecordError(err)
+			c.span.SetStatus(codes.Error, err.Error())
+		}
+		c.span.End()
+	}
+
+	t := c.telemetry
+	if t.calls == nil {
+		return
+	}
+	attrs := metric.WithAttributes(
+		AttributeAPIName.String(c.api.Name),
+		semconv.HTTPMethod(c.api.Method),
+		semconv.HTTPStatusCode(c.statusCode),
+		AttributeError.Bool(err != nil),
+	)
+	t.calls.Add(c.ctx, 1, attrs)
+	if err != nil {
+		t.errors.Add(c.ctx, 1, attrs)
+	}
+	if retries > 0 {
+		t.retries.Add(c.ctx, int64(retries), attrs)
+	}
+	t.duration.Record(c.ctx, latency, attrs)
+	if c.requestSize > 0 {
+		t.requestSize.Record(c.ctx, int64(c.requestSize), attrs)
+	}
+	t.responseSize.Record(c.ctx, int64(c.responseSize), attrs)
+}
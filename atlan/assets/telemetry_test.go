@@ -0,0 +1,156 @@
+package assets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func findMetric(rm metricdata.ResourceMetrics, name string) (metricdata.Metrics, bool) {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m, true
+			}
+		}
+	}
+	return metricdata.Metrics{}, false
+}
+
+func TestCallAPIRecordsSpan(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"entities": []}`))
+	}))
+	defer ts.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client, err := NewClient(ts.URL, "api_key", WithTracerProvider(provider), WithRetryPolicy(newRetryTestPolicy()))
+	require.NoError(t, err)
+
+	api := &API{Name: "TEST_SEARCH", Method: http.MethodPut, Endpoint: Endpoint{Atlas: "/test"}, Path: "/search", Status: http.StatusOK}
+	_, err = client.CallAPI(api, nil, map[string]string{"query": "value"})
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "TEST_SEARCH", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	attrs := spanAttributes(spans[0])
+	assert.Equal(t, "TEST_SEARCH", attrs[AttributeAPIName].AsString())
+	assert.Equal(t, "/test/search", attrs[AttributeAPIPath].AsString())
+	assert.Equal(t, http.MethodPut, attrs["http.method"].AsString())
+	assert.Equal(t, int64(http.StatusOK), attrs["http.status_code"].AsInt64())
+	assert.Equal(t, int64(1), attrs[AttributeRetryCount].AsInt64())
+	assert.Equal(t, int64(len(`{"query":"value"}`)), attrs[AttributeRequestSize].AsInt64())
+	assert.Equal(t, int64(len(`{"entities": []}`)), attrs[AttributeResponseSize].AsInt64())
+	assert.Contains(t, attrs, AttributeLatency)
+}
+
+func TestCallAPIRecordsFailedSpan(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errorCode": "ATLAS-404-00-005", "errorMessage": "not found"}`))
+	}))
+	defer ts.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client, err := NewClient(ts.URL, "api_key")
+	require.NoError(t, err)
+	require.NoError(t, client.SetTelemetry(provider, nil))
+
+	_, err = client.CallAPI(&GET_ENTITY_BY_GUID, nil, nil)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET_ENTITY_BY_GUID", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, int64(http.StatusNotFound), spanAttributes(spans[0])["http.status_code"].AsInt64())
+}
+
+func TestCallAPIRecordsMetrics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/test/missing" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	client, err := NewClient(ts.URL, "api_key", WithMeterProvider(provider))
+	require.NoError(t, err)
+
+	api := &API{Name: "TEST_ENDPOINT", Method: http.MethodGet, Endpoint: Endpoint{Atlas: "/test"}, Path: "/endpoint", Status: http.StatusOK}
+	for i := 0; i < 2; i++ {
+		_, err = client.CallAPI(api, nil, nil)
+		require.NoError(t, err)
+	}
+	missing := &API{Name: "TEST_MISSING", Method: http.MethodGet, Endpoint: Endpoint{Atlas: "/test"}, Path: "/missing", Status: http.StatusOK}
+	_, err = client.CallAPI(missing, nil, nil)
+	require.Error(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	calls, ok := findMetric(rm, MetricAPICalls)
+	require.True(t, ok)
+	counts := make(map[string]int64)
+	for _, dp := range calls.Data.(metricdata.Sum[int64]).DataPoints {
+		name, _ := dp.Attributes.Value(AttributeAPIName)
+		counts[name.AsString()] += dp.Value
+	}
+	assert.Equal(t, map[string]int64{"TEST_ENDPOINT": 2, "TEST_MISSING": 1}, counts)
+
+	errors, ok := findMetric(rm, MetricAPIErrors)
+	require.True(t, ok)
+	errorPoints := errors.Data.(metricdata.Sum[int64]).DataPoints
+	require.Len(t, errorPoints, 1)
+	name, _ := errorPoints[0].Attributes.Value(AttributeAPIName)
+	assert.Equal(t, "TEST_MISSING", name.AsString())
+
+	duration, ok := findMetric(rm, MetricAPIDuration)
+	require.True(t, ok)
+	assert.Len(t, duration.Data.(metricdata.Histogram[float64]).DataPoints, 2)
+}
+
+func TestTelemetryDisabledByDefault(t *testing.T) {
+	client, err := NewClient("tenant.atlan.com", "api_key")
+	require.NoError(t, err)
+	assert.Nil(t, client.telemetry)
+
+	ctx, call := client.telemetry.startAPICall(context.Background(), testEndpointAPI)
+	assert.Nil(t, call)
+	assert.Equal(t, context.Background(), ctx)
+	call.recordAttempt(nil)
+	call.end(nil)
+}
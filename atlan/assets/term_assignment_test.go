@@ -0,0 +1,93 @@
+package assets_test
+
+import (
+	"testing"
+
+	"github.com/atlanhq/atlan-go/atlan/assets"
+	"github.com/atlanhq/atlan-go/atlan/atlantest"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// savedBodies returns the bodies of the requests sent to the bulk entity API.
+func savedBodies(server *atlantest.Server) []string {
+	var bodies []string
+	for _, request := range server.Received("POST /api/meta/entity/bulk") {
+		bodies = append(bodies, string(request.Body))
+	}
+	return bodies
+}
+
+// meaningGuids returns the GUIDs of the terms assigned to the stored entity.
+func meaningGuids(server *atlantest.Server, guid string) []string {
+	guids := []string{}
+	meanings, _ := server.Entity(guid)["relationshipAttributes"].(map[string]interface{})["meanings"].([]interface{})
+	for _, meaning := range meanings {
+		guids = append(guids, meaning.(map[string]interface{})["guid"].(string))
+	}
+	return guids
+}
+
+func TestAppendReplaceAndRemoveTerms(t *testing.T) {
+	server, client := newTestServer(t)
+	qualifiedName := "default/snowflake/123/DB/SCHEMA/T"
+	server.AddEntity(map[string]interface{}{"typeName": "Table", "guid": "1",
+		"attributes": map[string]interface{}{"name": "T", "qualifiedName": qualifiedName}})
+	term := entities.RefByGuid("AtlasGlossaryTerm", "t1")
+
+	response, err := client.GlossaryClient.AppendTerms("Table", qualifiedName, term)
+	require.NoError(t, err)
+	assert.Len(t, response.MutatedEntities.UPDATE, 1)
+	assert.Equal(t, []string{"t1"}, meaningGuids(server, "1"))
+	_, err = client.GlossaryClient.AppendTerms("Table", qualifiedName, term, entities.RefByGuid("AtlasGlossaryTerm", "t2"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2"}, meaningGuids(server, "1"))
+	_, err = client.GlossaryClient.RemoveTerms("Table", qualifiedName, term)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t2"}, meaningGuids(server, "1"))
+	_, err = client.GlossaryClient.ReplaceTerms("Table", qualifiedName)
+	require.NoError(t, err)
+	assert.Empty(t, meaningGuids(server, "1"))
+
+	saved := savedBodies(server)
+	require.Len(t, saved, 4)
+	assert.JSONEq(t, `{"entities": [{"typeName": "Table", "attributes": {"name": "T", "qualifiedName": "default/snowflake/123/DB/SCHEMA/T"},
+		"appendRelationshipAttributes": {"meanings": [{"typeName": "AtlasGlossaryTerm", "guid": "t1"}]}}]}`, saved[0])
+	assert.JSONEq(t, `{"entities": [{"typeName": "Table", "attributes": {"name": "T", "qualifiedName": "default/snowflake/123/DB/SCHEMA/T"},
+		"removeRelationshipAttributes": {"meanings": [{"typeName": "AtlasGlossaryTerm", "guid": "t1"}]}}]}`, saved[2])
+	assert.JSONEq(t, `{"entities": [{"typeName": "Table", "attributes": {"name": "T", "qualifiedName": "default/snowflake/123/DB/SCHEMA/T"},
+		"relationshipAttributes": {"meanings": []}}]}`, saved[3])
+
+	_, err = client.GlossaryClient.AppendTerms("Table", qualifiedName)
+	assert.EqualError(t, err, "at least one term is required")
+}
+
+func TestLinkTerms(t *testing.T) {
+	server, client := newTestServer(t)
+	server.AddEntity(map[string]interface{}{"typeName": "AtlasGlossaryTerm", "guid": "t1",
+		"attributes":             map[string]interface{}{"name": "Revenue", "qualifiedName": "t1@g1"},
+		"relationshipAttributes": map[string]interface{}{"seeAlso": []interface{}{map[string]interface{}{"typeName": "AtlasGlossaryTerm", "guid": "t3"}}}})
+	term := &entities.AtlasGlossaryTerm{}
+	require.NoError(t, term.Updater("Revenue", "t1@g1", "g1"))
+
+	_, err := client.GlossaryClient.LinkTerms(term, assets.TermSynonyms, entities.RefByGuid("AtlasGlossaryTerm", "t2"))
+	require.NoError(t, err)
+	_, err = client.GlossaryClient.UnlinkTerms(term, assets.TermSeeAlso, entities.RefByGuid("AtlasGlossaryTerm", "t3"))
+	require.NoError(t, err)
+
+	saved := savedBodies(server)
+	require.Len(t, saved, 2)
+	assert.JSONEq(t, `{"entities": [{"typeName": "AtlasGlossaryTerm", "attributes": {"name": "Revenue", "qualifiedName": "t1@g1"},
+		"relationshipAttributes": {"anchor": {"typeName": "AtlasGlossary", "guid": "g1"}},
+		"appendRelationshipAttributes": {"synonyms": [{"typeName": "AtlasGlossaryTerm", "guid": "t2"}]}}]}`, saved[0])
+	assert.JSONEq(t, `{"entities": [{"typeName": "AtlasGlossaryTerm", "attributes": {"name": "Revenue", "qualifiedName": "t1@g1"},
+		"relationshipAttributes": {"anchor": {"typeName": "AtlasGlossary", "guid": "g1"}},
+		"removeRelationshipAttributes": {"seeAlso": [{"typeName": "AtlasGlossaryTerm", "guid": "t3"}]}}]}`, saved[1])
+	relationships := server.Entity("t1")["relationshipAttributes"].(map[string]interface{})
+	assert.Len(t, relationships["synonyms"], 1)
+	assert.Empty(t, relationships["seeAlso"])
+
+	_, err = client.GlossaryClient.LinkTerms(&entities.AtlasGlossaryTerm{}, assets.TermSynonyms, entities.RefByGuid("AtlasGlossaryTerm", "t2"))
+	assert.EqualError(t, err, "the name, qualifiedName and anchor of the term are required")
+}
@@ -1,6 +1,7 @@
 package assets
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -9,8 +10,29 @@ import (
 
 type TokenClient AtlanClient
 
+// client returns the AtlanClient backing this TokenClient,
+// falling back to the default client for a zero-value TokenClient.
+func (tc *TokenClient) client() (*AtlanClient, error) {
+	if tc != nil && tc.Session != nil {
+		return (*AtlanClient)(tc), nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
 // Get retrieves an ApiTokenResponse with a list of API tokens based on the provided parameters.
 func (tc *TokenClient) Get(limit *int, postFilter, sort *string, count bool, offset int) (*ApiTokenResponse, error) {
+	return tc.GetWithContext(context.Background(), limit, postFilter, sort, count, offset)
+}
+
+// GetWithContext is like Get, but bound to the provided context.
+func (tc *TokenClient) GetWithContext(ctx context.Context, limit *int, postFilter, sort *string, count bool, offset int) (*ApiTokenResponse, error) {
+	client, err := tc.client()
+	if err != nil {
+		return nil, err
+	}
 	queryParams := map[string]string{
 		"count":  fmt.Sprintf("%v", count),
 		"offset": fmt.Sprintf("%d", offset),
@@ -25,7 +47,7 @@ func (tc *TokenClient) Get(limit *int, postFilter, sort *string, count bool, off
 		queryParams["sort"] = *sort
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&GET_API_TOKENS, queryParams, nil)
+	rawJSON, err := client.CallAPIWithContext(ctx, &GET_API_TOKENS, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +63,13 @@ func (tc *TokenClient) Get(limit *int, postFilter, sort *string, count bool, off
 // GetByName retrieves the API token with a display name.
 // returns the API token with the provided display name as structs.ApiToken.
 func (tc *TokenClient) GetByName(displayName string) (*structs.ApiToken, error) {
+	return tc.GetByNameWithContext(context.Background(), displayName)
+}
+
+// GetByNameWithContext is like GetByName, but bound to the provided context.
+func (tc *TokenClient) GetByNameWithContext(ctx context.Context, displayName string) (*structs.ApiToken, error) {
 	filter := fmt.Sprintf(`{"displayName":"%s"}`, displayName)
-	response, err := tc.Get(nil, &filter, nil, true, 0)
+	response, err := tc.GetWithContext(ctx, nil, &filter, nil, true, 0)
 	if err != nil || response == nil || *response.TotalRecord == 0 {
 		return nil, err
 	}
@@ -52,11 +79,16 @@ func (tc *TokenClient) GetByName(displayName string) (*structs.ApiToken, error)
 // GetByID retrieves the API token with a client ID.
 // returns the API token with the provided client ID as structs.ApiToken.
 func (tc *TokenClient) GetByID(clientID string) (*structs.ApiToken, error) {
+	return tc.GetByIDWithContext(context.Background(), clientID)
+}
+
+// GetByIDWithContext is like GetByID, but bound to the provided context.
+func (tc *TokenClient) GetByIDWithContext(ctx context.Context, clientID string) (*structs.ApiToken, error) {
 	if len(clientID) > len(structs.ServiceAccount) && clientID[:len(structs.ServiceAccount)] == structs.ServiceAccount {
 		clientID = clientID[len(structs.ServiceAccount):]
 	}
 	filter := fmt.Sprintf(`{"clientId":"%s"}`, clientID)
-	response, err := tc.Get(nil, &filter, nil, true, 0)
+	response, err := tc.GetWithContext(ctx, nil, &filter, nil, true, 0)
 	if err != nil || response == nil || len(response.Records) == 0 {
 		return nil, err
 	}
@@ -66,9 +98,14 @@ func (tc *TokenClient) GetByID(clientID string) (*structs.ApiToken, error) {
 // GetByGUID retrieves the API token with a GUID.
 // returns the API token with the provided GUID as structs.ApiToken.
 func (tc *TokenClient) GetByGUID(guid string) (*structs.ApiToken, error) {
+	return tc.GetByGUIDWithContext(context.Background(), guid)
+}
+
+// GetByGUIDWithContext is like GetByGUID, but bound to the provided context.
+func (tc *TokenClient) GetByGUIDWithContext(ctx context.Context, guid string) (*structs.ApiToken, error) {
 	filter := fmt.Sprintf(`{"id":"%s"}`, guid)
 	sort := "createdAt"
-	response, err := tc.Get(nil, &filter, &sort, true, 0)
+	response, err := tc.GetWithContext(ctx, nil, &filter, &sort, true, 0)
 	if err != nil || response == nil || len(response.Records) == 0 {
 		return nil, err
 	}
@@ -82,6 +119,15 @@ func (tc *TokenClient) GetByGUID(guid string) (*structs.ApiToken, error) {
 // validitySeconds: Validity of the token in seconds
 // returns the created API token as structs.ApiToken.
 func (tc *TokenClient) Create(displayName, description *string, personas []string, validitySeconds *int) (*structs.ApiToken, error) {
+	return tc.CreateWithContext(context.Background(), displayName, description, personas, validitySeconds)
+}
+
+// CreateWithContext is like Create, but bound to the provided context.
+func (tc *TokenClient) CreateWithContext(ctx context.Context, displayName, description *string, personas []string, validitySeconds *int) (*structs.ApiToken, error) {
+	client, err := tc.client()
+	if err != nil {
+		return nil, err
+	}
 	request := structs.ApiTokenRequest{
 		DisplayName:           displayName,
 		Description:           " ",
@@ -98,7 +144,7 @@ func (tc *TokenClient) Create(displayName, description *string, personas []strin
 		request.ValiditySeconds = validitySeconds
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&UPSERT_API_TOKEN, nil, request)
+	rawJSON, err := client.CallAPIWithContext(ctx, &UPSERT_API_TOKEN, nil, request)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +164,15 @@ func (tc *TokenClient) Create(displayName, description *string, personas []strin
 // personas: Updated List of persona qualified names.
 // returns the updated API token as structs.ApiToken.
 func (tc *TokenClient) Update(guid, displayName, description *string, personas []string) (*structs.ApiToken, error) {
+	return tc.UpdateWithContext(context.Background(), guid, displayName, description, personas)
+}
+
+// UpdateWithContext is like Update, but bound to the provided context.
+func (tc *TokenClient) UpdateWithContext(ctx context.Context, guid, displayName, description *string, personas []string) (*structs.ApiToken, error) {
+	client, err := tc.client()
+	if err != nil {
+		return nil, err
+	}
 	request := structs.ApiTokenRequest{
 		DisplayName: displayName,
 	}
@@ -132,7 +187,7 @@ func (tc *TokenClient) Update(guid, displayName, description *string, personas [
 
 	api := &UPSERT_API_TOKEN
 	api.Path = fmt.Sprintf("apikeys/%s", *guid)
-	rawJSON, err := DefaultAtlanClient.CallAPI(api, nil, request)
+	rawJSON, err := client.CallAPIWithContext(ctx, api, nil, request)
 	if err != nil {
 		return nil, err
 	}
@@ -148,9 +203,18 @@ func (tc *TokenClient) Update(guid, displayName, description *string, personas [
 // Purge deletes the API token with the provided GUID.
 // returns error if the API token could not be deleted.
 func (tc *TokenClient) Purge(guid string) error {
+	return tc.PurgeWithContext(context.Background(), guid)
+}
+
+// PurgeWithContext is like Purge, but bound to the provided context.
+func (tc *TokenClient) PurgeWithContext(ctx context.Context, guid string) error {
+	client, err := tc.client()
+	if err != nil {
+		return err
+	}
 	api := &DELETE_API_TOKEN
 	api.Path = fmt.Sprintf("apikeys/%s", guid)
-	_, err := DefaultAtlanClient.CallAPI(api, nil, nil)
+	_, err = client.CallAPIWithContext(ctx, api, nil, nil)
 	return err
 }
 
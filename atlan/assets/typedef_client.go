@@ -63,12 +63,21 @@ func NewTypeDefResponse(rawJSON []byte) (*model.TypeDefResponse, error) {
 	return &response, nil
 }
 
+// RefreshCaches refreshes the default client's cache matching the kind of the provided typedef.
 func RefreshCaches(typedef model.TypeDef) error {
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.RefreshCaches(typedef)
+}
+
+// RefreshCaches refreshes this client's cache matching the kind of the provided typedef.
+func (ac *AtlanClient) RefreshCaches(typedef model.TypeDef) error {
 	switch t := typedef.(type) {
 	case *model.AtlanTagDef:
-		return GetAtlanTagCache().RefreshCache()
+		return ac.AtlanTagCache().RefreshCache()
 	case *model.CustomMetadataDef:
-		return GetCustomMetadataCache().RefreshCache()
+		return ac.CustomMetadataCache().RefreshCache()
 	case model.EnumDef:
 		// return EnumCache.RefreshCache()
 	default:
@@ -77,8 +86,17 @@ func RefreshCaches(typedef model.TypeDef) error {
 	return nil
 }
 
+// GetAll retrieves all the type definitions in Atlan, using the default client.
 func GetAll() (*model.TypeDefResponse, error) {
-	rawJSON, err := DefaultAtlanClient.CallAPI(&GET_ALL_TYPE_DEFS, nil, nil)
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.GetAllTypeDefs()
+}
+
+// GetAllTypeDefs retrieves all the type definitions in Atlan.
+func (ac *AtlanClient) GetAllTypeDefs() (*model.TypeDefResponse, error) {
+	rawJSON, err := ac.CallAPI(&GET_ALL_TYPE_DEFS, nil, nil)
 	if err != nil {
 		return nil, AtlanError{
 			ErrorCode: errorCodes[CONNECTION_ERROR],
@@ -90,6 +108,14 @@ func GetAll() (*model.TypeDefResponse, error) {
 
 // Get retrieves a TypeDefResponse object that contains a list of the specified category type definitions in Atlan.
 func Get(typeCategory interface{}) (*model.TypeDefResponse, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.GetTypeDefs(typeCategory)
+}
+
+// GetTypeDefs retrieves a TypeDefResponse object that contains a list of the specified category type definitions in Atlan.
+func (ac *AtlanClient) GetTypeDefs(typeCategory interface{}) (*model.TypeDefResponse, error) {
 	var categories []string
 	hasStruct := false
 
@@ -118,7 +144,7 @@ func Get(typeCategory interface{}) (*model.TypeDefResponse, error) {
 		"type": categories,
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&GET_ALL_TYPE_DEFS, queryParams, nil)
+	rawJSON, err := ac.CallAPI(&GET_ALL_TYPE_DEFS, queryParams, nil)
 	if err != nil {
 		return nil, AtlanError{
 			ErrorCode: errorCodes[CONNECTION_ERROR],
@@ -149,7 +175,7 @@ func (c *TypeDefClient) Create(typedef model.TypeDef) (*model.TypeDefResponse, e
 	if err != nil {
 		return nil, err
 	}
-	RefreshCaches(typedef)
+	c.Client.RefreshCaches(typedef)
 	return NewTypeDefResponse(rawJSON)
 }
 
@@ -162,7 +188,7 @@ func (c *TypeDefClient) Update(typedef model.TypeDef) (*model.TypeDefResponse, e
 	if err != nil {
 		return nil, err
 	}
-	RefreshCaches(typedef)
+	c.Client.RefreshCaches(typedef)
 	return NewTypeDefResponse(rawJSON)
 }
 
@@ -170,28 +196,28 @@ func (c *TypeDefClient) Purge(name string, typedefType model.TypeDef) error {
 	var internalName string
 	switch t := typedefType.(type) {
 	case *model.CustomMetadataDef:
-		internalName, _ = GetCustomMetadataCache().GetIDForName(name)
+		internalName, _ = c.Client.CustomMetadataCache().GetIDForName(name)
 	case *model.EnumDef:
 		// internalName = name
 	case *model.AtlanTagDef:
-		internalName, _ = GetAtlanTagCache().GetIDForName(name)
+		internalName, _ = c.Client.AtlanTagCache().GetIDForName(name)
 	default:
 		return fmt.Errorf("unsupported TypeDef type: %T", t)
 	}
 
 	if internalName == "" {
-		return NotFoundError{AtlanError{ErrorCode: errorCodes[TYPEDEF_NOT_FOUND_BY_NAME], Args: []interface{}{name}}}
+		return &NotFoundError{AtlanError{ErrorCode: errorCodes[TYPEDEF_NOT_FOUND_BY_NAME], Args: []interface{}{name}}}
 	}
 
 	c.Client.CallAPI(&DELETE_TYPE_DEF_BY_NAME, nil, nil)
 
 	switch t := typedefType.(type) {
 	case *model.CustomMetadataDef:
-		GetCustomMetadataCache().RefreshCache()
+		c.Client.CustomMetadataCache().RefreshCache()
 	case *model.EnumDef:
 		// EnumCache.refreshCache()
 	case *model.AtlanTagDef:
-		RefreshCache()
+		c.Client.AtlanTagCache().RefreshCache()
 	default:
 		return fmt.Errorf("unsupported TypeDef type: %T", t)
 	}
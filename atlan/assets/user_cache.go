@@ -15,65 +15,81 @@ type UserCache struct {
 	mutex        sync.Mutex
 }
 
-var (
-	userCaches = make(map[string]*UserCache)
-	userMutex  sync.Mutex
-)
-
-// GetUserCache retrieves the UserCache for the default Atlan client.
-func GetUserCache() (*UserCache, error) {
-	userMutex.Lock()
-	defer userMutex.Unlock()
-
-	client := DefaultAtlanClient
-	cacheKey := generateCacheKey(client.host, client.ApiKey)
-
-	if userCaches[cacheKey] == nil {
-		userCaches[cacheKey] = &UserCache{
-			userClient:   client.UserClient,
-			tokenClient:  client.TokenClient,
+// UserCache returns the UserCache owned by this client.
+func (ac *AtlanClient) UserCache() *UserCache {
+	ac.caches.mutex.Lock()
+	defer ac.caches.mutex.Unlock()
+
+	if ac.caches.user == nil {
+		ac.caches.user = &UserCache{
+			userClient:   (*UserClient)(ac),
+			tokenClient:  (*TokenClient)(ac),
 			mapIDToName:  make(map[string]string),
 			mapNameToID:  make(map[string]string),
 			mapEmailToID: make(map[string]string),
 		}
 	}
-	return userCaches[cacheKey], nil
+	return ac.caches.user
 }
 
-// GetUserIDForName translates the provided human-readable username to its GUID.
+// GetUserCache retrieves the UserCache for the default Atlan client.
+func GetUserCache() (*UserCache, error) {
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient.UserCache(), nil
+}
+
+// GetUserIDForName translates the provided human-readable username to its GUID, using the default client.
 func GetUserIDForName(name string) (string, error) {
-	cache, err := GetUserCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getIDForName(name)
+	return DefaultAtlanClient.GetUserIDForName(name)
 }
 
-// GetUserIDForEmail translates the provided email to its GUID.
+// GetUserIDForName translates the provided human-readable username to its GUID.
+func (ac *AtlanClient) GetUserIDForName(name string) (string, error) {
+	return ac.UserCache().getIDForName(name)
+}
+
+// GetUserIDForEmail translates the provided email to its GUID, using the default client.
 func GetUserIDForEmail(email string) (string, error) {
-	cache, err := GetUserCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getIDForEmail(email)
+	return DefaultAtlanClient.GetUserIDForEmail(email)
 }
 
-// GetUserNameForID translates the provided user GUID to the human-readable username.
+// GetUserIDForEmail translates the provided email to its GUID.
+func (ac *AtlanClient) GetUserIDForEmail(email string) (string, error) {
+	return ac.UserCache().getIDForEmail(email)
+}
+
+// GetUserNameForID translates the provided user GUID to the human-readable username, using the default client.
 func GetUserNameForID(id string) (string, error) {
-	cache, err := GetUserCache()
-	if err != nil {
-		return "", err
+	if DefaultAtlanClient == nil {
+		return "", errDefaultClientNotInitialized
 	}
-	return cache.getNameForID(id)
+	return DefaultAtlanClient.GetUserNameForID(id)
 }
 
-// ValidateUserNames validates that the given human-readable usernames are valid.
+// GetUserNameForID translates the provided user GUID to the human-readable username.
+func (ac *AtlanClient) GetUserNameForID(id string) (string, error) {
+	return ac.UserCache().getNameForID(id)
+}
+
+// ValidateUserNames validates that the given human-readable usernames are valid, using the default client.
 func ValidateUserNames(names []string) error {
-	cache, err := GetUserCache()
-	if err != nil {
-		return err
+	if DefaultAtlanClient == nil {
+		return errDefaultClientNotInitialized
 	}
-	return cache.validateNames(names)
+	return DefaultAtlanClient.ValidateUserNames(names)
+}
+
+// ValidateUserNames validates that the given human-readable usernames are valid.
+func (ac *AtlanClient) ValidateUserNames(names []string) error {
+	return ac.UserCache().validateNames(names)
 }
 
 func (uc *UserCache) refreshCache() error {
@@ -1,6 +1,7 @@
 package assets
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,18 @@ type (
 	UserClient AtlanClient
 )
 
+// client returns the AtlanClient backing this UserClient,
+// falling back to the default client for a zero-value UserClient.
+func (uc *UserClient) client() (*AtlanClient, error) {
+	if uc != nil && uc.Session != nil {
+		return (*AtlanClient)(uc), nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
 type CreateUser struct {
 	Email    string `json:"email"`
 	RoleName string `json:"roleName"`
@@ -41,6 +54,15 @@ func (u *AtlanUser) Updater(guid string) (*AtlanUser, error) {
 }
 
 func (uc *UserClient) CreateUsers(users []AtlanUser, returnInfo bool) ([]AtlanUser, error) {
+	return uc.CreateUsersWithContext(context.Background(), users, returnInfo)
+}
+
+// CreateUsersWithContext is like CreateUsers, but bound to the provided context.
+func (uc *UserClient) CreateUsersWithContext(ctx context.Context, users []AtlanUser, returnInfo bool) ([]AtlanUser, error) {
+	client, err := uc.client()
+	if err != nil {
+		return nil, err
+	}
 	if len(users) == 0 {
 		return nil, fmt.Errorf("no users provided for creation")
 	}
@@ -52,7 +74,7 @@ func (uc *UserClient) CreateUsers(users []AtlanUser, returnInfo bool) ([]AtlanUs
 		}
 
 		// Fetch the role ID from roleCache
-		roleID, err := GetRoleIDForRoleName(user.WorkspaceRole)
+		roleID, err := client.GetRoleIDForRoleName(user.WorkspaceRole)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch role ID for role '%s': %w", user.WorkspaceRole, err)
 		}
@@ -64,7 +86,7 @@ func (uc *UserClient) CreateUsers(users []AtlanUser, returnInfo bool) ([]AtlanUs
 		})
 	}
 
-	_, err := DefaultAtlanClient.CallAPI(&CREATE_USERS, nil, cur)
+	_, err = client.CallAPIWithContext(ctx, &CREATE_USERS, nil, cur)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create users: %w", err)
 	}
@@ -75,7 +97,7 @@ func (uc *UserClient) CreateUsers(users []AtlanUser, returnInfo bool) ([]AtlanUs
 		for _, user := range cur.Users {
 			emails = append(emails, user.Email)
 		}
-		return uc.GetByEmails(emails, 20, 0)
+		return uc.GetByEmailsWithContext(ctx, emails, 20, 0)
 	}
 
 	return nil, nil
@@ -83,6 +105,15 @@ func (uc *UserClient) CreateUsers(users []AtlanUser, returnInfo bool) ([]AtlanUs
 
 // Get retrieves a UserResponse which contains a list of users defined in Atlan.
 func (uc *UserClient) Get(limit int, postFilter string, sort string, count bool, offset int) (*UserResponse, error) {
+	return uc.GetWithContext(context.Background(), limit, postFilter, sort, count, offset)
+}
+
+// GetWithContext is like Get, but bound to the provided context.
+func (uc *UserClient) GetWithContext(ctx context.Context, limit int, postFilter string, sort string, count bool, offset int) (*UserResponse, error) {
+	client, err := uc.client()
+	if err != nil {
+		return nil, err
+	}
 	if limit == 0 {
 		limit = 20
 	}
@@ -116,7 +147,7 @@ func (uc *UserClient) Get(limit int, postFilter string, sort string, count bool,
 
 	queryParams := request.QueryParams()
 
-	rawJson, err := DefaultAtlanClient.CallAPI(&GET_USERS, queryParams, nil)
+	rawJson, err := client.CallAPIWithContext(ctx, &GET_USERS, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +158,7 @@ func (uc *UserClient) Get(limit int, postFilter string, sort string, count bool,
 		return nil, err
 	}
 
-	userResponse.Client = DefaultAtlanClient
+	userResponse.Client = client
 	userResponse.Endpoint = &GET_USERS
 	userResponse.Criteria = request
 	userResponse.Start = request.Offset
@@ -138,6 +169,11 @@ func (uc *UserClient) Get(limit int, postFilter string, sort string, count bool,
 
 // GetAll retrieves all users defined in Atlan.
 func (uc *UserClient) GetAll(limit int, offset int, sort string) ([]AtlanUser, error) {
+	return uc.GetAllWithContext(context.Background(), limit, offset, sort)
+}
+
+// GetAllWithContext is like GetAll, but bound to the provided context.
+func (uc *UserClient) GetAllWithContext(ctx context.Context, limit int, offset int, sort string) ([]AtlanUser, error) {
 	if limit == 0 {
 		limit = 20
 	}
@@ -146,7 +182,7 @@ func (uc *UserClient) GetAll(limit int, offset int, sort string) ([]AtlanUser, e
 		sort = "username"
 	}
 
-	userResponse, err := uc.Get(limit, "", sort, true, offset)
+	userResponse, err := uc.GetWithContext(ctx, limit, "", sort, true, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -159,12 +195,17 @@ func (uc *UserClient) GetAll(limit int, offset int, sort string) ([]AtlanUser, e
 
 // GetByEmail retrieves all users with email addresses that contain the provided email.
 func (uc *UserClient) GetByEmail(email string, limit int, offset int) ([]AtlanUser, error) {
+	return uc.GetByEmailWithContext(context.Background(), email, limit, offset)
+}
+
+// GetByEmailWithContext is like GetByEmail, but bound to the provided context.
+func (uc *UserClient) GetByEmailWithContext(ctx context.Context, email string, limit int, offset int) ([]AtlanUser, error) {
 	if limit == 0 {
 		limit = 20
 	}
 
 	postFilter := `{"email":{"$ilike":"%` + email + `%"}}`
-	userResponse, err := uc.Get(limit, postFilter, "", true, offset)
+	userResponse, err := uc.GetWithContext(ctx, limit, postFilter, "", true, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +215,11 @@ func (uc *UserClient) GetByEmail(email string, limit int, offset int) ([]AtlanUs
 
 // GetByEmails retrieves all users with email addresses that match the provided list of emails.
 func (uc *UserClient) GetByEmails(emails []string, limit int, offset int) ([]AtlanUser, error) {
+	return uc.GetByEmailsWithContext(context.Background(), emails, limit, offset)
+}
+
+// GetByEmailsWithContext is like GetByEmails, but bound to the provided context.
+func (uc *UserClient) GetByEmailsWithContext(ctx context.Context, emails []string, limit int, offset int) ([]AtlanUser, error) {
 	if limit == 0 {
 		limit = 20
 	}
@@ -184,7 +230,7 @@ func (uc *UserClient) GetByEmails(emails []string, limit int, offset int) ([]Atl
 	}
 
 	emailFilter := fmt.Sprintf(`{"email":{"$in":%s}}`, string(emailJSON))
-	userResponse, err := uc.Get(limit, emailFilter, "", true, offset)
+	userResponse, err := uc.GetWithContext(ctx, limit, emailFilter, "", true, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -194,8 +240,13 @@ func (uc *UserClient) GetByEmails(emails []string, limit int, offset int) ([]Atl
 
 // GetByUsername retrieves a user based on the username.
 func (uc *UserClient) GetByUsername(username string) (*AtlanUser, error) {
+	return uc.GetByUsernameWithContext(context.Background(), username)
+}
+
+// GetByUsernameWithContext is like GetByUsername, but bound to the provided context.
+func (uc *UserClient) GetByUsernameWithContext(ctx context.Context, username string) (*AtlanUser, error) {
 	postFilter := fmt.Sprintf(`{"$and":[{"username":{"$eq":"%s"}}]}`, username)
-	userResponse, err := uc.Get(5, postFilter, "", true, 0)
+	userResponse, err := uc.GetWithContext(ctx, 5, postFilter, "", true, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -209,6 +260,11 @@ func (uc *UserClient) GetByUsername(username string) (*AtlanUser, error) {
 
 // GetByUsernames retrieves users based on their usernames.
 func (uc *UserClient) GetByUsernames(usernames []string, limit int, offset int) ([]AtlanUser, error) {
+	return uc.GetByUsernamesWithContext(context.Background(), usernames, limit, offset)
+}
+
+// GetByUsernamesWithContext is like GetByUsernames, but bound to the provided context.
+func (uc *UserClient) GetByUsernamesWithContext(ctx context.Context, usernames []string, limit int, offset int) ([]AtlanUser, error) {
 	if limit == 0 {
 		limit = 5
 	}
@@ -219,7 +275,7 @@ func (uc *UserClient) GetByUsernames(usernames []string, limit int, offset int)
 	}
 
 	usernameFilter := fmt.Sprintf(`{"username":{"$in":%s}}`, string(usernamesJSON))
-	userResponse, err := uc.Get(limit, usernameFilter, "", true, offset)
+	userResponse, err := uc.GetWithContext(ctx, limit, usernameFilter, "", true, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -228,6 +284,15 @@ func (uc *UserClient) GetByUsernames(usernames []string, limit int, offset int)
 }
 
 func (uc *UserClient) GetGroups(guid string, request *structs.GroupRequest) ([]*AtlanGroup, error) {
+	return uc.GetGroupsWithContext(context.Background(), guid, request)
+}
+
+// GetGroupsWithContext is like GetGroups, but bound to the provided context.
+func (uc *UserClient) GetGroupsWithContext(ctx context.Context, guid string, request *structs.GroupRequest) ([]*AtlanGroup, error) {
+	client, err := uc.client()
+	if err != nil {
+		return nil, err
+	}
 	// If no request is provided, initialize a default one
 	if request == nil {
 		request = &structs.GroupRequest{}
@@ -238,7 +303,7 @@ func (uc *UserClient) GetGroups(guid string, request *structs.GroupRequest) ([]*
 
 	queryParams := request.QueryParams()
 
-	responseData, err := DefaultAtlanClient.CallAPI(api, queryParams, nil)
+	responseData, err := client.CallAPIWithContext(ctx, api, queryParams, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve groups for user %s: %w", guid, err)
 	}
@@ -266,6 +331,15 @@ type AddToGroupsRequest struct {
 // Errors:
 // - Returns an AtlanError if any API communication occurs
 func (uc *UserClient) AddUserToGroups(guid string, groupIDs []string) error {
+	return uc.AddUserToGroupsWithContext(context.Background(), guid, groupIDs)
+}
+
+// AddUserToGroupsWithContext is like AddUserToGroups, but bound to the provided context.
+func (uc *UserClient) AddUserToGroupsWithContext(ctx context.Context, guid string, groupIDs []string) error {
+	client, err := uc.client()
+	if err != nil {
+		return err
+	}
 	if guid == "" {
 		return fmt.Errorf("user GUID cannot be empty")
 	}
@@ -280,7 +354,7 @@ func (uc *UserClient) AddUserToGroups(guid string, groupIDs []string) error {
 	api := &ADD_USER_TO_GROUPS
 	api.Path = fmt.Sprintf("users/%s/groups", guid)
 
-	_, err := DefaultAtlanClient.CallAPI(api, nil, requestPayload)
+	_, err = client.CallAPIWithContext(ctx, api, nil, requestPayload)
 	if err != nil {
 		return fmt.Errorf("failed to add user to groups: %w", err)
 	}
@@ -307,6 +381,15 @@ Errors:
 - Returns an error if any API communication issue occurs.
 */
 func (uc *UserClient) ChangeUserRole(guid string, roleID string) error {
+	return uc.ChangeUserRoleWithContext(context.Background(), guid, roleID)
+}
+
+// ChangeUserRoleWithContext is like ChangeUserRole, but bound to the provided context.
+func (uc *UserClient) ChangeUserRoleWithContext(ctx context.Context, guid string, roleID string) error {
+	client, err := uc.client()
+	if err != nil {
+		return err
+	}
 	if guid == "" {
 		return fmt.Errorf("user GUID cannot be empty")
 	}
@@ -321,7 +404,7 @@ func (uc *UserClient) ChangeUserRole(guid string, roleID string) error {
 	api := &CHANGE_USER_ROLE
 	api.Path = fmt.Sprintf("users/%s/roles/update", guid)
 
-	_, err := DefaultAtlanClient.CallAPI(api, nil, requestPayload)
+	_, err = client.CallAPIWithContext(ctx, api, nil, requestPayload)
 	if err != nil {
 		return fmt.Errorf("failed to change user role: %w", err)
 	}
@@ -392,7 +475,11 @@ func (r *UserResponse) getNextPage() (bool, error) {
 	r.Criteria.Limit = r.Size
 
 	queryParams := r.Criteria.QueryParams()
-	responseBytes, err := DefaultAtlanClient.CallAPI(r.Endpoint, queryParams, nil)
+	client := r.Client
+	if client == nil {
+		client = DefaultAtlanClient
+	}
+	responseBytes, err := client.CallAPI(r.Endpoint, queryParams, nil)
 	if err != nil {
 		return false, err
 	}
@@ -474,8 +561,17 @@ func ParseAtlanUser(data interface{}) (AtlanUser, error) {
 //   - WorkflowResponse: Response of the workflow execution.
 //   - Error: If any API issue occurs.
 func (uc *UserClient) RemoveUser(userName, transferToUserName string, wfCreatorUserName *string) (*structs.WorkflowResponse, error) {
+	return uc.RemoveUserWithContext(context.Background(), userName, transferToUserName, wfCreatorUserName)
+}
+
+// RemoveUserWithContext is like RemoveUser, but bound to the provided context.
+func (uc *UserClient) RemoveUserWithContext(ctx context.Context, userName, transferToUserName string, wfCreatorUserName *string) (*structs.WorkflowResponse, error) {
+	client, err := uc.client()
+	if err != nil {
+		return nil, err
+	}
 	// Fetch user details using userName
-	userDetails, err := uc.GetByUsername(userName)
+	userDetails, err := uc.GetByUsernameWithContext(ctx, userName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user details: %w", err)
 	}
@@ -486,7 +582,7 @@ func (uc *UserClient) RemoveUser(userName, transferToUserName string, wfCreatorU
 	}
 
 	// Fetch transferee user details
-	transferUserDetails, err := uc.GetByUsername(transferToUserName)
+	transferUserDetails, err := uc.GetByUsernameWithContext(ctx, transferToUserName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch transferee user details: %w", err)
 	}
@@ -494,7 +590,7 @@ func (uc *UserClient) RemoveUser(userName, transferToUserName string, wfCreatorU
 	// Determine workflow creator details, also avoiding an extra request if wfCreatorUserName == transferToUserName
 	var wfCreatorDetails *AtlanUser
 	if wfCreatorUserName != nil && *wfCreatorUserName != transferToUserName {
-		wfCreatorDetails, err = uc.GetByUsername(*wfCreatorUserName)
+		wfCreatorDetails, err = uc.GetByUsernameWithContext(ctx, *wfCreatorUserName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch workflow creator user details: %w", err)
 		}
@@ -590,7 +686,7 @@ func (uc *UserClient) RemoveUser(userName, transferToUserName string, wfCreatorU
 		Payload:  payload,
 	}
 
-	responseData, err := DefaultAtlanClient.CallAPI(&WORKFLOW_RUN, nil, workflowPayload)
+	responseData, err := client.CallAPIWithContext(ctx, &WORKFLOW_RUN, nil, workflowPayload)
 	if err != nil {
 		return nil, fmt.Errorf("error executing workflow: %w", err)
 	}
@@ -0,0 +1,127 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultPageFetchConcurrency is the number of pages fetched at once by IteratePages and ParallelPages.
+const DefaultPageFetchConcurrency = 4
+
+// fetchInParallel calls fetch for every index in [0, n), using at most the given number of
+// goroutines at once, and passes the results to deliver: in index order when ordered, or as
+// soon as they are fetched otherwise. At most workers results are fetched ahead of their delivery,
+// which bounds memory use. It stops at the first error of fetch or deliver, cancelling the context
+// of the calls still in flight, and returns that error.
+func fetchInParallel[T any](ctx context.Context, n, workers int, ordered bool, fetch func(ctx context.Context, i int) (T, error), deliver func(T) error) error {
+	if n <= 0 {
+		return nil
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		index int
+		value T
+		err   error
+	}
+	// A slot is taken before fetching a result, and only released once it has been delivered
+	slots := make(chan struct{}, workers)
+	results := make(chan result)
+	go func() {
+		var wg sync.WaitGroup
+		defer func() {
+			wg.Wait()
+			close(results)
+		}()
+		for i := 0; i < n; i++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				value, err := fetch(ctx, i)
+				select {
+				case results <- result{index: i, value: value, err: err}:
+				case <-ctx.Done():
+				}
+			}(i)
+		}
+	}()
+
+	var firstErr error
+	fail := func(err error) {
+		firstErr = err
+		cancel()
+	}
+	pending := make(map[int]T)
+	delivered := 0
+	for r := range results {
+		if firstErr != nil {
+			// Drain the results of the calls that were in flight
+			continue
+		}
+		if r.err != nil {
+			fail(r.err)
+			continue
+		}
+		if !ordered {
+			if err := deliver(r.value); err != nil {
+				fail(err)
+				continue
+			}
+			delivered++
+			<-slots
+			continue
+		}
+		pending[r.index] = r.value
+		for {
+			value, ok := pending[delivered]
+			if !ok {
+				break
+			}
+			delete(pending, delivered)
+			if err := deliver(value); err != nil {
+				fail(err)
+				break
+			}
+			delivered++
+			<-slots
+		}
+	}
+	if firstErr == nil && delivered < n {
+		// The parent context was cancelled before every result was fetched
+		return ctx.Err()
+	}
+	return firstErr
+}
+
+// WithPageFetchConcurrency sets how many pages of search results IteratePages and ParallelPages
+// fetch at once, unless set on the iterator. It defaults to DefaultPageFetchConcurrency.
+func WithPageFetchConcurrency(workers int) ClientOption {
+	return func(o *clientOptions) error {
+		if workers < 1 {
+			return fmt.Errorf("page fetch concurrency must be at least 1")
+		}
+		o.pageFetchConcurrency = workers
+		return nil
+	}
+}
+
+// pageFetchWorkers returns how many pages of search results the client fetches at once.
+func (ac *AtlanClient) pageFetchWorkers() int {
+	if ac.pageFetchConcurrency < 1 {
+		return DefaultPageFetchConcurrency
+	}
+	return ac.pageFetchConcurrency
+}
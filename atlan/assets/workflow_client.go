@@ -1,6 +1,7 @@
 package assets
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,6 +23,18 @@ type WorkflowClient struct {
 	*AtlanClient
 }
 
+// client returns the AtlanClient backing this WorkflowClient,
+// falling back to the default client for a zero-value WorkflowClient.
+func (w *WorkflowClient) client() (*AtlanClient, error) {
+	if w != nil && w.AtlanClient != nil {
+		return w.AtlanClient, nil
+	}
+	if DefaultAtlanClient == nil {
+		return nil, errDefaultClientNotInitialized
+	}
+	return DefaultAtlanClient, nil
+}
+
 // FindByType searches for workflows by their type prefix.
 // Params:
 //   - prefix: The workflow package type (atlan.WorkflowPackage) to search for (for example atlan.WorkflowPackageSnowflakeMiner).
@@ -31,6 +44,15 @@ type WorkflowClient struct {
 //   - A slice of structs.WorkflowSearchResult containing the workflows found.
 //   - An error if any occurs during the request.
 func (w *WorkflowClient) FindByType(prefix atlan.WorkflowPackage, maxResults int) ([]structs.WorkflowSearchResult, error) {
+	return w.FindByTypeWithContext(context.Background(), prefix, maxResults)
+}
+
+// FindByTypeWithContext is like FindByType, but bound to the provided context.
+func (w *WorkflowClient) FindByTypeWithContext(ctx context.Context, prefix atlan.WorkflowPackage, maxResults int) ([]structs.WorkflowSearchResult, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	var query model.Query = &model.BoolQuery{
 		Filter: []model.Query{
 			&model.NestedQuery{
@@ -60,7 +82,7 @@ func (w *WorkflowClient) FindByType(prefix atlan.WorkflowPackage, maxResults int
 		Sort:  sortItems,
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&WORKFLOW_INDEX_SEARCH, nil, &request)
+	rawJSON, err := client.CallAPIWithContext(ctx, &WORKFLOW_INDEX_SEARCH, nil, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +102,15 @@ func (w *WorkflowClient) FindByType(prefix atlan.WorkflowPackage, maxResults int
 //   - A pointer to a structs.WorkflowSearchResult containing the workflow found, or nil if no workflow is found.
 //   - An error if any occurs during the request or unmarshalling.
 func (w *WorkflowClient) FindByID(id string) (*structs.WorkflowSearchResult, error) {
+	return w.FindByIDWithContext(context.Background(), id)
+}
+
+// FindByIDWithContext is like FindByID, but bound to the provided context.
+func (w *WorkflowClient) FindByIDWithContext(ctx context.Context, id string) (*structs.WorkflowSearchResult, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	var query model.Query = &model.BoolQuery{
 		Filter: []model.Query{
 			&model.NestedQuery{
@@ -97,7 +128,7 @@ func (w *WorkflowClient) FindByID(id string) (*structs.WorkflowSearchResult, err
 		Size:  1,
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&WORKFLOW_INDEX_SEARCH, nil, &request)
+	rawJSON, err := client.CallAPIWithContext(ctx, &WORKFLOW_INDEX_SEARCH, nil, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +158,11 @@ func (w *WorkflowClient) FindByID(id string) (*structs.WorkflowSearchResult, err
 //   - A pointer to a structs.WorkflowSearchResult containing the workflow run found, or nil if no workflow run is found.
 //   - An error if any occurs during the request or unmarshalling.
 func (w *WorkflowClient) FindRunByID(id string) (*structs.WorkflowSearchResult, error) {
+	return w.FindRunByIDWithContext(context.Background(), id)
+}
+
+// FindRunByIDWithContext is like FindRunByID, but bound to the provided context.
+func (w *WorkflowClient) FindRunByIDWithContext(ctx context.Context, id string) (*structs.WorkflowSearchResult, error) {
 	var query model.Query = &model.BoolQuery{
 		Filter: []model.Query{
 			&model.TermQuery{
@@ -136,7 +172,7 @@ func (w *WorkflowClient) FindRunByID(id string) (*structs.WorkflowSearchResult,
 		},
 	}
 
-	response, err := w.findRuns(query, 0, 1)
+	response, err := w.findRuns(ctx, query, 0, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -156,14 +192,18 @@ func (w *WorkflowClient) FindRunByID(id string) (*structs.WorkflowSearchResult,
 // Returns:
 //   - A pointer to structs.WorkflowSearchResponse containing the retrieved workflow runs.
 //   - An error if any occurs during the request or unmarshalling.
-func (w *WorkflowClient) findRuns(query model.Query, from, size int) (*structs.WorkflowSearchResponse, error) {
+func (w *WorkflowClient) findRuns(ctx context.Context, query model.Query, from, size int) (*structs.WorkflowSearchResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	request := model.WorkflowSearchRequest{
 		Query: query,
 		From:  from,
 		Size:  size,
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&WORKFLOW_INDEX_RUN_SEARCH, nil, &request)
+	rawJSON, err := client.CallAPIWithContext(ctx, &WORKFLOW_INDEX_RUN_SEARCH, nil, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +224,11 @@ func (w *WorkflowClient) findRuns(query model.Query, from, size int) (*structs.W
 //   - A pointer to a structs.WorkflowSearchResult containing the latest workflow run found, or nil if no run is found.
 //   - An error if any occurs during the request or unmarshalling.
 func (w *WorkflowClient) FindLatestRun(workflowName string) (*structs.WorkflowSearchResult, error) {
+	return w.FindLatestRunWithContext(context.Background(), workflowName)
+}
+
+// FindLatestRunWithContext is like FindLatestRun, but bound to the provided context.
+func (w *WorkflowClient) FindLatestRunWithContext(ctx context.Context, workflowName string) (*structs.WorkflowSearchResult, error) {
 	var query model.Query = &model.BoolQuery{
 		Filter: []model.Query{
 			&model.NestedQuery{
@@ -196,7 +241,7 @@ func (w *WorkflowClient) FindLatestRun(workflowName string) (*structs.WorkflowSe
 		},
 	}
 
-	response, err := w.findRuns(query, 0, 1)
+	response, err := w.findRuns(ctx, query, 0, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +260,11 @@ func (w *WorkflowClient) FindLatestRun(workflowName string) (*structs.WorkflowSe
 //   - A pointer to a structs.WorkflowSearchResult containing the currently running workflow, or nil if no run is found or it's not running.
 //   - An error if any occurs during the request or unmarshalling.
 func (w *WorkflowClient) FindCurrentRun(workflowName string) (*structs.WorkflowSearchResult, error) {
+	return w.FindCurrentRunWithContext(context.Background(), workflowName)
+}
+
+// FindCurrentRunWithContext is like FindCurrentRun, but bound to the provided context.
+func (w *WorkflowClient) FindCurrentRunWithContext(ctx context.Context, workflowName string) (*structs.WorkflowSearchResult, error) {
 	var query model.Query = &model.BoolQuery{
 		Filter: []model.Query{
 			&model.NestedQuery{
@@ -227,7 +277,7 @@ func (w *WorkflowClient) FindCurrentRun(workflowName string) (*structs.WorkflowS
 		},
 	}
 
-	response, err := w.findRuns(query, 0, 50)
+	response, err := w.findRuns(ctx, query, 0, 50)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +301,11 @@ func (w *WorkflowClient) FindCurrentRun(workflowName string) (*structs.WorkflowS
 //   - A slice of structs.WorkflowSearchResult containing the workflow runs found.
 //   - An error if any occurs during the request or unmarshalling.
 func (w *WorkflowClient) GetRuns(workflowName string, workflowPhase atlan.AtlanWorkflowPhase, from, size int) ([]structs.WorkflowSearchResult, error) {
+	return w.GetRunsWithContext(context.Background(), workflowName, workflowPhase, from, size)
+}
+
+// GetRunsWithContext is like GetRuns, but bound to the provided context.
+func (w *WorkflowClient) GetRunsWithContext(ctx context.Context, workflowName string, workflowPhase atlan.AtlanWorkflowPhase, from, size int) ([]structs.WorkflowSearchResult, error) {
 	var query model.Query = &model.BoolQuery{
 		Must: []model.Query{
 			&model.NestedQuery{
@@ -269,7 +324,7 @@ func (w *WorkflowClient) GetRuns(workflowName string, workflowPhase atlan.AtlanW
 		},
 	}
 
-	response, err := w.findRuns(query, from, size)
+	response, err := w.findRuns(ctx, query, from, size)
 	if err != nil {
 		return nil, err
 	}
@@ -285,10 +340,19 @@ func (w *WorkflowClient) GetRuns(workflowName string, workflowPhase atlan.AtlanW
 //   - A pointer to structs.WorkflowRunResponse containing the result of the stop action.
 //   - An error if any occurs during the request or unmarshalling.
 func (w *WorkflowClient) Stop(workflowRunID string) (*structs.WorkflowRunResponse, error) {
+	return w.StopWithContext(context.Background(), workflowRunID)
+}
+
+// StopWithContext is like Stop, but bound to the provided context.
+func (w *WorkflowClient) StopWithContext(ctx context.Context, workflowRunID string) (*structs.WorkflowRunResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	api := &STOP_WORKFLOW_RUN
 	api.Path = fmt.Sprintf("runs/%s/stop", workflowRunID)
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(api, nil, "")
+	rawJSON, err := client.CallAPIWithContext(ctx, api, nil, "")
 	if err != nil {
 		return nil, err
 	}
@@ -308,9 +372,18 @@ func (w *WorkflowClient) Stop(workflowRunID string) (*structs.WorkflowRunRespons
 // Returns:
 //   - An error if any occurs during the request or API call.
 func (w *WorkflowClient) Delete(workflowName string) error {
+	return w.DeleteWithContext(context.Background(), workflowName)
+}
+
+// DeleteWithContext is like Delete, but bound to the provided context.
+func (w *WorkflowClient) DeleteWithContext(ctx context.Context, workflowName string) error {
+	client, err := w.client()
+	if err != nil {
+		return err
+	}
 	api := &WORKFLOW_ARCHIVE
 	api.Path = fmt.Sprintf("workflows/%s/archive", workflowName)
-	_, err := DefaultAtlanClient.CallAPI(api, nil, "")
+	_, err = client.CallAPIWithContext(ctx, api, nil, "")
 	return err
 }
 
@@ -321,10 +394,10 @@ func (w *WorkflowClient) Delete(workflowName string) error {
 // Returns:
 //   - A pointer to structs.WorkflowSearchResultDetail containing the details of the workflow.
 //   - An error if the workflow type is invalid or any issue occurs.
-func (w *WorkflowClient) handleWorkflowTypes(workflow interface{}) (*structs.WorkflowSearchResultDetail, error) {
+func (w *WorkflowClient) handleWorkflowTypes(ctx context.Context, workflow interface{}) (*structs.WorkflowSearchResultDetail, error) {
 	switch wf := workflow.(type) {
 	case atlan.WorkflowPackage:
-		results, err := w.FindByType(wf, 1) // Fetching at most 1 result
+		results, err := w.FindByTypeWithContext(ctx, wf, 1) // Fetching at most 1 result
 		if err != nil {
 			return nil, err
 		}
@@ -376,16 +449,27 @@ func convertWorkflowToSearchResult(wf *structs.Workflow) (*structs.WorkflowSearc
 //   - A pointer to structs.WorkflowRunResponse containing the result of the rerun.
 //   - An error if any occurs during the rerun process.
 func (w *WorkflowClient) Rerun(workflow interface{}, idempotent bool) (*structs.WorkflowRunResponse, error) {
-	detail, err := w.handleWorkflowTypes(workflow)
+	return w.RerunWithContext(context.Background(), workflow, idempotent)
+}
+
+// RerunWithContext is like Rerun, but bound to the provided context.
+func (w *WorkflowClient) RerunWithContext(ctx context.Context, workflow interface{}, idempotent bool) (*structs.WorkflowRunResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
+	detail, err := w.handleWorkflowTypes(ctx, workflow)
 	if err != nil {
 		return nil, err
 	}
 
 	if idempotent && *detail.Metadata.Name != "" {
 		// Wait before checking the current workflow run status
-		time.Sleep(10 * time.Second)
+		if err := sleepWithContext(ctx, 10*time.Second); err != nil {
+			return nil, err
+		}
 
-		currentRun, err := w.FindCurrentRun(*detail.Metadata.Name)
+		currentRun, err := w.FindCurrentRunWithContext(ctx, *detail.Metadata.Name)
 		if err == nil && currentRun != nil && currentRun.Source.Status != nil {
 			return &structs.WorkflowRunResponse{
 				WorkflowResponse: structs.WorkflowResponse{
@@ -402,7 +486,7 @@ func (w *WorkflowClient) Rerun(workflow interface{}, idempotent bool) (*structs.
 		ResourceName: *detail.Metadata.Name,
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&WORKFLOW_RERUN, nil, &request)
+	rawJSON, err := client.CallAPIWithContext(ctx, &WORKFLOW_RERUN, nil, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -423,10 +507,19 @@ func (w *WorkflowClient) Rerun(workflow interface{}, idempotent bool) (*structs.
 //   - A pointer to structs.WorkflowResponse containing the updated workflow result.
 //   - An error if any occurs during the update process.
 func (w *WorkflowClient) Update(workflow *structs.Workflow) (*structs.WorkflowResponse, error) {
+	return w.UpdateWithContext(context.Background(), workflow)
+}
+
+// UpdateWithContext is like Update, but bound to the provided context.
+func (w *WorkflowClient) UpdateWithContext(ctx context.Context, workflow *structs.Workflow) (*structs.WorkflowResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	api := &WORKFLOW_UPDATE
 	api.Path = fmt.Sprintf("workflows/%s", *workflow.Metadata.Name)
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(api, nil, workflow)
+	rawJSON, err := client.CallAPIWithContext(ctx, api, nil, workflow)
 	if err != nil {
 		return nil, err
 	}
@@ -448,12 +541,21 @@ func (w *WorkflowClient) Update(workflow *structs.Workflow) (*structs.WorkflowRe
 //   - A pointer to structs.WorkflowResponse containing the updated workflow result.
 //   - An error if any occurs during the ownership change.
 func (w *WorkflowClient) UpdateOwner(workflowName, username string) (*structs.WorkflowResponse, error) {
+	return w.UpdateOwnerWithContext(context.Background(), workflowName, username)
+}
+
+// UpdateOwnerWithContext is like UpdateOwner, but bound to the provided context.
+func (w *WorkflowClient) UpdateOwnerWithContext(ctx context.Context, workflowName, username string) (*structs.WorkflowResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	api := &WORKFLOW_CHANGE_OWNER
 	api.Path = fmt.Sprintf("workflows/%s/changeownership", workflowName)
 
 	queryParams := map[string]string{"username": username}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(api, queryParams, nil)
+	rawJSON, err := client.CallAPIWithContext(ctx, api, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -477,6 +579,11 @@ func (w *WorkflowClient) UpdateOwner(workflowName, username string) (*structs.Wo
 //   - The current workflow phase (atlan.AtlanWorkflowPhase) indicating the status of the workflow run.
 //   - An error if any occurs during the monitoring process.
 func (w *WorkflowClient) Monitor(workflowResponse *structs.WorkflowResponse, logger *log.Logger) (*atlan.AtlanWorkflowPhase, error) {
+	return w.MonitorWithContext(context.Background(), workflowResponse, logger)
+}
+
+// MonitorWithContext is like Monitor, but bound to the provided context.
+func (w *WorkflowClient) MonitorWithContext(ctx context.Context, workflowResponse *structs.WorkflowResponse, logger *log.Logger) (*atlan.AtlanWorkflowPhase, error) {
 	if workflowResponse.Metadata == nil || *workflowResponse.Metadata.Name == "" {
 		if logger != nil {
 			logger.Println("Skipping workflow monitoring — nothing to monitor.")
@@ -488,8 +595,10 @@ func (w *WorkflowClient) Monitor(workflowResponse *structs.WorkflowResponse, log
 	var status *atlan.AtlanWorkflowPhase
 
 	for status == nil || (*status != atlan.AtlanWorkflowPhaseSuccess && *status != atlan.AtlanWorkflowPhaseError && *status != atlan.AtlanWorkflowPhaseFailed) {
-		time.Sleep(MonitorSleepSeconds * time.Second)
-		runDetails, _ := w.FindLatestRun(*name)
+		if err := sleepWithContext(ctx, MonitorSleepSeconds*time.Second); err != nil {
+			return nil, err
+		}
+		runDetails, _ := w.FindLatestRunWithContext(ctx, *name)
 		if runDetails != nil {
 			status = runDetails.Status()
 		}
@@ -525,7 +634,16 @@ func (w *WorkflowClient) addSchedule(workflow *structs.WorkflowSearchResultDetai
 //   - A WorkflowResponse object containing the details of the scheduled workflow.
 //   - Error if any occurred during the process.
 func (w *WorkflowClient) AddSchedule(workflow interface{}, schedule *structs.WorkflowSchedule) (*structs.WorkflowResponse, error) {
-	workflowToUpdate, err := w.handleWorkflowTypes(workflow)
+	return w.AddScheduleWithContext(context.Background(), workflow, schedule)
+}
+
+// AddScheduleWithContext is like AddSchedule, but bound to the provided context.
+func (w *WorkflowClient) AddScheduleWithContext(ctx context.Context, workflow interface{}, schedule *structs.WorkflowSchedule) (*structs.WorkflowResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
+	workflowToUpdate, err := w.handleWorkflowTypes(ctx, workflow)
 	if err != nil {
 		return nil, err
 	}
@@ -535,7 +653,7 @@ func (w *WorkflowClient) AddSchedule(workflow interface{}, schedule *structs.Wor
 	api := &WORKFLOW_UPDATE
 	api.Path = fmt.Sprintf("workflows/%s", *workflowToUpdate.Metadata.Name)
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(api, nil, workflowToUpdate)
+	rawJSON, err := client.CallAPIWithContext(ctx, api, nil, workflowToUpdate)
 	if err != nil {
 		return nil, err
 	}
@@ -558,7 +676,16 @@ func (w *WorkflowClient) AddSchedule(workflow interface{}, schedule *structs.Wor
 //   - A WorkflowResponse object with the updated workflow details.
 //   - Error if any occurred during the process.
 func (w *WorkflowClient) RemoveSchedule(workflow interface{}) (*structs.WorkflowResponse, error) {
-	workflowToUpdate, err := w.handleWorkflowTypes(workflow)
+	return w.RemoveScheduleWithContext(context.Background(), workflow)
+}
+
+// RemoveScheduleWithContext is like RemoveSchedule, but bound to the provided context.
+func (w *WorkflowClient) RemoveScheduleWithContext(ctx context.Context, workflow interface{}) (*structs.WorkflowResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
+	workflowToUpdate, err := w.handleWorkflowTypes(ctx, workflow)
 	if err != nil {
 		return nil, err
 	}
@@ -570,7 +697,7 @@ func (w *WorkflowClient) RemoveSchedule(workflow interface{}) (*structs.Workflow
 	api := &WORKFLOW_UPDATE
 	api.Path = fmt.Sprintf("workflows/%s", *workflowToUpdate.Metadata.Name)
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(api, nil, workflowToUpdate)
+	rawJSON, err := client.CallAPIWithContext(ctx, api, nil, workflowToUpdate)
 	if err != nil {
 		return nil, err
 	}
@@ -590,7 +717,16 @@ func (w *WorkflowClient) RemoveSchedule(workflow interface{}) (*structs.Workflow
 //   - A WorkflowScheduleResponse containing the list of scheduled workflows.
 //   - Error if any occurred during the API call.
 func (w *WorkflowClient) GetAllScheduledRuns() (*structs.WorkflowScheduleResponse, error) {
-	rawJSON, err := DefaultAtlanClient.CallAPI(&GET_ALL_SCHEDULE_RUNS, nil, nil)
+	return w.GetAllScheduledRunsWithContext(context.Background())
+}
+
+// GetAllScheduledRunsWithContext is like GetAllScheduledRuns, but bound to the provided context.
+func (w *WorkflowClient) GetAllScheduledRunsWithContext(ctx context.Context) (*structs.WorkflowScheduleResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
+	rawJSON, err := client.CallAPIWithContext(ctx, &GET_ALL_SCHEDULE_RUNS, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -615,10 +751,19 @@ func (w *WorkflowClient) GetAllScheduledRuns() (*structs.WorkflowScheduleRespons
 //   - A WorkflowScheduleResponse containing the scheduled run details.
 //   - Error if any occurred during the API call.
 func (w *WorkflowClient) GetScheduledRun(workflowName string) (*structs.WorkflowScheduleResponse, error) {
+	return w.GetScheduledRunWithContext(context.Background(), workflowName)
+}
+
+// GetScheduledRunWithContext is like GetScheduledRun, but bound to the provided context.
+func (w *WorkflowClient) GetScheduledRunWithContext(ctx context.Context, workflowName string) (*structs.WorkflowScheduleResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	api := &GET_SCHEDULE_RUN
 	api.Path = fmt.Sprintf("runs/cron/%s-cron", workflowName)
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(api, nil, nil)
+	rawJSON, err := client.CallAPIWithContext(ctx, api, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -642,6 +787,15 @@ func (w *WorkflowClient) GetScheduledRun(workflowName string) (*structs.Workflow
 //   - A slice of WorkflowSearchResult containing the matching scheduled workflows.
 //   - Error if any occurred during the search process.
 func (w *WorkflowClient) FindScheduleQuery(savedQueryID string, maxResults int) ([]structs.WorkflowSearchResult, error) {
+	return w.FindScheduleQueryWithContext(context.Background(), savedQueryID, maxResults)
+}
+
+// FindScheduleQueryWithContext is like FindScheduleQuery, but bound to the provided context.
+func (w *WorkflowClient) FindScheduleQueryWithContext(ctx context.Context, savedQueryID string, maxResults int) ([]structs.WorkflowSearchResult, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	if maxResults <= 0 {
 		maxResults = 10
 	}
@@ -670,7 +824,7 @@ func (w *WorkflowClient) FindScheduleQuery(savedQueryID string, maxResults int)
 		Size:  maxResults,
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&WORKFLOW_INDEX_SEARCH, nil, request)
+	rawJSON, err := client.CallAPIWithContext(ctx, &WORKFLOW_INDEX_SEARCH, nil, request)
 	if err != nil {
 		return nil, err
 	}
@@ -697,12 +851,21 @@ func (w *WorkflowClient) FindScheduleQuery(savedQueryID string, maxResults int)
 //   - A WorkflowRunResponse containing details of the re-triggered workflow.
 //   - Error if any occurred during the re-run process.
 func (w *WorkflowClient) ReRunScheduleQuery(scheduleQueryID string) (*structs.WorkflowRunResponse, error) {
+	return w.ReRunScheduleQueryWithContext(context.Background(), scheduleQueryID)
+}
+
+// ReRunScheduleQueryWithContext is like ReRunScheduleQuery, but bound to the provided context.
+func (w *WorkflowClient) ReRunScheduleQueryWithContext(ctx context.Context, scheduleQueryID string) (*structs.WorkflowRunResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	request := structs.ReRunRequest{
 		Namespace:    "default",
 		ResourceName: scheduleQueryID,
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&WORKFLOW_OWNER_RERUN, nil, &request)
+	rawJSON, err := client.CallAPIWithContext(ctx, &WORKFLOW_OWNER_RERUN, nil, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -726,6 +889,15 @@ func (w *WorkflowClient) ReRunScheduleQuery(scheduleQueryID string) (*structs.Wo
 //   - A slice of WorkflowRunResponse containing the matching scheduled workflows within the date range.
 //   - Error if any occurred during the search process.
 func (w *WorkflowClient) FindScheduleQueryBetween(request structs.ScheduleQueriesSearchRequest, missed bool) ([]structs.WorkflowRunResponse, error) {
+	return w.FindScheduleQueryBetweenWithContext(context.Background(), request, missed)
+}
+
+// FindScheduleQueryBetweenWithContext is like FindScheduleQueryBetween, but bound to the provided context.
+func (w *WorkflowClient) FindScheduleQueryBetweenWithContext(ctx context.Context, request structs.ScheduleQueriesSearchRequest, missed bool) ([]structs.WorkflowRunResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	queryParams := map[string]string{
 		"startDate": request.StartDate,
 		"endDate":   request.EndDate,
@@ -736,7 +908,7 @@ func (w *WorkflowClient) FindScheduleQueryBetween(request structs.ScheduleQuerie
 		searchAPI = SCHEDULE_QUERY_WORKFLOWS_MISSED
 	}
 
-	rawJSON, err := DefaultAtlanClient.CallAPI(&searchAPI, queryParams, nil)
+	rawJSON, err := client.CallAPIWithContext(ctx, &searchAPI, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -760,6 +932,15 @@ func (w *WorkflowClient) FindScheduleQueryBetween(request structs.ScheduleQuerie
 //   - A WorkflowResponse object containing the details of the executed workflow.
 //   - Error if any occurred during the execution process.
 func (w *WorkflowClient) Run(workflow interface{}, schedule *structs.WorkflowSchedule) (*structs.WorkflowResponse, error) {
+	return w.RunWithContext(context.Background(), workflow, schedule)
+}
+
+// RunWithContext is like Run, but bound to the provided context.
+func (w *WorkflowClient) RunWithContext(ctx context.Context, workflow interface{}, schedule *structs.WorkflowSchedule) (*structs.WorkflowResponse, error) {
+	client, err := w.client()
+	if err != nil {
+		return nil, err
+	}
 	if workflow == nil {
 		return nil, errors.New("workflow cannot be nil")
 	}
@@ -776,11 +957,11 @@ func (w *WorkflowClient) Run(workflow interface{}, schedule *structs.WorkflowSch
 	}
 
 	if schedule != nil {
-		workflowToUpdate, _ := w.handleWorkflowTypes(workflowPayload)
+		workflowToUpdate, _ := w.handleWorkflowTypes(ctx, workflowPayload)
 		w.addSchedule(workflowToUpdate, schedule)
 	}
 
-	responseData, err := DefaultAtlanClient.CallAPI(&WORKFLOW_RUN, nil, workflowPayload)
+	responseData, err := client.CallAPIWithContext(ctx, &WORKFLOW_RUN, nil, workflowPayload)
 	if err != nil {
 		return nil, fmt.Errorf("error executing workflow: %w", err)
 	}
@@ -0,0 +1,585 @@
+package atlantest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+const (
+	statusActive  = "ACTIVE"
+	statusDeleted = "DELETED"
+)
+
+// AddEntity stores an entity directly, as if it had been created through the bulk entity API,
+// and returns its GUID. Any createTime or updateTime of the entity is kept.
+// The entity uses the Atlas JSON structure, for example:
+//
+//	server.AddEntity(map[string]interface{}{
+//		"typeName":   "Table",
+//		"attributes": map[string]interface{}{"name": "orders", "qualifiedName": "default/snowflake/1/db/schema/orders"},
+//	})
+func (s *Server) AddEntity(entity map[string]interface{}) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entity = normalizeEntity(toJSONObject(entity))
+	createTime, updateTime := entity["createTime"], entity["updateTime"]
+	created, _ := s.upsertEntity(entity)
+	if createTime != nil {
+		created["createTime"] = createTime
+	}
+	if updateTime != nil {
+		created["updateTime"] = updateTime
+	}
+	return stringValue(created["guid"])
+}
+
+// Entity returns a copy of the stored entity with the given GUID, or nil if there is none.
+// Soft-deleted entities are returned with a DELETED status.
+func (s *Server) Entity(guid string) map[string]interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entity, ok := s.entities[guid]
+	if !ok {
+		return nil
+	}
+	return copyMap(entity)
+}
+
+// Entities returns copies of all stored entities, in the order they were created.
+func (s *Server) Entities() []map[string]interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entities := make([]map[string]interface{}, 0, len(s.entityOrder))
+	for _, guid := range s.entityOrder {
+		entities = append(entities, copyMap(s.entities[guid]))
+	}
+	return entities
+}
+
+// RejectEntities makes the bulk entity API reject every request that contains an entity for which
+// reject returns an error, with a 400 response carrying the error message, as Atlan does for
+// entities that fail validation. None of the entities of a rejected request are saved.
+// The entity uses the Atlas JSON structure, and reject may be nil to accept every entity again.
+func (s *Server) RejectEntities(reject func(entity map[string]interface{}) error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.rejectEntity = reject
+}
+
+// handleBulkEntities creates or updates every entity in the request, matching existing entities
+// on their GUID or on their type name and qualified name.
+func (s *Server) handleBulkEntities(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Entities []map[string]interface{} `json:"entities"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if s.rejectEntity != nil {
+		for _, entity := range request.Entities {
+			if err := s.rejectEntity(normalizeEntity(entity)); err != nil {
+				writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-08A", err.Error())
+				return
+			}
+		}
+	}
+
+	placeholders := make(map[string]bool)
+	for _, entity := range request.Entities {
+		if guid := stringValue(entity["guid"]); strings.HasPrefix(guid, "-") {
+			placeholders[guid] = true
+		}
+	}
+	for _, entity := range request.Entities {
+		missing := ""
+		walkReferences(entity, func(ref map[string]interface{}) {
+			if guid := stringValue(ref["guid"]); strings.HasPrefix(guid, "-") && !placeholders[guid] {
+				missing = guid
+			}
+		})
+		if missing != "" {
+			writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-00A", fmt.Sprintf("Referenced entity %s is not found", missing))
+			return
+		}
+	}
+
+	guidAssignments := make(map[string]string)
+	var saved []map[string]interface{}
+	var created, updated []interface{}
+	for _, entity := range request.Entities {
+		entity = normalizeEntity(entity)
+		if stringValue(entity["typeName"]) == "" {
+			writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-01A", "typeName is required for every entity")
+			return
+		}
+		requestedGUID := stringValue(entity["guid"])
+		if requestedGUID != "" && !strings.HasPrefix(requestedGUID, "-") {
+			if _, ok := s.entities[requestedGUID]; !ok {
+				writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-005", fmt.Sprintf("Given instance guid %s is invalid/not found", requestedGUID))
+				return
+			}
+		}
+
+		stored, changed := s.upsertEntity(entity)
+		guid := stringValue(stored["guid"])
+		if requestedGUID != "" && requestedGUID != guid {
+			guidAssignments[requestedGUID] = guid
+		}
+		saved = append(saved, stored)
+		switch changed {
+		case entityCreated:
+			created = append(created, entityHeader(stored))
+		case entityUpdated:
+			updated = append(updated, entityHeader(stored))
+		}
+	}
+
+	// References to the placeholder GUIDs of the request point to the entities created for them
+	for _, stored := range saved {
+		walkReferences(stored, func(ref map[string]interface{}) {
+			if guid, ok := guidAssignments[stringValue(ref["guid"])]; ok {
+				ref["guid"] = guid
+			}
+		})
+	}
+
+	mutated := make(map[string]interface{})
+	if len(created) > 0 {
+		mutated["CREATE"] = created
+	}
+	if len(updated) > 0 {
+		mutated["UPDATE"] = updated
+	}
+	response := map[string]interface{}{"mutatedEntities": mutated}
+	if len(guidAssignments) > 0 {
+		response["guidAssignments"] = guidAssignments
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+type entityChange int
+
+const (
+	entityUnchanged entityChange = iota
+	entityCreated
+	entityUpdated
+)
+
+// upsertEntity creates the entity, or merges its attributes into the existing entity it matches.
+// The references in its appendRelationshipAttributes and removeRelationshipAttributes are added to
+// or removed from the relationship attributes of the entity.
+func (s *Server) upsertEntity(entity map[string]interface{}) (map[string]interface{}, entityChange) {
+	now := nowMillis()
+	appended, _ := entity["appendRelationshipAttributes"].(map[string]interface{})
+	removed, _ := entity["removeRelationshipAttributes"].(map[string]interface{})
+	delete(entity, "appendRelationshipAttributes")
+	delete(entity, "removeRelationshipAttributes")
+	existing := s.findEntity(entity)
+	if existing == nil {
+		s.changeReferences(entity["relationshipAttributes"].(map[string]interface{}), appended, removed)
+		guid := stringValue(entity["guid"])
+		if guid == "" || strings.HasPrefix(guid, "-") {
+			guid = newGUID()
+		}
+		attributes := entity["attributes"].(map[string]interface{})
+		if stringValue(attributes["qualifiedName"]) == "" {
+			attributes["qualifiedName"] = stringValue(entity["typeName"]) + "/" + guid
+		}
+		entity["guid"] = guid
+		entity["status"] = statusActive
+		entity["createdBy"] = CurrentUsername
+		entity["updatedBy"] = CurrentUsername
+		entity["createTime"] = now
+		entity["updateTime"] = now
+		entity["version"] = 0
+		s.entities[guid] = entity
+		s.entityOrder = append(s.entityOrder, guid)
+		return entity, entityCreated
+	}
+
+	changed := existing["status"] != statusActive
+	for _, key := range []string{"attributes", "relationshipAttributes"} {
+		target := existing[key].(map[string]interface{})
+		for name, value := range entity[key].(map[string]interface{}) {
+			if value == nil {
+				continue
+			}
+			if !reflect.DeepEqual(target[name], value) {
+				target[name] = value
+				changed = true
+			}
+		}
+	}
+	if s.changeReferences(existing["relationshipAttributes"].(map[string]interface{}), appended, removed) {
+		changed = true
+	}
+	for _, key := range []string{"classifications", "meanings", "labels", "businessAttributes"} {
+		if value, ok := entity[key]; ok && value != nil && !reflect.DeepEqual(existing[key], value) {
+			existing[key] = value
+			changed = true
+		}
+	}
+	if !changed {
+		return existing, entityUnchanged
+	}
+	existing["status"] = statusActive
+	existing["updatedBy"] = CurrentUsername
+	existing["updateTime"] = now
+	existing["version"] = intValue(existing["version"]) + 1
+	return existing, entityUpdated
+}
+
+// changeReferences adds the appended references to the relationship attributes, unless they are
+// already there, and removes the removed ones, reporting whether any attribute changed.
+func (s *Server) changeReferences(relationshipAttributes, appended, removed map[string]interface{}) bool {
+	changed := false
+	for name, refs := range appended {
+		values := asList(relationshipAttributes[name])
+		for _, ref := range asList(refs) {
+			if s.indexOfReference(values, ref) < 0 {
+				values = append(values, ref)
+				changed = true
+			}
+		}
+		relationshipAttributes[name] = values
+	}
+	for name, refs := range removed {
+		values := asList(relationshipAttributes[name])
+		for _, ref := range asList(refs) {
+			if i := s.indexOfReference(values, ref); i >= 0 {
+				values = append(values[:i:i], values[i+1:]...)
+				changed = true
+			}
+		}
+		relationshipAttributes[name] = values
+	}
+	return changed
+}
+
+// indexOfReference returns the index of the reference in the list that points to the same entity
+// as ref, or -1 if there is none.
+func (s *Server) indexOfReference(refs []interface{}, ref interface{}) int {
+	key := s.referenceKey(ref)
+	for i, r := range refs {
+		if s.referenceKey(r) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// referenceKey identifies the entity a reference points to: by the GUID of the stored entity it
+// resolves to, or else by its own GUID or type name and qualified name.
+func (s *Server) referenceKey(ref interface{}) string {
+	if referenced := s.findReferenced(ref); referenced != nil {
+		return stringValue(referenced["guid"])
+	}
+	r, _ := ref.(map[string]interface{})
+	if guid := stringValue(r["guid"]); guid != "" {
+		return guid
+	}
+	uniqueAttributes, _ := r["uniqueAttributes"].(map[string]interface{})
+	return stringValue(r["typeName"]) + "/" + stringValue(uniqueAttributes["qualifiedName"])
+}
+
+// walkReferences calls visit with every reference to another entity, by GUID, in the attributes
+// and relationship attributes of the entity.
+func walkReferences(entity map[string]interface{}, visit func(ref map[string]interface{})) {
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if _, ok := v["guid"]; ok {
+				visit(v)
+				return
+			}
+			for _, item := range v {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	for _, key := range []string{"attributes", "relationshipAttributes", "appendRelationshipAttributes", "removeRelationshipAttributes"} {
+		walk(entity[key])
+	}
+}
+
+// findEntity returns the stored entity matching the GUID of the given one,
+// or else its type name and qualified name.
+func (s *Server) findEntity(entity map[string]interface{}) map[string]interface{} {
+	if existing, ok := s.entities[stringValue(entity["guid"])]; ok {
+		return existing
+	}
+	qualifiedName := stringValue(entity["attributes"].(map[string]interface{})["qualifiedName"])
+	if qualifiedName == "" {
+		return nil
+	}
+	return s.findByQualifiedName(stringValue(entity["typeName"]), qualifiedName)
+}
+
+func (s *Server) findByQualifiedName(typeName, qualifiedName string) map[string]interface{} {
+	for _, guid := range s.entityOrder {
+		entity := s.entities[guid]
+		if entity["typeName"] == typeName && stringValue(entity["attributes"].(map[string]interface{})["qualifiedName"]) == qualifiedName {
+			return entity
+		}
+	}
+	return nil
+}
+
+// handleDeleteEntities soft or hard deletes the entities with the GUIDs in the query.
+func (s *Server) handleDeleteEntities(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var guids []string
+	for _, value := range query["guid"] {
+		for _, guid := range strings.Split(value, ",") {
+			if guid = strings.TrimSpace(guid); guid != "" {
+				guids = append(guids, guid)
+			}
+		}
+	}
+	if len(guids) == 0 {
+		writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-002", "at least one guid is required")
+		return
+	}
+	for _, guid := range guids {
+		if _, ok := s.entities[guid]; !ok {
+			writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-005", fmt.Sprintf("Given instance guid %s is invalid/not found", guid))
+			return
+		}
+	}
+
+	hard := query.Get("deleteType") == "HARD" || query.Get("deleteType") == "PURGE"
+	var deleted []interface{}
+	for _, guid := range guids {
+		entity := s.entities[guid]
+		if hard {
+			delete(s.entities, guid)
+			s.removeFromOrder(guid)
+		} else {
+			if entity["status"] == statusDeleted {
+				continue
+			}
+			entity["status"] = statusDeleted
+			entity["updatedBy"] = CurrentUsername
+			entity["updateTime"] = nowMillis()
+		}
+		header := entityHeader(entity)
+		header["status"] = statusDeleted
+		deleted = append(deleted, header)
+	}
+
+	mutated := make(map[string]interface{})
+	if len(deleted) > 0 {
+		mutated["DELETE"] = deleted
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"mutatedEntities": mutated})
+}
+
+func (s *Server) removeFromOrder(guid string) {
+	for i, g := range s.entityOrder {
+		if g == guid {
+			s.entityOrder = append(s.entityOrder[:i], s.entityOrder[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *Server) handleGetEntityByGUID(w http.ResponseWriter, r *http.Request, guid string) {
+	entity, ok := s.entities[guid]
+	if !ok {
+		writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-005", fmt.Sprintf("Given instance guid %s is invalid/not found", guid))
+		return
+	}
+	writeEntity(w, r, entity)
+}
+
+func (s *Server) handleGetEntityByUniqueAttribute(w http.ResponseWriter, r *http.Request, typeName string) {
+	qualifiedName := r.URL.Query().Get("attr:qualifiedName")
+	entity := s.findByQualifiedName(typeName, qualifiedName)
+	if entity == nil {
+		writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-009",
+			fmt.Sprintf("Instance %s with unique attribute {qualifiedName:%s} does not exist", typeName, qualifiedName))
+		return
+	}
+	writeEntity(w, r, entity)
+}
+
+// handleSaveBusinessMetadata sets the attributes of a custom metadata set on the entity with the
+// given GUID, removing those that are null, or removes the given attributes when removing.
+func (s *Server) handleSaveBusinessMetadata(w http.ResponseWriter, r *http.Request, guid, setName string, remove bool) {
+	var attributes map[string]interface{}
+	if !decodeBody(w, r, &attributes) {
+		return
+	}
+	entity, ok := s.entities[guid]
+	if !ok {
+		writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-005", fmt.Sprintf("Given instance guid %s is invalid/not found", guid))
+		return
+	}
+	if s.typeDefs[categoryBusinessMetadata][setName] == nil {
+		writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-007", fmt.Sprintf("Given typename %s was invalid", setName))
+		return
+	}
+
+	businessAttributes, _ := entity["businessAttributes"].(map[string]interface{})
+	if businessAttributes == nil {
+		businessAttributes = make(map[string]interface{})
+	}
+	set, _ := businessAttributes[setName].(map[string]interface{})
+	if set == nil {
+		set = make(map[string]interface{})
+	}
+	for name, value := range attributes {
+		if remove || value == nil {
+			delete(set, name)
+		} else {
+			set[name] = value
+		}
+	}
+	if len(set) > 0 {
+		businessAttributes[setName] = set
+	} else {
+		delete(businessAttributes, setName)
+	}
+	entity["businessAttributes"] = businessAttributes
+	entity["updatedBy"] = CurrentUsername
+	entity["updateTime"] = nowMillis()
+	entity["version"] = intValue(entity["version"]) + 1
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeEntity writes the entity in the structure returned by the entity retrieval endpoints.
+func writeEntity(w http.ResponseWriter, r *http.Request, entity map[string]interface{}) {
+	entity = copyMap(entity)
+	if r.URL.Query().Get("ignore_relationships") == "true" {
+		entity["relationshipAttributes"] = map[string]interface{}{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"referredEntities": map[string]interface{}{},
+		"entity":           entity,
+	})
+}
+
+// entityHeader returns the summary of an entity included in mutation responses.
+func entityHeader(entity map[string]interface{}) map[string]interface{} {
+	attributes := entity["attributes"].(map[string]interface{})
+	name := stringValue(attributes["name"])
+	return map[string]interface{}{
+		"typeName":    entity["typeName"],
+		"guid":        entity["guid"],
+		"status":      entity["status"],
+		"displayText": name,
+		"attributes": map[string]interface{}{
+			"qualifiedName": attributes["qualifiedName"],
+			"name":          attributes["name"],
+		},
+		"classificationNames": classificationNames(entity),
+		"meaningNames":        []string{},
+		"meanings":            []string{},
+		"isIncomplete":        false,
+		"labels":              []string{},
+		"createdBy":           entity["createdBy"],
+		"updatedBy":           entity["updatedBy"],
+		"createTime":          entity["createTime"],
+		"updateTime":          entity["updateTime"],
+	}
+}
+
+// classificationNames returns the type names of the Atlan tags on the entity.
+func classificationNames(entity map[string]interface{}) []string {
+	names := []string{}
+	classifications, _ := entity["classifications"].([]interface{})
+	for _, classification := range classifications {
+		if c, ok := classification.(map[string]interface{}); ok {
+			names = append(names, stringValue(c["typeName"]))
+		}
+	}
+	return names
+}
+
+// normalizeEntity copies the entity, making sure its attribute maps exist.
+func normalizeEntity(entity map[string]interface{}) map[string]interface{} {
+	entity = copyMap(entity)
+	for _, key := range []string{"attributes", "relationshipAttributes"} {
+		if _, ok := entity[key].(map[string]interface{}); !ok {
+			entity[key] = map[string]interface{}{}
+		}
+	}
+	return entity
+}
+
+// copyMap deep copies a decoded JSON object, so stored entities can't be modified through the copy.
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	return copyValue(m).(map[string]interface{})
+}
+
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			c[key] = copyValue(item)
+		}
+		return c
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i, item := range v {
+			c[i] = copyValue(item)
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// toJSONObject round-trips a value through JSON, so that it only holds the types
+// decoded from request bodies (maps, slices, strings, booleans and json.Number).
+func toJSONObject(value interface{}) map[string]interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(fmt.Sprintf("atlantest: value cannot be encoded as JSON: %v", err))
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		panic(fmt.Sprintf("atlantest: value is not a JSON object: %v", err))
+	}
+	return object
+}
+
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func intValue(value interface{}) int {
+	switch v := value.(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case json.Number:
+		n, _ := v.Int64()
+		return int(n)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,591 @@
+package atlantest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AddUser stores a user directly and returns its ID. The user uses the Heracles JSON
+// structure (for example a structs.AtlanUser), and gets the $member role unless it has one.
+func (s *Server) AddUser(user interface{}) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	record := toJSONObject(user)
+	if stringValue(record["id"]) == "" {
+		record["id"] = newGUID()
+	}
+	if stringValue(record["workspaceRole"]) == "" {
+		record["workspaceRole"] = "$member"
+	}
+	if _, ok := record["enabled"]; !ok {
+		record["enabled"] = true
+	}
+	s.users = append(s.users, record)
+	return stringValue(record["id"])
+}
+
+// AddGroup stores a group directly, with the given users as members, and returns its ID.
+// The group uses the Heracles JSON structure, for example a structs.AtlanGroup.
+func (s *Server) AddGroup(group interface{}, userIDs ...string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return stringValue(s.createGroup(toJSONObject(group), userIDs)["id"])
+}
+
+func (s *Server) handleGetUsers(w http.ResponseWriter, r *http.Request) {
+	records := make([]map[string]interface{}, 0, len(s.users))
+	for _, user := range s.users {
+		records = append(records, s.userWithGroupCount(user))
+	}
+	writeJSON(w, http.StatusOK, page(filterRecords(records, r.URL.Query().Get("filter")), r))
+}
+
+// userWithGroupCount returns a copy of the user with the number of groups it belongs to.
+func (s *Server) userWithGroupCount(user map[string]interface{}) map[string]interface{} {
+	user = copyMap(user)
+	count := 0
+	for _, members := range s.groupMembers {
+		if containsString(members, stringValue(user["id"])) {
+			count++
+		}
+	}
+	user["groupCount"] = count
+	return user
+}
+
+// handleCreateUsers invites the users in the request, which are created enabled right away.
+func (s *Server) handleCreateUsers(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Users []struct {
+			Email    string `json:"email"`
+			RoleName string `json:"roleName"`
+			RoleID   string `json:"roleId"`
+		} `json:"users"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	for _, user := range request.Users {
+		if user.Email == "" {
+			writeHeraclesError(w, http.StatusBadRequest, "email is required for every user")
+			return
+		}
+		if s.findRecord(s.users, "email", user.Email) != nil {
+			writeHeraclesError(w, http.StatusConflict, fmt.Sprintf("user with email %s already exists", user.Email))
+			return
+		}
+	}
+	for _, user := range request.Users {
+		username, _, _ := strings.Cut(user.Email, "@")
+		s.users = append(s.users, map[string]interface{}{
+			"id":               newGUID(),
+			"username":         username,
+			"email":            user.Email,
+			"enabled":          true,
+			"emailVerified":    false,
+			"workspaceRole":    user.RoleName,
+			"createdTimestamp": nowMillis(),
+		})
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleDeleteUser(w http.ResponseWriter, id string) {
+	for i, user := range s.users {
+		if stringValue(user["id"]) == id {
+			s.users = append(s.users[:i], s.users[i+1:]...)
+			for group, members := range s.groupMembers {
+				s.groupMembers[group] = removeString(members, id)
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("user %s not found", id))
+}
+
+func (s *Server) handleGetUserGroups(w http.ResponseWriter, r *http.Request, id string) {
+	if s.findRecord(s.users, "id", id) == nil {
+		writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("user %s not found", id))
+		return
+	}
+	var groups []map[string]interface{}
+	for _, group := range s.groups {
+		if containsString(s.groupMembers[stringValue(group["id"])], id) {
+			groups = append(groups, s.groupWithUserCount(group))
+		}
+	}
+	writeJSON(w, http.StatusOK, page(filterRecords(groups, r.URL.Query().Get("filter")), r))
+}
+
+func (s *Server) handleAddUserToGroups(w http.ResponseWriter, r *http.Request, id string) {
+	var request struct {
+		Groups []string `json:"groups"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	if s.findRecord(s.users, "id", id) == nil {
+		writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("user %s not found", id))
+		return
+	}
+	for _, group := range request.Groups {
+		if s.findRecord(s.groups, "id", group) == nil {
+			writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("group %s not found", group))
+			return
+		}
+	}
+	for _, group := range request.Groups {
+		if !containsString(s.groupMembers[group], id) {
+			s.groupMembers[group] = append(s.groupMembers[group], id)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleChangeUserRole(w http.ResponseWriter, r *http.Request, id string) {
+	var request struct {
+		RoleID string `json:"roleId"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	user := s.findRecord(s.users, "id", id)
+	if user == nil {
+		writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("user %s not found", id))
+		return
+	}
+	role := s.findRecord(s.roles, "id", request.RoleID)
+	if role == nil {
+		writeHeraclesError(w, http.StatusBadRequest, fmt.Sprintf("role %s not found", request.RoleID))
+		return
+	}
+	user["workspaceRole"] = role["name"]
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleGetGroups(w http.ResponseWriter, r *http.Request) {
+	records := make([]map[string]interface{}, 0, len(s.groups))
+	for _, group := range s.groups {
+		records = append(records, s.groupWithUserCount(group))
+	}
+	writeJSON(w, http.StatusOK, page(filterRecords(records, r.URL.Query().Get("filter")), r))
+}
+
+// groupWithUserCount returns a copy of the group with its number of members.
+func (s *Server) groupWithUserCount(group map[string]interface{}) map[string]interface{} {
+	group = copyMap(group)
+	group["userCount"] = len(s.groupMembers[stringValue(group["id"])])
+	return group
+}
+
+// handleCreateGroup creates the group in the request, with the requested members.
+func (s *Server) handleCreateGroup(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Group map[string]interface{} `json:"group"`
+		Users []string               `json:"users"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	if request.Group == nil {
+		writeHeraclesError(w, http.StatusBadRequest, "group is required")
+		return
+	}
+	if name := stringValue(request.Group["name"]); name != "" && s.findRecord(s.groups, "name", name) != nil {
+		writeHeraclesError(w, http.StatusConflict, fmt.Sprintf("group %s already exists", stringValue(request.Group["name"])))
+		return
+	}
+	group := s.createGroup(request.Group, request.Users)
+
+	statuses := make(map[string]interface{})
+	for _, user := range request.Users {
+		statuses[user] = map[string]interface{}{"status": http.StatusOK, "statusMessage": "success"}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"group": group["id"], "users": statuses})
+}
+
+func (s *Server) createGroup(group map[string]interface{}, userIDs []string) map[string]interface{} {
+	group = copyMap(group)
+	id := newGUID()
+	group["id"] = id
+	if alias := firstAttribute(group, "alias"); alias != "" && stringValue(group["alias"]) == "" {
+		group["alias"] = alias
+	}
+	if stringValue(group["name"]) == "" {
+		group["name"] = strings.ReplaceAll(strings.ToLower(stringValue(group["alias"])), " ", "_")
+	}
+	group["path"] = "/" + stringValue(group["name"])
+	attributes, _ := group["attributes"].(map[string]interface{})
+	if attributes == nil {
+		attributes = make(map[string]interface{})
+		group["attributes"] = attributes
+	}
+	now := strconv.FormatInt(nowMillis(), 10)
+	attributes["createdAt"] = []interface{}{now}
+	attributes["createdBy"] = []interface{}{CurrentUsername}
+	attributes["updatedAt"] = []interface{}{now}
+	attributes["updatedBy"] = []interface{}{CurrentUsername}
+	s.groups = append(s.groups, group)
+	s.groupMembers[id] = append([]string(nil), userIDs...)
+	return group
+}
+
+// handleUpdateGroup merges the properties and attributes in the request into the group.
+func (s *Server) handleUpdateGroup(w http.ResponseWriter, r *http.Request, id string) {
+	var request map[string]interface{}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	group := s.findRecord(s.groups, "id", id)
+	if group == nil {
+		writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("group %s not found", id))
+		return
+	}
+	for key, value := range request {
+		switch key {
+		case "id", "name", "path", "userCount":
+		case "attributes":
+			attributes, _ := group["attributes"].(map[string]interface{})
+			if updates, ok := value.(map[string]interface{}); ok {
+				for name, v := range updates {
+					attributes[name] = v
+				}
+			}
+		default:
+			group[key] = value
+		}
+	}
+	if alias := firstAttribute(group, "alias"); alias != "" {
+		group["alias"] = alias
+	}
+	attributes := group["attributes"].(map[string]interface{})
+	attributes["updatedAt"] = []interface{}{strconv.FormatInt(nowMillis(), 10)}
+	attributes["updatedBy"] = []interface{}{CurrentUsername}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleDeleteGroup(w http.ResponseWriter, id string) {
+	for i, group := range s.groups {
+		if stringValue(group["id"]) == id {
+			s.groups = append(s.groups[:i], s.groups[i+1:]...)
+			delete(s.groupMembers, id)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("group %s not found", id))
+}
+
+func (s *Server) handleGetGroupMembers(w http.ResponseWriter, r *http.Request, id string) {
+	if s.findRecord(s.groups, "id", id) == nil {
+		writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("group %s not found", id))
+		return
+	}
+	var members []map[string]interface{}
+	for _, user := range s.users {
+		if containsString(s.groupMembers[id], stringValue(user["id"])) {
+			members = append(members, s.userWithGroupCount(user))
+		}
+	}
+	writeJSON(w, http.StatusOK, page(filterRecords(members, r.URL.Query().Get("filter")), r))
+}
+
+func (s *Server) handleRemoveGroupMembers(w http.ResponseWriter, r *http.Request, id string) {
+	var request struct {
+		Users []string `json:"users"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	if s.findRecord(s.groups, "id", id) == nil {
+		writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("group %s not found", id))
+		return
+	}
+	for _, user := range request.Users {
+		s.groupMembers[id] = removeString(s.groupMembers[id], user)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleUpsertToken creates an API token, or updates the one with the given ID.
+// Created tokens get a client ID and an access token, which is only ever returned on creation.
+func (s *Server) handleUpsertToken(w http.ResponseWriter, r *http.Request, id string) {
+	var request struct {
+		DisplayName           *string  `json:"displayName"`
+		Description           *string  `json:"description"`
+		PersonaQualifiedNames []string `json:"personaQualifiedNames"`
+		ValiditySeconds       *int64   `json:"validitySeconds"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	var token map[string]interface{}
+	if id == "" {
+		if request.DisplayName == nil || *request.DisplayName == "" {
+			writeHeraclesError(w, http.StatusBadRequest, "displayName is required")
+			return
+		}
+		clientID := "apikey-" + newGUID()
+		token = map[string]interface{}{
+			"id":       newGUID(),
+			"clientId": clientID,
+			"attributes": map[string]interface{}{
+				"clientId":              clientID,
+				"createdAt":             strconv.FormatInt(nowMillis(), 10),
+				"createdBy":             CurrentUsername,
+				"personaQualifiedName":  []interface{}{},
+				"access.token.lifespan": "0",
+			},
+		}
+		s.tokens = append(s.tokens, token)
+	} else if token = s.findRecord(s.tokens, "id", id); token == nil {
+		writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("API token %s not found", id))
+		return
+	}
+
+	attributes := token["attributes"].(map[string]interface{})
+	if request.DisplayName != nil {
+		token["displayName"] = *request.DisplayName
+		attributes["displayName"] = *request.DisplayName
+	}
+	if request.Description != nil {
+		attributes["description"] = *request.Description
+	}
+	if request.PersonaQualifiedNames != nil {
+		personas := []interface{}{}
+		for _, persona := range request.PersonaQualifiedNames {
+			personas = append(personas, map[string]interface{}{"persona": persona})
+		}
+		attributes["personaQualifiedName"] = personas
+	}
+	if request.ValiditySeconds != nil {
+		attributes["access.token.lifespan"] = strconv.FormatInt(*request.ValiditySeconds, 10)
+	}
+
+	response := copyMap(token)
+	if id == "" {
+		response["attributes"].(map[string]interface{})["accessToken"] = "atlantest-" + newGUID()
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleDeleteToken(w http.ResponseWriter, id string) {
+	for i, token := range s.tokens {
+		if stringValue(token["id"]) == id {
+			s.tokens = append(s.tokens[:i], s.tokens[i+1:]...)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("API token %s not found", id))
+}
+
+func (s *Server) findRecord(records []map[string]interface{}, field, value string) map[string]interface{} {
+	for _, record := range records {
+		if stringValue(record[field]) == value {
+			return record
+		}
+	}
+	return nil
+}
+
+// filterRecords returns the records matching a Heracles filter, such as
+// {"email":{"$ilike":"%@example.com"}} or {"$and":[{"username":{"$eq":"jdoe"}}]}.
+// An invalid filter matches nothing, and an empty one everything.
+func filterRecords(records []map[string]interface{}, filter string) []map[string]interface{} {
+	var criteria map[string]interface{}
+	if filter != "" {
+		if err := json.Unmarshal([]byte(filter), &criteria); err != nil {
+			return []map[string]interface{}{}
+		}
+	}
+	matches := []map[string]interface{}{}
+	for _, record := range records {
+		if matchesFilter(record, criteria) {
+			matches = append(matches, copyMap(record))
+		}
+	}
+	return matches
+}
+
+func matchesFilter(record map[string]interface{}, criteria map[string]interface{}) bool {
+	for key, condition := range criteria {
+		switch key {
+		case "$and", "$or":
+			clauses, _ := condition.([]interface{})
+			matched := 0
+			for _, clause := range clauses {
+				if c, ok := clause.(map[string]interface{}); ok && matchesFilter(record, c) {
+					matched++
+				}
+			}
+			if (key == "$and" && matched != len(clauses)) || (key == "$or" && matched == 0) {
+				return false
+			}
+		default:
+			if !matchesCondition(recordValues(record, key), condition) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// recordValues returns the values of a property of a record, looking into its attributes
+// when it isn't a top-level property. Attributes of Heracles records are lists.
+func recordValues(record map[string]interface{}, key string) []interface{} {
+	value, ok := record[key]
+	if !ok {
+		if attributes, isMap := record["attributes"].(map[string]interface{}); isMap {
+			value = attributes[key]
+		}
+	}
+	return asList(value)
+}
+
+func asList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	default:
+		return []interface{}{v}
+	}
+}
+
+func matchesCondition(values []interface{}, condition interface{}) bool {
+	operators, ok := condition.(map[string]interface{})
+	if !ok {
+		operators = map[string]interface{}{"$eq": condition}
+	}
+	for operator, operand := range operators {
+		switch operator {
+		case "$ne":
+			if anyMatch(values, "$eq", operand) {
+				return false
+			}
+		case "$nin":
+			if anyMatch(values, "$in", operand) {
+				return false
+			}
+		default:
+			if !anyMatch(values, operator, operand) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func anyMatch(values []interface{}, operator string, operand interface{}) bool {
+	for _, value := range values {
+		if matchesOperator(value, operator, operand) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesOperator(value interface{}, operator string, operand interface{}) bool {
+	switch operator {
+	case "$eq":
+		return stringValue(value) == stringValue(operand)
+	case "$in":
+		list, _ := operand.([]interface{})
+		for _, item := range list {
+			if stringValue(value) == stringValue(item) {
+				return true
+			}
+		}
+		return false
+	case "$ilike":
+		return likePattern(stringValue(operand)).MatchString(stringValue(value))
+	}
+	return false
+}
+
+// likePattern converts a SQL LIKE pattern into a case-insensitive regular expression.
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// page applies the sort, offset and limit query parameters to the records,
+// returning them in the structure of Heracles list responses.
+func page(records []map[string]interface{}, r *http.Request) map[string]interface{} {
+	query := r.URL.Query()
+	if field := query.Get("sort"); field != "" {
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+		sort.SliceStable(records, func(i, j int) bool {
+			a, b := stringValue(firstValue(recordValues(records[i], field))), stringValue(firstValue(recordValues(records[j], field)))
+			return (a < b) != descending && a != b
+		})
+	}
+	total := len(records)
+	if offset, err := strconv.Atoi(query.Get("offset")); err == nil && offset > 0 {
+		if offset > len(records) {
+			offset = len(records)
+		}
+		records = records[offset:]
+	}
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 && limit < len(records) {
+		records = records[:limit]
+	}
+	return map[string]interface{}{
+		"totalRecord":  total,
+		"filterRecord": total,
+		"records":      records,
+	}
+}
+
+func firstValue(values []interface{}) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
+
+func firstAttribute(record map[string]interface{}, name string) string {
+	attributes, _ := record["attributes"].(map[string]interface{})
+	return stringValue(firstValue(asList(attributes[name])))
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	result := values[:0]
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
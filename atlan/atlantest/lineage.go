@@ -0,0 +1,143 @@
+package atlantest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Directions of the lineage list API.
+const (
+	directionUpstream   = "INPUT"
+	directionDownstream = "OUTPUT"
+	directionBoth       = "BOTH"
+)
+
+// lineageEntity is an entity found by traversing lineage, with the number of hops to reach it.
+type lineageEntity struct {
+	entity map[string]interface{}
+	depth  int
+}
+
+// handleLineageList returns the requested page of the lineage of an entity, traversed through
+// the stored entities that have inputs or outputs (processes).
+func (s *Server) handleLineageList(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Guid                   string   `json:"guid"`
+		Size                   int      `json:"size"`
+		From                   int      `json:"from"`
+		Depth                  int      `json:"depth"`
+		Direction              string   `json:"direction"`
+		Attributes             []string `json:"attributes"`
+		RelationAttributes     []string `json:"relationAttributes"`
+		ExcludeClassifications bool     `json:"excludeClassifications"`
+		AllowDeletedProcesses  bool     `json:"allowDeletedProcesses"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	if _, ok := s.entities[request.Guid]; !ok {
+		writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-005", fmt.Sprintf("Given instance guid %s is invalid/not found", request.Guid))
+		return
+	}
+
+	visited := map[string]bool{request.Guid: true}
+	var found []lineageEntity
+	switch request.Direction {
+	case directionUpstream:
+		found = s.traverseLineage(request.Guid, true, request.Depth, request.AllowDeletedProcesses, visited)
+	case directionDownstream:
+		found = s.traverseLineage(request.Guid, false, request.Depth, request.AllowDeletedProcesses, visited)
+	case directionBoth:
+		found = s.traverseLineage(request.Guid, true, request.Depth, request.AllowDeletedProcesses, visited)
+		found = append(found, s.traverseLineage(request.Guid, false, request.Depth, request.AllowDeletedProcesses, visited)...)
+	default:
+		writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-001", fmt.Sprintf("invalid lineage direction %q", request.Direction))
+		return
+	}
+
+	entities := []interface{}{}
+	for i := request.From; i < len(found) && i < request.From+request.Size; i++ {
+		result := searchResult(found[i].entity, append(request.Attributes, request.RelationAttributes...), request.ExcludeClassifications)
+		result["depth"] = found[i].depth
+		entities = append(entities, result)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"entities":         entities,
+		"hasMore":          request.From+len(entities) < len(found),
+		"entityCount":      len(entities),
+		"searchParameters": toJSONObject(request),
+	})
+}
+
+// traverseLineage returns the processes and assets reached from the entity with the given GUID,
+// breadth first, within depth hops through processes. Each process is followed by the assets it
+// leads to that weren't visited yet.
+func (s *Server) traverseLineage(guid string, upstream bool, depth int, allowDeletedProcesses bool, visited map[string]bool) []lineageEntity {
+	var found []lineageEntity
+	current := map[string]bool{guid: true}
+	for hop := 1; hop <= depth && len(current) > 0; hop++ {
+		next := make(map[string]bool)
+		for _, processGUID := range s.entityOrder {
+			process := s.entities[processGUID]
+			if visited[processGUID] || (process["status"] != statusActive && !allowDeletedProcesses) {
+				continue
+			}
+			from, to := s.referencedGUIDs(process, "inputs"), s.referencedGUIDs(process, "outputs")
+			if upstream {
+				from, to = to, from
+			}
+			if !containsAny(current, from) {
+				continue
+			}
+			visited[processGUID] = true
+			found = append(found, lineageEntity{process, hop})
+			for _, assetGUID := range to {
+				if !visited[assetGUID] {
+					visited[assetGUID] = true
+					next[assetGUID] = true
+					found = append(found, lineageEntity{s.entities[assetGUID], hop})
+				}
+			}
+		}
+		current = next
+	}
+	return found
+}
+
+// referencedGUIDs returns the GUIDs of the stored entities referenced by an attribute of the
+// entity, by GUID or by unique attributes.
+func (s *Server) referencedGUIDs(entity map[string]interface{}, attribute string) []string {
+	refs, _ := entity["attributes"].(map[string]interface{})[attribute].([]interface{})
+	var guids []string
+	for _, ref := range refs {
+		if referenced := s.findReferenced(ref); referenced != nil {
+			guids = append(guids, stringValue(referenced["guid"]))
+		}
+	}
+	return guids
+}
+
+// findReferenced returns the stored entity that a reference points to, or nil if there is none.
+func (s *Server) findReferenced(ref interface{}) map[string]interface{} {
+	r, ok := ref.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if entity, ok := s.entities[stringValue(r["guid"])]; ok {
+		return entity
+	}
+	uniqueAttributes, _ := r["uniqueAttributes"].(map[string]interface{})
+	if qualifiedName := stringValue(uniqueAttributes["qualifiedName"]); qualifiedName != "" {
+		return s.findByQualifiedName(stringValue(r["typeName"]), qualifiedName)
+	}
+	return nil
+}
+
+func containsAny(set map[string]bool, values []string) bool {
+	for _, value := range values {
+		if set[value] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,656 @@
+package atlantest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// searchFields maps the internal fields of the search index to the properties of stored entities.
+var searchFields = map[string]string{
+	"__typeName":              "typeName",
+	"__guid":                  "guid",
+	"__state":                 "status",
+	"__createdBy":             "createdBy",
+	"__modifiedBy":            "updatedBy",
+	"__timestamp":             "createTime",
+	"__modificationTimestamp": "updateTime",
+}
+
+// handleIndexSearch evaluates the query and post_filter of the DSL over the stored entities,
+// and returns the requested page of matches.
+func (s *Server) handleIndexSearch(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Attributes             []string `json:"attributes"`
+		RelationAttributes     []string `json:"relationAttributes"`
+		ExcludeClassifications bool     `json:"excludeClassifications"`
+		Dsl                    struct {
+			From           int                      `json:"from"`
+			Size           int                      `json:"size"`
+			Query          map[string]interface{}   `json:"query"`
+			PostFilter     map[string]interface{}   `json:"post_filter"`
+			Sort           []map[string]interface{} `json:"sort"`
+			SearchAfter    []interface{}            `json:"search_after"`
+			TrackTotalHits bool                     `json:"track_total_hits"`
+		} `json:"dsl"`
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	dsl := request.Dsl
+
+	var matches []map[string]interface{}
+	for _, guid := range s.entityOrder {
+		entity := s.entities[guid]
+		matched, err := evaluate(entity, dsl.Query)
+		if err == nil && matched {
+			// The SDK may send a post filter that isn't valid DSL, which Atlas ignores
+			if filtered, err := evaluate(entity, dsl.PostFilter); err == nil {
+				matched = filtered
+			}
+		}
+		if err != nil {
+			writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-08A", "invalid search query: "+err.Error())
+			return
+		}
+		if matched {
+			matches = append(matches, entity)
+		}
+	}
+	keys, err := parseSort(dsl.Sort)
+	if err != nil {
+		writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-08A", "invalid sort: "+err.Error())
+		return
+	}
+	sortEntities(matches, keys)
+	count := len(matches)
+	if dsl.SearchAfter != nil {
+		if matches, err = searchAfter(matches, keys, dsl.SearchAfter); err != nil {
+			writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-08A", "invalid search_after: "+err.Error())
+			return
+		}
+	}
+
+	entities := []interface{}{}
+	for i := dsl.From; i < len(matches) && i < dsl.From+dsl.Size; i++ {
+		entities = append(entities, searchResult(matches[i], append(request.Attributes, request.RelationAttributes...), request.ExcludeClassifications))
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"queryType": "INDEX",
+		"searchParameters": map[string]interface{}{
+			"attributes":             request.Attributes,
+			"excludeClassifications": request.ExcludeClassifications,
+			"dsl": map[string]interface{}{
+				"from":             dsl.From,
+				"size":             dsl.Size,
+				"query":            dsl.Query,
+				"sort":             dsl.Sort,
+				"search_after":     dsl.SearchAfter,
+				"track_total_hits": dsl.TrackTotalHits,
+			},
+		},
+		"approximateCount": count,
+		"entities":         entities,
+	})
+}
+
+// searchResult returns the entity as included in search results: its header,
+// with only the name, qualified name and requested attributes.
+func searchResult(entity map[string]interface{}, attributes []string, excludeClassifications bool) map[string]interface{} {
+	result := copyMap(entity)
+	stored := result["attributes"].(map[string]interface{})
+	relationships := result["relationshipAttributes"].(map[string]interface{})
+	delete(result, "relationshipAttributes")
+	delete(result, "businessAttributes")
+
+	projected := map[string]interface{}{
+		"name":          stored["name"],
+		"qualifiedName": stored["qualifiedName"],
+	}
+	for _, attribute := range attributes {
+		if value, ok := stored[attribute]; ok {
+			projected[attribute] = value
+		} else if value, ok := relationships[attribute]; ok {
+			projected[attribute] = value
+		} else if set, name, ok := strings.Cut(attribute, "."); ok {
+			// Custom metadata is requested as "<set>.<attribute>"
+			if values, ok := entity["businessAttributes"].(map[string]interface{})[set].(map[string]interface{}); ok {
+				if value, ok := values[name]; ok {
+					projected[attribute] = value
+				}
+			}
+		}
+	}
+	result["attributes"] = projected
+	result["displayText"] = stored["name"]
+	result["classificationNames"] = classificationNames(entity)
+	if excludeClassifications {
+		delete(result, "classifications")
+	}
+	return result
+}
+
+// evaluate reports whether the entity matches the query. A nil query matches everything.
+func evaluate(entity map[string]interface{}, query map[string]interface{}) (bool, error) {
+	if len(query) == 0 {
+		return true, nil
+	}
+	if len(query) != 1 {
+		return false, fmt.Errorf("a query must have exactly one type, got %d", len(query))
+	}
+	for kind, body := range query {
+		return evaluateQuery(entity, kind, body)
+	}
+	return false, nil
+}
+
+func evaluateQuery(entity map[string]interface{}, kind string, body interface{}) (bool, error) {
+	clause, ok := body.(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("%s query must be an object", kind)
+	}
+	switch kind {
+	case "match_all":
+		return true, nil
+	case "match_none":
+		return false, nil
+	case "bool":
+		return evaluateBool(entity, clause)
+	case "exists":
+		return len(fieldValues(entity, stringValue(clause["field"]))) > 0, nil
+	case "nested":
+		// Nested documents are embedded in the entity, so their fields resolve as dotted paths
+		return evaluateSubquery(entity, clause["query"])
+	case "constant_score":
+		return evaluateSubquery(entity, clause["filter"])
+	case "function_score":
+		return evaluateSubquery(entity, clause["query"])
+	case "dis_max":
+		queries, _ := clause["queries"].([]interface{})
+		for _, q := range queries {
+			if matched, err := evaluateSubquery(entity, q); err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	case "terms":
+		for field, values := range clause {
+			if field == "boost" {
+				continue
+			}
+			list, ok := values.([]interface{})
+			if !ok {
+				return false, fmt.Errorf("terms query on %s must have a list of values", field)
+			}
+			for _, value := range list {
+				if anyValue(entity, field, func(v interface{}) bool { return equalValues(v, value, false) }) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return false, fmt.Errorf("terms query without a field")
+	case "term", "prefix", "wildcard", "regexp", "fuzzy", "match", "range":
+		field, params, err := fieldClause(kind, clause)
+		if err != nil {
+			return false, err
+		}
+		return evaluateFieldQuery(entity, kind, field, params)
+	default:
+		return false, fmt.Errorf("unsupported query type %q", kind)
+	}
+}
+
+// evaluateSubquery evaluates a query nested in another; a missing query matches everything.
+func evaluateSubquery(entity map[string]interface{}, query interface{}) (bool, error) {
+	if query == nil {
+		return true, nil
+	}
+	q, ok := query.(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("query must be an object")
+	}
+	return evaluate(entity, q)
+}
+
+func evaluateBool(entity map[string]interface{}, clause map[string]interface{}) (bool, error) {
+	occurrences := make(map[string][]interface{})
+	for _, occur := range []string{"must", "filter", "should", "must_not"} {
+		switch queries := clause[occur].(type) {
+		case nil:
+		case []interface{}:
+			occurrences[occur] = queries
+		case map[string]interface{}:
+			occurrences[occur] = []interface{}{queries}
+		default:
+			return false, fmt.Errorf("bool %s must be a query or a list of queries", occur)
+		}
+	}
+
+	for _, occur := range []string{"must", "filter"} {
+		for _, q := range occurrences[occur] {
+			if matched, err := evaluateSubquery(entity, q); err != nil || !matched {
+				return false, err
+			}
+		}
+	}
+	for _, q := range occurrences["must_not"] {
+		if matched, err := evaluateSubquery(entity, q); err != nil || matched {
+			return false, err
+		}
+	}
+
+	should := occurrences["should"]
+	minimum := 0
+	if value, ok := clause["minimum_should_match"]; ok {
+		minimum = intValue(value)
+	} else if len(should) > 0 && len(occurrences["must"]) == 0 && len(occurrences["filter"]) == 0 {
+		minimum = 1
+	}
+	matched := 0
+	for _, q := range should {
+		ok, err := evaluateSubquery(entity, q)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			matched++
+		}
+	}
+	return matched >= minimum, nil
+}
+
+// fieldClause extracts the field and parameters of a query on a single field, which are
+// given either in full ({"field": {"value": v}}) or in short ({"field": v}) form.
+func fieldClause(kind string, clause map[string]interface{}) (string, map[string]interface{}, error) {
+	for field, value := range clause {
+		if field == "boost" {
+			continue
+		}
+		if params, ok := value.(map[string]interface{}); ok {
+			return field, params, nil
+		}
+		key := "value"
+		if kind == "match" {
+			key = "query"
+		}
+		return field, map[string]interface{}{key: value}, nil
+	}
+	return "", nil, fmt.Errorf("%s query without a field", kind)
+}
+
+func evaluateFieldQuery(entity map[string]interface{}, kind, field string, params map[string]interface{}) (bool, error) {
+	caseInsensitive, _ := params["case_insensitive"].(bool)
+	switch kind {
+	case "term":
+		value := params["value"]
+		return anyValue(entity, field, func(v interface{}) bool { return equalValues(v, value, caseInsensitive) }), nil
+	case "prefix":
+		prefix := stringValue(params["value"])
+		return anyValue(entity, field, func(v interface{}) bool {
+			if caseInsensitive {
+				return strings.HasPrefix(strings.ToLower(stringValue(v)), strings.ToLower(prefix))
+			}
+			return strings.HasPrefix(stringValue(v), prefix)
+		}), nil
+	case "wildcard", "regexp":
+		pattern := stringValue(params["value"])
+		if kind == "wildcard" {
+			pattern = wildcardToRegexp(pattern)
+		}
+		if caseInsensitive {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return false, fmt.Errorf("invalid %s on %s: %w", kind, field, err)
+		}
+		return anyValue(entity, field, func(v interface{}) bool { return re.MatchString(stringValue(v)) }), nil
+	case "fuzzy":
+		value := stringValue(params["value"])
+		maxEdits := fuzziness(params["fuzziness"], value)
+		return anyValue(entity, field, func(v interface{}) bool { return editDistance(stringValue(v), value) <= maxEdits }), nil
+	case "match":
+		tokens := tokenize(stringValue(params["query"]))
+		requireAll := strings.EqualFold(stringValue(params["operator"]), "and")
+		return anyValue(entity, field, func(v interface{}) bool {
+			valueTokens := make(map[string]bool)
+			for _, token := range tokenize(stringValue(v)) {
+				valueTokens[token] = true
+			}
+			found := 0
+			for _, token := range tokens {
+				if valueTokens[token] {
+					found++
+				}
+			}
+			if requireAll {
+				return len(tokens) > 0 && found == len(tokens)
+			}
+			return found > 0
+		}), nil
+	case "range":
+		return anyValue(entity, field, func(v interface{}) bool {
+			for op, bound := range params {
+				cmp, ok := compareValues(v, bound)
+				if !ok {
+					if op == "gt" || op == "gte" || op == "lt" || op == "lte" {
+						return false
+					}
+					continue
+				}
+				switch op {
+				case "gt":
+					if cmp <= 0 {
+						return false
+					}
+				case "gte":
+					if cmp < 0 {
+						return false
+					}
+				case "lt":
+					if cmp >= 0 {
+						return false
+					}
+				case "lte":
+					if cmp > 0 {
+						return false
+					}
+				}
+			}
+			return true
+		}), nil
+	}
+	return false, fmt.Errorf("unsupported query type %q", kind)
+}
+
+// fieldValues returns the values of a search index field on the entity, flattening lists.
+func fieldValues(entity map[string]interface{}, field string) []interface{} {
+	for _, suffix := range []string{".keyword", ".text", ".stemmed"} {
+		field = strings.TrimSuffix(field, suffix)
+	}
+
+	var value interface{}
+	switch field {
+	case "__superTypeNames":
+		value = []interface{}{entity["typeName"], "Asset", "Referenceable"}
+	case "__traitNames", "__classificationNames", "__classificationsText":
+		names := []interface{}{}
+		for _, name := range classificationNames(entity) {
+			names = append(names, name)
+		}
+		value = names
+	default:
+		if property, ok := searchFields[field]; ok {
+			value = entity[property]
+		} else if v, ok := lookupPath(entity["attributes"], field); ok {
+			value = v
+		} else if v, ok := lookupPath(entity["relationshipAttributes"], field); ok {
+			value = v
+		} else {
+			value, _ = lookupPath(entity, field)
+		}
+	}
+
+	var values []interface{}
+	var flatten func(v interface{})
+	flatten = func(v interface{}) {
+		switch v := v.(type) {
+		case nil:
+		case []interface{}:
+			for _, item := range v {
+				flatten(item)
+			}
+		default:
+			values = append(values, v)
+		}
+	}
+	flatten(value)
+	return values
+}
+
+// lookupPath resolves a (possibly dotted) field within a decoded JSON value.
+func lookupPath(value interface{}, field string) (interface{}, bool) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	if v, ok := object[field]; ok {
+		return v, true
+	}
+	head, rest, ok := strings.Cut(field, ".")
+	if !ok {
+		return nil, false
+	}
+	switch child := object[head].(type) {
+	case map[string]interface{}:
+		return lookupPath(child, rest)
+	case []interface{}:
+		var values []interface{}
+		for _, item := range child {
+			if v, ok := lookupPath(item, rest); ok {
+				values = append(values, v)
+			}
+		}
+		return values, len(values) > 0
+	}
+	return nil, false
+}
+
+func anyValue(entity map[string]interface{}, field string, predicate func(interface{}) bool) bool {
+	for _, value := range fieldValues(entity, field) {
+		if predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalValues(a, b interface{}, caseInsensitive bool) bool {
+	if cmp, ok := compareNumbers(a, b); ok {
+		return cmp == 0
+	}
+	if caseInsensitive {
+		return strings.EqualFold(stringValue(a), stringValue(b))
+	}
+	return stringValue(a) == stringValue(b)
+}
+
+// compareValues compares two values numerically if they are both numbers, or as strings otherwise.
+func compareValues(a, b interface{}) (int, bool) {
+	if cmp, ok := compareNumbers(a, b); ok {
+		return cmp, true
+	}
+	if _, isString := b.(string); !isString {
+		return 0, false
+	}
+	return strings.Compare(stringValue(a), stringValue(b)), true
+}
+
+func compareNumbers(a, b interface{}) (int, bool) {
+	x, ok := toFloat(a)
+	if !ok {
+		return 0, false
+	}
+	y, ok := toFloat(b)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	}
+	return 0, true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func wildcardToRegexp(pattern string) string {
+	var b strings.Builder
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// tokenize splits text into lower-cased words, roughly like the standard analyzer.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// fuzziness returns the maximum edit distance of a fuzzy query, resolving AUTO by the length of the term.
+func fuzziness(value interface{}, term string) int {
+	if value != nil && !strings.EqualFold(stringValue(value), "AUTO") {
+		if n, err := strconv.Atoi(stringValue(value)); err == nil {
+			return n
+		}
+	}
+	switch n := len([]rune(term)); {
+	case n < 3:
+		return 0
+	case n < 6:
+		return 1
+	}
+	return 2
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	previous := make([]int, len(y)+1)
+	current := make([]int, len(y)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		current[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(y)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// sortKey is a field to sort search results on, in ascending or descending order.
+type sortKey struct {
+	field      string
+	descending bool
+}
+
+// parseSort returns the sort keys of the sort clauses of the DSL.
+func parseSort(clauses []map[string]interface{}) ([]sortKey, error) {
+	var keys []sortKey
+	for _, clause := range clauses {
+		for field, spec := range clause {
+			order := spec
+			if params, ok := spec.(map[string]interface{}); ok {
+				order = params["order"]
+			}
+			switch strings.ToLower(stringValue(order)) {
+			case "", "asc":
+				keys = append(keys, sortKey{field: field})
+			case "desc":
+				keys = append(keys, sortKey{field: field, descending: true})
+			default:
+				return nil, fmt.Errorf("invalid order %v for %s", order, field)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// sortEntities sorts the entities by the sort keys, keeping insertion order
+// between equal entities. Entities without a value sort last.
+func sortEntities(entities []map[string]interface{}, keys []sortKey) {
+	sort.SliceStable(entities, func(i, j int) bool {
+		for _, key := range keys {
+			if cmp := compareSortValues(fieldValues(entities[i], key.field), fieldValues(entities[j], key.field), key); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+}
+
+// searchAfter returns the sorted entities that come after the given sort values, like the search_after of the DSL.
+func searchAfter(entities []map[string]interface{}, keys []sortKey, after []interface{}) ([]map[string]interface{}, error) {
+	if len(after) != len(keys) {
+		return nil, fmt.Errorf("search_after has %d values, but the sort has %d fields", len(after), len(keys))
+	}
+	for i, entity := range entities {
+		for k, key := range keys {
+			cmp := compareSortValues(fieldValues(entity, key.field), []interface{}{after[k]}, key)
+			if cmp > 0 {
+				return entities[i:], nil
+			}
+			if cmp < 0 {
+				break
+			}
+		}
+	}
+	return nil, nil
+}
+
+// compareSortValues compares the values of two entities for a sort key, in the order of the key.
+func compareSortValues(a, b []interface{}, key sortKey) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	cmp, ok := compareValues(a[0], b[0])
+	if !ok {
+		cmp = strings.Compare(stringValue(a[0]), stringValue(b[0]))
+	}
+	if key.descending {
+		return -cmp
+	}
+	return cmp
+}
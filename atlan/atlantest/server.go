@@ -0,0 +1,406 @@
+// Package atlantest provides an in-memory fake of the Atlan APIs, for unit tests
+// of code built on the SDK that should not depend on a live tenant.
+//
+// A Server emulates the core Atlas endpoints (entities and their custom metadata,
+// index search, lineage and typedefs)
+// and Heracles endpoints (users, groups, roles and API tokens) over an in-memory store:
+//
+//	server := atlantest.NewServer()
+//	defer server.Close()
+//	client, _ := assets.NewClient(server.URL, "api_key")
+//
+// The fake aims to be faithful for the common paths, not complete: index search supports
+// the queries built by model.Query and FluentSearch (bool, term, terms, exists, prefix,
+// wildcard, regexp, fuzzy, match, range, nested and match_all), with sorting and paging
+// (from and size, or search_after), but not aggregations or scoring. Lineage is traversed
+// through the inputs and outputs of the stored processes, without filters.
+//
+// Every request is recorded, so that tests can check what was sent with Received. Failures can be
+// simulated with FailRequests, and validation errors with RejectEntities.
+package atlantest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/atlanhq/atlan-go/atlan/assets"
+)
+
+const (
+	atlasPrefix    = "/api/meta/"
+	heraclesPrefix = "/api/service/"
+
+	// CurrentUsername is the username of the user the fake server considers to be making every request.
+	CurrentUsername = "atlantest"
+)
+
+// Server is an httptest.Server emulating a single Atlan tenant in memory.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mutex sync.Mutex
+	// Entities keyed by GUID, with their insertion order
+	entities     map[string]map[string]interface{}
+	entityOrder  []string
+	typeDefs     map[string]map[string]map[string]interface{}
+	users        []map[string]interface{}
+	groups       []map[string]interface{}
+	roles        []map[string]interface{}
+	tokens       []map[string]interface{}
+	groupMembers map[string][]string
+	requests     []Request
+	// Statuses to respond with, keyed by method and path
+	failures     map[string]int
+	rejectEntity func(entity map[string]interface{}) error
+}
+
+// Request is a request received by the server, recorded so that tests can check what the SDK sent.
+type Request struct {
+	Method string
+	// Path of the request, without any trailing slash.
+	Path  string
+	Query url.Values
+	Body  []byte
+}
+
+// DecodeBody decodes the JSON body of the request into v.
+func (r Request) DecodeBody(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// NewServer starts a fake Atlan tenant with the default workspace roles
+// ($admin, $member and $guest) and a current admin user.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := NewUnstartedServer()
+	s.Start()
+	return s
+}
+
+// NewUnstartedServer returns a fake Atlan tenant that is not yet listening,
+// for example to switch it to TLS with StartTLS.
+func NewUnstartedServer() *Server {
+	s := &Server{
+		entities:     make(map[string]map[string]interface{}),
+		typeDefs:     make(map[string]map[string]map[string]interface{}),
+		groupMembers: make(map[string][]string),
+		failures:     make(map[string]int),
+	}
+	for _, role := range []string{"$admin", "$member", "$guest"} {
+		s.roles = append(s.roles, map[string]interface{}{
+			"id":          newGUID(),
+			"name":        role,
+			"description": "Workspace role " + role,
+			"level":       "workspace",
+		})
+	}
+	s.users = append(s.users, map[string]interface{}{
+		"id":            newGUID(),
+		"username":      CurrentUsername,
+		"email":         CurrentUsername + "@example.com",
+		"firstName":     "Atlan",
+		"lastName":      "Test",
+		"enabled":       true,
+		"emailVerified": true,
+		"workspaceRole": "$admin",
+	})
+	// The SDK expects at least one struct definition to be present on any tenant
+	s.typeDefs[categoryStruct] = map[string]map[string]interface{}{
+		"SourceTagAttachment": {
+			"category":      categoryStruct,
+			"guid":          newGUID(),
+			"name":          "SourceTagAttachment",
+			"attributeDefs": []interface{}{},
+		},
+	}
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Requests returns the method and path of every request received so far, such as "POST /api/meta/entity/bulk".
+func (s *Server) Requests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	requests := make([]string, len(s.requests))
+	for i, request := range s.requests {
+		requests[i] = request.Method + " " + request.Path
+	}
+	return requests
+}
+
+// Received returns the requests received so far with the given method and path, such as
+// "POST /api/meta/entity/bulk", with their query and body.
+func (s *Server) Received(request string) []Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var received []Request
+	for _, r := range s.requests {
+		if r.Method+" "+r.Path == request {
+			received = append(received, r)
+		}
+	}
+	return received
+}
+
+// FailRequests makes the server respond to every request with the given method and path, such as
+// "POST /api/meta/entity/bulk", with an error of the given status instead of handling it.
+// A status of 0 handles them again.
+func (s *Server) FailRequests(request string, status int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if status == 0 {
+		delete(s.failures, request)
+		return
+	}
+	s.failures[request] = status
+}
+
+// serveHTTP routes the request to the Atlas or Heracles emulation.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-001", "unreadable request body: "+err.Error())
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	s.requests = append(s.requests, Request{Method: r.Method, Path: path, Query: r.URL.Query(), Body: body})
+	if requestID := r.Header.Get(assets.RequestIDHeader); requestID != "" {
+		w.Header().Set(assets.RequestIDHeader, requestID)
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeHeraclesError(w, http.StatusUnauthorized, "Unauthorized: missing bearer token")
+		return
+	}
+	if status := s.failures[r.Method+" "+path]; status != 0 {
+		if strings.HasPrefix(path+"/", heraclesPrefix) {
+			writeHeraclesError(w, status, http.StatusText(status))
+		} else {
+			writeAtlasError(w, status, fmt.Sprintf("ATLAS-%d-00-000", status), http.StatusText(status))
+		}
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(path+"/", atlasPrefix):
+		s.serveAtlas(w, r, strings.Split(strings.TrimPrefix(path, atlasPrefix), "/"))
+	case strings.HasPrefix(path+"/", heraclesPrefix):
+		s.serveHeracles(w, r, strings.Split(strings.TrimPrefix(path, heraclesPrefix), "/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveAtlas handles the metastore endpoints, under /api/meta/.
+func (s *Server) serveAtlas(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case match(segments, "entity", "bulk"):
+		switch r.Method {
+		case http.MethodPost:
+			s.handleBulkEntities(w, r)
+			return
+		case http.MethodDelete:
+			s.handleDeleteEntities(w, r)
+			return
+		}
+	case match(segments, "entity", "guid", "*") && r.Method == http.MethodGet:
+		s.handleGetEntityByGUID(w, r, segments[2])
+		return
+	case match(segments, "entity", "guid", "*", "businessmetadata", "*"):
+		switch r.Method {
+		case http.MethodPost:
+			s.handleSaveBusinessMetadata(w, r, segments[2], segments[4], false)
+			return
+		case http.MethodDelete:
+			s.handleSaveBusinessMetadata(w, r, segments[2], segments[4], true)
+			return
+		}
+	case match(segments, "entity", "uniqueAttribute", "type", "*") && r.Method == http.MethodGet:
+		s.handleGetEntityByUniqueAttribute(w, r, segments[3])
+		return
+	case match(segments, "search", "indexsearch") && r.Method == http.MethodPost:
+		s.handleIndexSearch(w, r)
+		return
+	case match(segments, "lineage", "list") && r.Method == http.MethodPost:
+		s.handleLineageList(w, r)
+		return
+	case match(segments, "types", "typedefs"):
+		switch r.Method {
+		case http.MethodGet:
+			s.handleGetTypeDefs(w, r)
+			return
+		case http.MethodPost, http.MethodPut:
+			s.handleUpsertTypeDefs(w, r)
+			return
+		case http.MethodDelete:
+			s.handleDeleteTypeDefs(w, r)
+			return
+		}
+	case match(segments, "types", "typedef", "name", "*"):
+		switch r.Method {
+		case http.MethodGet:
+			s.handleGetTypeDef(w, "name", segments[3])
+			return
+		case http.MethodDelete:
+			s.handleDeleteTypeDef(w, segments[3])
+			return
+		}
+	case match(segments, "types", "typedef", "guid", "*") && r.Method == http.MethodGet:
+		s.handleGetTypeDef(w, "guid", segments[3])
+		return
+	}
+	writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-001", fmt.Sprintf("%s %s is not supported by atlantest", r.Method, r.URL.Path))
+}
+
+// serveHeracles handles the service endpoints, under /api/service/.
+func (s *Server) serveHeracles(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case match(segments, "users"):
+		switch r.Method {
+		case http.MethodGet:
+			s.handleGetUsers(w, r)
+			return
+		case http.MethodPost:
+			s.handleCreateUsers(w, r)
+			return
+		}
+	case match(segments, "users", "current") && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.userWithGroupCount(s.users[0]))
+		return
+	case match(segments, "users", "*", "delete") && r.Method == http.MethodPost:
+		s.handleDeleteUser(w, segments[1])
+		return
+	case match(segments, "users", "*", "groups"):
+		switch r.Method {
+		case http.MethodGet:
+			s.handleGetUserGroups(w, r, segments[1])
+			return
+		case http.MethodPost:
+			s.handleAddUserToGroups(w, r, segments[1])
+			return
+		}
+	case match(segments, "users", "*", "roles", "update") && r.Method == http.MethodPost:
+		s.handleChangeUserRole(w, r, segments[1])
+		return
+	case match(segments, "roles") && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, page(filterRecords(s.roles, r.URL.Query().Get("filter")), r))
+		return
+	case match(segments, "groups"):
+		switch r.Method {
+		case http.MethodGet:
+			s.handleGetGroups(w, r)
+			return
+		case http.MethodPost:
+			s.handleCreateGroup(w, r)
+			return
+		}
+	case match(segments, "groups", "*") && r.Method == http.MethodPost:
+		s.handleUpdateGroup(w, r, segments[1])
+		return
+	case match(segments, "groups", "*", "delete") && r.Method == http.MethodPost:
+		s.handleDeleteGroup(w, segments[1])
+		return
+	case match(segments, "groups", "*", "members") && r.Method == http.MethodGet:
+		s.handleGetGroupMembers(w, r, segments[1])
+		return
+	case match(segments, "groups", "*", "members", "remove") && r.Method == http.MethodPost:
+		s.handleRemoveGroupMembers(w, r, segments[1])
+		return
+	case match(segments, "apikeys"):
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, page(filterRecords(s.tokens, r.URL.Query().Get("filter")), r))
+			return
+		case http.MethodPost:
+			s.handleUpsertToken(w, r, "")
+			return
+		}
+	case match(segments, "apikeys", "*"):
+		switch r.Method {
+		case http.MethodPost:
+			s.handleUpsertToken(w, r, segments[1])
+			return
+		case http.MethodDelete:
+			s.handleDeleteToken(w, segments[1])
+			return
+		}
+	}
+	writeHeraclesError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not supported by atlantest", r.Method, r.URL.Path))
+}
+
+// match reports whether the path segments match the pattern, where "*" matches any single segment.
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeBody decodes the JSON request body into v, writing a 400 response if it is invalid.
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		writeAtlasError(w, http.StatusBadRequest, "ATLAS-400-00-001", "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+// writeAtlasError writes an error in the format returned by the metastore.
+func writeAtlasError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errorCode":    code,
+		"errorMessage": message,
+	})
+}
+
+// writeHeraclesError writes an error in the format returned by the service endpoints.
+func writeHeraclesError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errorId": fmt.Sprintf("%d", status),
+		"message": message,
+		"causes":  []interface{}{},
+	})
+}
+
+// newGUID returns a random (version 4) UUID.
+func newGUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// nowMillis returns the current time as epoch milliseconds, as used throughout Atlan.
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,363 @@
+package atlantest_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/assets"
+	"github.com/atlanhq/atlan-go/atlan/atlantest"
+	"github.com/atlanhq/atlan-go/atlan/model"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+	"github.com/atlanhq/atlan-go/atlan/model/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schemaQualifiedName = "default/snowflake/1234567890/ANALYTICS/WIDE_WORLD"
+
+func newTestClient(t *testing.T) (*atlantest.Server, *assets.AtlanClient) {
+	t.Helper()
+	server := atlantest.NewServer()
+	t.Cleanup(server.Close)
+	client, err := assets.NewClient(server.URL, "api_key")
+	require.NoError(t, err)
+	return server, client
+}
+
+func newTable(t *testing.T, name string) *assets.Table {
+	t.Helper()
+	table := &assets.Table{}
+	require.NoError(t, table.Creator(name, schemaQualifiedName))
+	return table
+}
+
+func addTable(server *atlantest.Server, name string, rowCount int) string {
+	return server.AddEntity(map[string]interface{}{
+		"typeName": "Table",
+		"attributes": map[string]interface{}{
+			"name":          name,
+			"qualifiedName": schemaQualifiedName + "/" + name,
+			"rowCount":      rowCount,
+		},
+	})
+}
+
+func TestSaveAndRetrieve(t *testing.T) {
+	server, client := newTestClient(t)
+
+	response, err := client.Save(newTable(t, "ORDERS"))
+	require.NoError(t, err)
+	require.Len(t, response.MutatedEntities.CREATE, 1)
+	created := response.MutatedEntities.CREATE[0]
+	assert.Equal(t, "Table", created.TypeName)
+	assert.Equal(t, "ACTIVE", created.Status)
+	assert.NotNil(t, server.Entity(created.Guid))
+
+	byGUID := &assets.Table{}
+	require.NoError(t, client.GetByGuid(created.Guid, byGUID))
+	assert.Equal(t, "ORDERS", *byGUID.Name)
+	assert.Equal(t, schemaQualifiedName+"/ORDERS", *byGUID.QualifiedName)
+	assert.Equal(t, "WIDE_WORLD", *byGUID.SchemaName)
+
+	byName := &assets.Table{}
+	require.NoError(t, client.GetByQualifiedName(schemaQualifiedName+"/ORDERS", byName))
+	assert.Equal(t, created.Guid, *byName.Guid)
+
+	// Saving an asset with the same qualified name updates it, only if something changed
+	response, err = client.Save(newTable(t, "ORDERS"))
+	require.NoError(t, err)
+	assert.Empty(t, response.MutatedEntities.CREATE)
+	assert.Empty(t, response.MutatedEntities.UPDATE)
+
+	updated := newTable(t, "ORDERS")
+	updated.CertificateStatus = &atlan.CertificateStatusVerified
+	response, err = client.Save(updated)
+	require.NoError(t, err)
+	require.Len(t, response.MutatedEntities.UPDATE, 1)
+	assert.Equal(t, created.Guid, response.MutatedEntities.UPDATE[0].Guid)
+	assert.Equal(t, "VERIFIED", server.Entity(created.Guid)["attributes"].(map[string]interface{})["certificateStatus"])
+}
+
+func TestRetrieveMissingAsset(t *testing.T) {
+	_, client := newTestClient(t)
+
+	err := client.GetByGuid("missing", &assets.Table{})
+	assert.ErrorIs(t, err, assets.ErrNotFound)
+	var notFound *assets.NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "ATLAS-404-00-005", notFound.AtlanErrorCode())
+	assert.NotEmpty(t, notFound.RequestID)
+	assert.False(t, notFound.Retryable)
+
+	err = client.GetByQualifiedName(schemaQualifiedName+"/MISSING", &assets.Table{})
+	assert.ErrorIs(t, err, assets.ErrNotFound)
+}
+
+func TestDeleteAndPurge(t *testing.T) {
+	server, client := newTestClient(t)
+	orders := addTable(server, "ORDERS", 10)
+	customers := addTable(server, "CUSTOMERS", 20)
+
+	response, err := client.DeleteByGuid([]string{orders})
+	require.NoError(t, err)
+	require.Len(t, response.MutatedEntities.DELETE, 1)
+	assert.Equal(t, "DELETED", server.Entity(orders)["status"])
+
+	response, err = client.PurgeByGuid([]string{orders, customers})
+	require.NoError(t, err)
+	assert.Len(t, response.MutatedEntities.DELETE, 2)
+	assert.Nil(t, server.Entity(orders))
+	assert.Empty(t, server.Entities())
+}
+
+func TestFluentSearch(t *testing.T) {
+	server, client := newTestClient(t)
+	for i, name := range []string{"ORDERS", "CUSTOMERS", "ORDER_LINES", "PRODUCTS"} {
+		addTable(server, name, (i+1)*100)
+	}
+	server.AddEntity(map[string]interface{}{
+		"typeName":   "Column",
+		"attributes": map[string]interface{}{"name": "ID", "qualifiedName": schemaQualifiedName + "/ORDERS/ID"},
+	})
+	fields := assets.NewSearchTable()
+
+	iterator, err := client.NewFluentSearch().
+		AssetType("Table").
+		ActiveAssets().
+		Where(fields.QUALIFIED_NAME.StartsWith(schemaQualifiedName+"/ORDER", nil)).
+		Sort(assets.NAME, atlan.SortOrderAscending).
+		IncludeOnResults("rowCount").
+		Execute()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), iterator.Count())
+	page, err := iterator.CurrentPage()
+	require.NoError(t, err)
+	require.Len(t, page.Entities, 2)
+	assert.Equal(t, "ORDERS", *page.Entities[0].Name)
+	assert.Equal(t, "ORDER_LINES", *page.Entities[1].Name)
+
+	rowCount := 250.0
+	iterator, err = client.NewFluentSearch().
+		PageSizes(1).
+		AssetType("Table").
+		Where(fields.ROW_COUNT.Gt(&rowCount)).
+		Execute()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), iterator.Count())
+	pages, err := iterator.IteratePages()
+	require.NoError(t, err)
+	var names []string
+	for _, p := range pages {
+		for _, entity := range p.Entities {
+			names = append(names, *entity.Name)
+		}
+	}
+	assert.ElementsMatch(t, []string{"ORDER_LINES", "PRODUCTS"}, names)
+}
+
+func TestBatch(t *testing.T) {
+	server, client := newTestClient(t)
+	addTable(server, "ORDERS", 10)
+
+	batch := assets.NewBatch(client, 2, false, atlan.IGNORE, true)
+	for _, name := range []string{"ORDERS", "CUSTOMERS", "PRODUCTS"} {
+		table := newTable(t, name)
+		table.CertificateStatus = &atlan.CertificateStatusDraft
+		require.NoError(t, batch.Add(table))
+	}
+	_, err := batch.Flush()
+	require.NoError(t, err)
+
+	assert.Empty(t, batch.Failures())
+	assert.Len(t, batch.Created(), 2)
+	assert.Len(t, batch.Updated(), 1)
+	assert.Len(t, server.Entities(), 3)
+}
+
+func TestTypeDefs(t *testing.T) {
+	_, client := newTestClient(t)
+
+	tag := &model.AtlanTagDef{DisplayName: "PII", EntityTypes: []string{}}
+	tag.Category = atlan.AtlanTypeCategoryClassification
+	response, err := assets.NewTypeDefClient(client).Create(tag)
+	require.NoError(t, err)
+	require.Len(t, response.AtlanTagDefs, 1)
+	assert.Equal(t, "PII", response.AtlanTagDefs[0].DisplayName)
+	assert.NotEqual(t, "PII", response.AtlanTagDefs[0].Name, "Atlan tags should get an internal name")
+
+	typeDefs, err := client.GetTypeDefs(atlan.AtlanTypeCategoryClassification)
+	require.NoError(t, err)
+	require.Len(t, typeDefs.AtlanTagDefs, 1)
+	assert.NotEmpty(t, typeDefs.StructDefs)
+
+	id, err := client.AtlanTagCache().GetIDForName("PII")
+	require.NoError(t, err)
+	assert.Equal(t, response.AtlanTagDefs[0].Name, id)
+}
+
+func TestUsersGroupsAndTokens(t *testing.T) {
+	server, client := newTestClient(t)
+
+	current, err := client.UserClient.GetByUsername(atlantest.CurrentUsername)
+	require.NoError(t, err)
+	assert.Equal(t, "$admin", current.WorkspaceRole)
+
+	jdoe := server.AddUser(map[string]interface{}{"username": "jdoe", "email": "jdoe@example.com"})
+	users, err := client.UserClient.GetByEmail("example.com", 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	group := &assets.AtlanGroup{}
+	group.Alias = structs.StringPtr("Data Stewards")
+	group.Name = structs.StringPtr("data_stewards")
+	created, err := client.GroupClient.Create(group, []string{jdoe})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Group)
+	assert.True(t, created.Users[jdoe].WasSuccessful())
+
+	groups, err := client.GroupClient.GetByName("stewards", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, 1, *groups[0].UserCount)
+
+	members, err := client.GroupClient.GetMembers(created.Group, nil)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, "jdoe", *members[0].Username)
+
+	require.NoError(t, client.GroupClient.RemoveUsers(created.Group, []string{jdoe}))
+	members, err = client.GroupClient.GetMembers(created.Group, nil)
+	require.NoError(t, err)
+	assert.Empty(t, members)
+
+	token, err := client.TokenClient.Create(structs.StringPtr("ci-token"), nil, nil, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, *token.Attributes.AccessToken)
+	found, err := client.TokenClient.GetByName("ci-token")
+	require.NoError(t, err)
+	assert.Equal(t, *token.GUID, *found.GUID)
+	require.NoError(t, client.TokenClient.Purge(*token.GUID))
+	found, err = client.TokenClient.GetByName("ci-token")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+func TestRecordsRequests(t *testing.T) {
+	server, client := newTestClient(t)
+
+	_, err := client.Save(newTable(t, "ORDERS"))
+	require.NoError(t, err)
+	assert.Contains(t, server.Requests(), "POST /api/meta/entity/bulk")
+}
+
+func TestSimulatesFailures(t *testing.T) {
+	server, client := newTestClient(t)
+
+	server.RejectEntities(func(entity map[string]interface{}) error {
+		if entity["attributes"].(map[string]interface{})["name"] == "BAD" {
+			return errors.New("invalid name")
+		}
+		return nil
+	})
+	_, err := client.Save(newTable(t, "ORDERS"), newTable(t, "BAD"))
+	assert.ErrorIs(t, err, assets.ErrInvalidRequest)
+	assert.Empty(t, server.Entities(), "no entity of a rejected request is saved")
+
+	server.FailRequests("POST /api/meta/entity/bulk", http.StatusForbidden)
+	_, err = client.Save(newTable(t, "ORDERS"))
+	assert.ErrorIs(t, err, assets.ErrPermission)
+
+	server.FailRequests("POST /api/meta/entity/bulk", 0)
+	_, err = client.Save(newTable(t, "ORDERS"))
+	require.NoError(t, err)
+	assert.Len(t, server.Entities(), 1)
+}
+
+func TestResolvesPlaceholdersWithinARequest(t *testing.T) {
+	server, client := newTestClient(t)
+
+	table := &entities.Table{}
+	require.NoError(t, table.Creator("ORDERS", schemaQualifiedName))
+	table.Guid = structs.StringPtr(atlan.NextID())
+	column := &entities.Column{}
+	require.NoError(t, column.Creator("ID", "Table", *table.QualifiedName, 1))
+	column.Table = entities.RefByGuid("Table", *table.Guid)
+
+	_, err := client.Save(column)
+	assert.ErrorIs(t, err, assets.ErrNotFound, "a placeholder must be created in the same request")
+	response, err := client.Save(table, column)
+	require.NoError(t, err)
+	stored := server.Entities()
+	require.Len(t, stored, 2)
+	ref := stored[1]["relationshipAttributes"].(map[string]interface{})["table"].(map[string]interface{})
+	assert.Equal(t, response.GuidAssignments[*table.Guid], ref["guid"])
+}
+
+func collectNames(t *testing.T, iterator *assets.IndexSearchIterator) []string {
+	t.Helper()
+	assetsCh, errCh := iterator.Iter()
+	var names []string
+	for asset := range assetsCh {
+		names = append(names, *asset.Name)
+	}
+	require.NoError(t, <-errCh)
+	return names
+}
+
+func TestSearchAfterPagination(t *testing.T) {
+	server, client := newTestClient(t)
+	var expected []string
+	for i := 0; i < 25; i++ {
+		name := fmt.Sprintf("TABLE_%02d", i)
+		addTable(server, name, i)
+		expected = append(expected, name)
+	}
+
+	iterator, err := client.NewFluentSearch().
+		PageSizes(4).
+		AssetType("Table").
+		Sort(assets.NAME, atlan.SortOrderAscending).
+		Execute()
+	require.NoError(t, err)
+	assert.Equal(t, expected, collectNames(t, iterator))
+	assert.Equal(t, int64(25), iterator.Count())
+
+	// Every page after the first one is fetched from the last asset of the previous page
+	var searches int
+	for _, request := range server.Requests() {
+		if request == "POST /api/meta/search/indexsearch" {
+			searches++
+		}
+	}
+	assert.Equal(t, 7, searches)
+}
+
+func TestTimestampSlicing(t *testing.T) {
+	server, client := newTestClient(t)
+	var expected []string
+	for i := 0; i < 25; i++ {
+		name := fmt.Sprintf("TABLE_%02d", i)
+		// Several assets are created at the same time, some of them across page boundaries
+		server.AddEntity(map[string]interface{}{
+			"typeName":   "Table",
+			"createTime": 1700000000000 + int64(i/6),
+			"attributes": map[string]interface{}{"name": name, "qualifiedName": schemaQualifiedName + "/" + name},
+		})
+		expected = append(expected, name)
+	}
+
+	iterator, err := client.NewFluentSearch().
+		PageSizes(4).
+		AssetType("Table").
+		TimestampSlicing().
+		Execute()
+	require.NoError(t, err)
+
+	names := collectNames(t, iterator)
+	assert.ElementsMatch(t, expected, names)
+	assert.Len(t, names, 25, "every asset should be returned exactly once")
+	assert.Equal(t, int64(25), iterator.Count())
+}
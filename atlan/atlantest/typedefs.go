@@ -0,0 +1,228 @@
+package atlantest
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Type definition categories, and the keys under which each is listed in typedef payloads.
+const (
+	categoryEnum             = "ENUM"
+	categoryStruct           = "STRUCT"
+	categoryClassification   = "CLASSIFICATION"
+	categoryEntity           = "ENTITY"
+	categoryRelationship     = "RELATIONSHIP"
+	categoryBusinessMetadata = "BUSINESS_METADATA"
+)
+
+var typeDefKeys = []struct {
+	category string
+	key      string
+}{
+	{categoryEnum, "enumDefs"},
+	{categoryStruct, "structDefs"},
+	{categoryClassification, "classificationDefs"},
+	{categoryEntity, "entityDefs"},
+	{categoryRelationship, "relationshipDefs"},
+	{categoryBusinessMetadata, "businessMetadataDefs"},
+}
+
+// AddTypeDefs stores type definitions directly, as if they had been created through the typedef API.
+// The payload uses the Atlas JSON structure, for example a model.TypeDefResponse.
+func (s *Server) AddTypeDefs(typeDefs interface{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.upsertTypeDefs(toJSONObject(typeDefs))
+}
+
+// handleGetTypeDefs returns the type definitions of the requested categories, or all of them.
+func (s *Server) handleGetTypeDefs(w http.ResponseWriter, r *http.Request) {
+	requested := make(map[string]bool)
+	for _, category := range r.URL.Query()["type"] {
+		requested[strings.ToUpper(category)] = true
+	}
+	response := make(map[string]interface{})
+	for _, k := range typeDefKeys {
+		defs := []interface{}{}
+		if len(requested) == 0 || requested[k.category] {
+			defs = s.typeDefList(k.category)
+		}
+		response[k.key] = defs
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// typeDefList returns copies of the type definitions of a category, sorted by name.
+func (s *Server) typeDefList(category string) []interface{} {
+	defs := s.typeDefs[category]
+	names := make([]string, 0, len(defs))
+	for name := range defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		list = append(list, copyMap(defs[name]))
+	}
+	return list
+}
+
+// handleUpsertTypeDefs creates or replaces the type definitions in the request, returning them as stored.
+func (s *Server) handleUpsertTypeDefs(w http.ResponseWriter, r *http.Request) {
+	var request map[string]interface{}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.upsertTypeDefs(request))
+}
+
+func (s *Server) upsertTypeDefs(request map[string]interface{}) map[string]interface{} {
+	response := make(map[string]interface{})
+	for _, k := range typeDefKeys {
+		stored := []interface{}{}
+		defs, _ := request[k.key].([]interface{})
+		for _, def := range defs {
+			if d, ok := def.(map[string]interface{}); ok {
+				stored = append(stored, copyMap(s.upsertTypeDef(k.category, copyMap(d))))
+			}
+		}
+		response[k.key] = stored
+	}
+	return response
+}
+
+// upsertTypeDef stores a type definition. Atlan tags and custom metadata are matched on their
+// display name, and get a generated internal name (as do custom metadata attributes) like in Atlan.
+func (s *Server) upsertTypeDef(category string, def map[string]interface{}) map[string]interface{} {
+	if s.typeDefs[category] == nil {
+		s.typeDefs[category] = make(map[string]map[string]interface{})
+	}
+	defs := s.typeDefs[category]
+	hashedNames := category == categoryClassification || category == categoryBusinessMetadata
+
+	name := stringValue(def["name"])
+	existing, ok := defs[name]
+	if !ok && hashedNames {
+		displayName := stringValue(def["displayName"])
+		for _, candidate := range defs {
+			if displayName != "" && stringValue(candidate["displayName"]) == displayName {
+				existing, ok = candidate, true
+				break
+			}
+		}
+	}
+
+	now := nowMillis()
+	if ok {
+		def["name"] = existing["name"]
+		def["guid"] = existing["guid"]
+		def["createTime"] = existing["createTime"]
+		def["createdBy"] = existing["createdBy"]
+		def["version"] = intValue(existing["version"]) + 1
+	} else {
+		if hashedNames && (name == "" || name == stringValue(def["displayName"])) {
+			def["name"] = internalName()
+		}
+		def["guid"] = newGUID()
+		def["createTime"] = now
+		def["createdBy"] = CurrentUsername
+		def["version"] = 1
+	}
+	def["category"] = category
+	def["updateTime"] = now
+	def["updatedBy"] = CurrentUsername
+
+	if attributes, ok := def["attributeDefs"].([]interface{}); ok && category == categoryBusinessMetadata {
+		var previous []interface{}
+		if existing != nil {
+			previous, _ = existing["attributeDefs"].([]interface{})
+		}
+		for _, attribute := range attributes {
+			a, ok := attribute.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			attributeName := stringValue(a["name"])
+			if attributeName != "" && attributeName != stringValue(a["displayName"]) {
+				continue
+			}
+			a["name"] = internalName()
+			for _, p := range previous {
+				if prev, ok := p.(map[string]interface{}); ok && stringValue(prev["displayName"]) == stringValue(a["displayName"]) {
+					a["name"] = prev["name"]
+				}
+			}
+		}
+	}
+	if _, ok := def["attributeDefs"]; !ok {
+		def["attributeDefs"] = []interface{}{}
+	}
+
+	if ok && existing["name"] != def["name"] {
+		delete(defs, stringValue(existing["name"]))
+	}
+	defs[stringValue(def["name"])] = def
+	return def
+}
+
+// handleGetTypeDef returns the type definition with the given name or GUID.
+func (s *Server) handleGetTypeDef(w http.ResponseWriter, key, value string) {
+	if def := s.findTypeDef(key, value); def != nil {
+		writeJSON(w, http.StatusOK, def)
+		return
+	}
+	writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-007", fmt.Sprintf("Given typename %s was invalid", value))
+}
+
+func (s *Server) findTypeDef(key, value string) map[string]interface{} {
+	for _, defs := range s.typeDefs {
+		for _, def := range defs {
+			if stringValue(def[key]) == value {
+				return def
+			}
+		}
+	}
+	return nil
+}
+
+// handleDeleteTypeDef deletes the type definition with the given name.
+func (s *Server) handleDeleteTypeDef(w http.ResponseWriter, name string) {
+	def := s.findTypeDef("name", name)
+	if def == nil {
+		writeAtlasError(w, http.StatusNotFound, "ATLAS-404-00-007", fmt.Sprintf("Given typename %s was invalid", name))
+		return
+	}
+	delete(s.typeDefs[stringValue(def["category"])], name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleDeleteTypeDefs deletes every type definition listed in the request.
+func (s *Server) handleDeleteTypeDefs(w http.ResponseWriter, r *http.Request) {
+	var request map[string]interface{}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	for _, k := range typeDefKeys {
+		defs, _ := request[k.key].([]interface{})
+		for _, def := range defs {
+			if d, ok := def.(map[string]interface{}); ok {
+				delete(s.typeDefs[k.category], stringValue(d["name"]))
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// internalName returns a random name like those Atlan generates for Atlan tags and custom metadata.
+func internalName() string {
+	const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	b := make([]byte, 22)
+	rand.Read(b)
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b)
+}
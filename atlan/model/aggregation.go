@@ -0,0 +1,384 @@
+// Contains the aggregation model for the Atlas search DSL.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Aggregation is an interface that represents the base aggregation behavior.
+type Aggregation interface {
+	ToJSON() map[string]interface{}
+}
+
+// MetricType is the kind of single-value metric computed by a MetricAggregation.
+type MetricType string
+
+const (
+	MetricAvg        MetricType = "avg"
+	MetricSum        MetricType = "sum"
+	MetricMin        MetricType = "min"
+	MetricMax        MetricType = "max"
+	MetricValueCount MetricType = "value_count"
+)
+
+// TermsAggregation represents a terms (bucket) aggregation in the Atlas search DSL,
+// with one bucket for each distinct value of the field.
+type TermsAggregation struct {
+	Field           string
+	Size            *int
+	MinDocCount     *int
+	Missing         *string
+	Order           map[string]string
+	SubAggregations map[string]Aggregation
+}
+
+// CardinalityAggregation represents a cardinality aggregation in the Atlas search DSL,
+// counting the (approximate) number of distinct values of the field.
+type CardinalityAggregation struct {
+	Field              string
+	PrecisionThreshold *int
+}
+
+// DateHistogramAggregation represents a date histogram (bucket) aggregation in the Atlas search DSL,
+// with one bucket for each interval of time. Only one of CalendarInterval and FixedInterval should be set.
+type DateHistogramAggregation struct {
+	Field            string
+	CalendarInterval string
+	FixedInterval    string
+	Format           *string
+	TimeZone         *string
+	MinDocCount      *int
+	SubAggregations  map[string]Aggregation
+}
+
+// NestedAggregation represents a nested aggregation in the Atlas search DSL, running its
+// sub-aggregations over the nested documents at the given path.
+type NestedAggregation struct {
+	Path            string
+	SubAggregations map[string]Aggregation
+}
+
+// FiltersAggregation represents a filters (bucket) aggregation in the Atlas search DSL,
+// with one bucket for each named query.
+type FiltersAggregation struct {
+	Filters         map[string]Query
+	OtherBucketKey  *string
+	SubAggregations map[string]Aggregation
+}
+
+// MetricAggregation represents a single-value metric aggregation (avg, sum, min, max or
+// value_count) in the Atlas search DSL.
+type MetricAggregation struct {
+	Type    MetricType
+	Field   string
+	Missing interface{}
+}
+
+// WithSubAggregation adds a named aggregation to run within each bucket of the terms aggregation.
+func (t *TermsAggregation) WithSubAggregation(name string, aggregation Aggregation) *TermsAggregation {
+	t.SubAggregations = withSubAggregation(t.SubAggregations, name, aggregation)
+	return t
+}
+
+// ToJSON returns the JSON representation of the TermsAggregation.
+func (t *TermsAggregation) ToJSON() map[string]interface{} {
+	terms := map[string]interface{}{
+		"field": t.Field,
+	}
+	if t.Size != nil {
+		terms["size"] = *t.Size
+	}
+	if t.MinDocCount != nil {
+		terms["min_doc_count"] = *t.MinDocCount
+	}
+	if t.Missing != nil {
+		terms["missing"] = *t.Missing
+	}
+	if len(t.Order) > 0 {
+		terms["order"] = t.Order
+	}
+	return withSubAggregationsJSON(map[string]interface{}{"terms": terms}, t.SubAggregations)
+}
+
+// ToJSON returns the JSON representation of the CardinalityAggregation.
+func (c *CardinalityAggregation) ToJSON() map[string]interface{} {
+	cardinality := map[string]interface{}{
+		"field": c.Field,
+	}
+	if c.PrecisionThreshold != nil {
+		cardinality["precision_threshold"] = *c.PrecisionThreshold
+	}
+	return map[string]interface{}{
+		"cardinality": cardinality,
+	}
+}
+
+// WithSubAggregation adds a named aggregation to run within each bucket of the date histogram.
+func (d *DateHistogramAggregation) WithSubAggregation(name string, aggregation Aggregation) *DateHistogramAggregation {
+	d.SubAggregations = withSubAggregation(d.SubAggregations, name, aggregation)
+	return d
+}
+
+// ToJSON returns the JSON representation of the DateHistogramAggregation.
+func (d *DateHistogramAggregation) ToJSON() map[string]interface{} {
+	histogram := map[string]interface{}{
+		"field": d.Field,
+	}
+	if d.CalendarInterval != "" {
+		histogram["calendar_interval"] = d.CalendarInterval
+	}
+	if d.FixedInterval != "" {
+		histogram["fixed_interval"] = d.FixedInterval
+	}
+	if d.Format != nil {
+		histogram["format"] = *d.Format
+	}
+	if d.TimeZone != nil {
+		histogram["time_zone"] = *d.TimeZone
+	}
+	if d.MinDocCount != nil {
+		histogram["min_doc_count"] = *d.MinDocCount
+	}
+	return withSubAggregationsJSON(map[string]interface{}{"date_histogram": histogram}, d.SubAggregations)
+}
+
+// WithSubAggregation adds a named aggregation to run over the nested documents.
+func (n *NestedAggregation) WithSubAggregation(name string, aggregation Aggregation) *NestedAggregation {
+	n.SubAggregations = withSubAggregation(n.SubAggregations, name, aggregation)
+	return n
+}
+
+// ToJSON returns the JSON representation of the NestedAggregation.
+func (n *NestedAggregation) ToJSON() map[string]interface{} {
+	return withSubAggregationsJSON(map[string]interface{}{
+		"nested": map[string]interface{}{
+			"path": n.Path,
+		},
+	}, n.SubAggregations)
+}
+
+// WithSubAggregation adds a named aggregation to run within each bucket of the filters aggregation.
+func (f *FiltersAggregation) WithSubAggregation(name string, aggregation Aggregation) *FiltersAggregation {
+	f.SubAggregations = withSubAggregation(f.SubAggregations, name, aggregation)
+	return f
+}
+
+// ToJSON returns the JSON representation of the FiltersAggregation.
+func (f *FiltersAggregation) ToJSON() map[string]interface{} {
+	filters := make(map[string]interface{}, len(f.Filters))
+	for name, query := range f.Filters {
+		filters[name] = query.ToJSON()
+	}
+	aggregation := map[string]interface{}{
+		"filters": filters,
+	}
+	if f.OtherBucketKey != nil {
+		aggregation["other_bucket_key"] = *f.OtherBucketKey
+	}
+	return withSubAggregationsJSON(map[string]interface{}{"filters": aggregation}, f.SubAggregations)
+}
+
+// ToJSON returns the JSON representation of the MetricAggregation.
+func (m *MetricAggregation) ToJSON() map[string]interface{} {
+	metric := map[string]interface{}{
+		"field": m.Field,
+	}
+	if m.Missing != nil {
+		metric["missing"] = m.Missing
+	}
+	return map[string]interface{}{
+		string(m.Type): metric,
+	}
+}
+
+// AggregationsToJSON returns the JSON representation of a set of named aggregations,
+// as used for the aggregations of the DSL.
+func AggregationsToJSON(aggregations map[string]Aggregation) map[string]interface{} {
+	if len(aggregations) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{}, len(aggregations))
+	for name, aggregation := range aggregations {
+		result[name] = aggregation.ToJSON()
+	}
+	return result
+}
+
+func withSubAggregation(subAggregations map[string]Aggregation, name string, aggregation Aggregation) map[string]Aggregation {
+	if subAggregations == nil {
+		subAggregations = make(map[string]Aggregation)
+	}
+	subAggregations[name] = aggregation
+	return subAggregations
+}
+
+func withSubAggregationsJSON(aggregation map[string]interface{}, subAggregations map[string]Aggregation) map[string]interface{} {
+	if len(subAggregations) > 0 {
+		aggregation["aggs"] = AggregationsToJSON(subAggregations)
+	}
+	return aggregation
+}
+
+// AggregationResult represents the result of a single aggregation in an index search response.
+// Metric aggregations (avg, sum, min, max, value_count and cardinality) populate Value, bucket
+// aggregations (terms, date histogram and filters) populate Buckets, and single-bucket
+// aggregations (nested) populate DocCount and the Aggregations within them.
+type AggregationResult struct {
+	Value                   *float64
+	ValueAsString           string
+	Buckets                 []AggregationBucket
+	DocCount                *int64
+	DocCountErrorUpperBound *int64
+	SumOtherDocCount        *int64
+	Aggregations            map[string]AggregationResult
+}
+
+// AggregationBucket represents a single bucket of a bucket aggregation result.
+type AggregationBucket struct {
+	Key          interface{}
+	KeyAsString  string
+	DocCount     int64
+	Aggregations map[string]AggregationResult
+}
+
+// Bucket returns the bucket of the result whose key matches the given key, or nil if there is none.
+func (ar AggregationResult) Bucket(key string) *AggregationBucket {
+	for i := range ar.Buckets {
+		if ar.Buckets[i].GetKey() == key {
+			return &ar.Buckets[i]
+		}
+	}
+	return nil
+}
+
+// Counts returns the document count of each bucket of the result, by bucket key.
+func (ar AggregationResult) Counts() map[string]int64 {
+	counts := make(map[string]int64, len(ar.Buckets))
+	for _, bucket := range ar.Buckets {
+		counts[bucket.GetKey()] = bucket.DocCount
+	}
+	return counts
+}
+
+// GetKey returns the key of the bucket as a string, preferring its formatted key_as_string
+// (for example, the formatted date of a date histogram bucket) when there is one.
+func (ab AggregationBucket) GetKey() string {
+	if ab.KeyAsString != "" {
+		return ab.KeyAsString
+	}
+	switch key := ab.Key.(type) {
+	case string:
+		return key
+	case float64:
+		return strconv.FormatFloat(key, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(key)
+	}
+}
+
+// UnmarshalJSON decodes an aggregation result, treating any object-valued property that
+// is not part of the result itself as the result of a named sub-aggregation.
+func (ar *AggregationResult) UnmarshalJSON(data []byte) error {
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return err
+	}
+	for name, raw := range properties {
+		var err error
+		switch name {
+		case "value":
+			err = json.Unmarshal(raw, &ar.Value)
+		case "value_as_string":
+			err = json.Unmarshal(raw, &ar.ValueAsString)
+		case "doc_count":
+			err = json.Unmarshal(raw, &ar.DocCount)
+		case "doc_count_error_upper_bound":
+			err = json.Unmarshal(raw, &ar.DocCountErrorUpperBound)
+		case "sum_other_doc_count":
+			err = json.Unmarshal(raw, &ar.SumOtherDocCount)
+		case "buckets":
+			ar.Buckets, err = decodeBuckets(raw)
+		case "meta":
+		default:
+			ar.Aggregations, err = decodeSubAggregation(ar.Aggregations, name, raw)
+		}
+		if err != nil {
+			return fmt.Errorf("decoding %s of aggregation result: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes an aggregation bucket, treating any object-valued property that
+// is not part of the bucket itself as the result of a named sub-aggregation.
+func (ab *AggregationBucket) UnmarshalJSON(data []byte) error {
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return err
+	}
+	for name, raw := range properties {
+		var err error
+		switch name {
+		case "key":
+			err = json.Unmarshal(raw, &ab.Key)
+		case "key_as_string":
+			err = json.Unmarshal(raw, &ab.KeyAsString)
+		case "doc_count":
+			err = json.Unmarshal(raw, &ab.DocCount)
+		default:
+			ab.Aggregations, err = decodeSubAggregation(ab.Aggregations, name, raw)
+		}
+		if err != nil {
+			return fmt.Errorf("decoding %s of aggregation bucket: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// decodeBuckets decodes the buckets of a bucket aggregation result, which are an array for
+// most aggregations but an object keyed by bucket name for (keyed) filters aggregations.
+func decodeBuckets(raw json.RawMessage) ([]AggregationBucket, error) {
+	var buckets []AggregationBucket
+	if len(raw) > 0 && raw[0] == '[' {
+		err := json.Unmarshal(raw, &buckets)
+		return buckets, err
+	}
+	var keyed map[string]AggregationBucket
+	if err := json.Unmarshal(raw, &keyed); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(keyed))
+	for key := range keyed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	buckets = make([]AggregationBucket, 0, len(keyed))
+	for _, key := range keys {
+		bucket := keyed[key]
+		bucket.Key = key
+		buckets = append(buckets, bucket)
+	}
+	return buckets, nil
+}
+
+// decodeSubAggregation adds the named sub-aggregation result to results, if raw is an object.
+func decodeSubAggregation(results map[string]AggregationResult, name string, raw json.RawMessage) (map[string]AggregationResult, error) {
+	if len(raw) == 0 || raw[0] != '{' {
+		return results, nil
+	}
+	var result AggregationResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return results, err
+	}
+	if results == nil {
+		results = make(map[string]AggregationResult)
+	}
+	results[name] = result
+	return results, nil
+}
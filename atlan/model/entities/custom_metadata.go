@@ -0,0 +1,17 @@
+package entities
+
+// GetCustomMetadata returns the attributes of the custom metadata set with the given name, by the
+// names of the attributes, or nil if the asset has none of them.
+func (e *Entity) GetCustomMetadata(setName string) map[string]interface{} {
+	return e.CustomMetadata[setName]
+}
+
+// SetCustomMetadata sets the attributes of the custom metadata set with the given name, by the
+// names of the attributes. They are saved along with the asset when it is saved with an
+// atlan.CustomMetadataHandling of MERGE or OVERWRITE (see assets.SaveOptions).
+func (e *Entity) SetCustomMetadata(setName string, attributes map[string]interface{}) {
+	if e.CustomMetadata == nil {
+		e.CustomMetadata = make(map[string]map[string]interface{})
+	}
+	e.CustomMetadata[setName] = attributes
+}
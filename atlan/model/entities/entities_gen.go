@@ -0,0 +1,3943 @@
+// Code generated by go run ./generator; DO NOT EDIT.
+
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model/structs"
+)
+
+// AWS is an entity of type AWS.
+// AWS: Base class for AWS assets.
+type AWS struct {
+	Entity
+	AWSAttributes
+	AWSRelationshipAttributes
+}
+
+// AWSAttributes holds the attributes of AWS entities.
+type AWSAttributes struct {
+	CloudAttributes
+	// Amazon Resource Name (ARN) for this asset. This uniquely identifies the asset in AWS, and thus must be unique across all AWS asset instances.
+	AwsArn *string `json:"awsArn,omitempty"`
+	// Group of AWS region and service objects.
+	AwsPartition *string `json:"awsPartition,omitempty"`
+	// Type of service in which the asset exists.
+	AwsService *string `json:"awsService,omitempty"`
+	// Physical region where the data center in which the asset exists is clustered.
+	AwsRegion *string `json:"awsRegion,omitempty"`
+	// 12-digit number that uniquely identifies an AWS account.
+	AwsAccountId *string `json:"awsAccountId,omitempty"`
+	// Unique resource ID assigned when a new resource is created.
+	AwsResourceId *string `json:"awsResourceId,omitempty"`
+	// Root user's name.
+	AwsOwnerName *string `json:"awsOwnerName,omitempty"`
+	// Root user's ID.
+	AwsOwnerId *string `json:"awsOwnerId,omitempty"`
+	// List of tags that have been applied to the asset in AWS.
+	AwsTags *[]Struct `json:"awsTags,omitempty"`
+}
+
+// AWSRelationshipAttributes holds the relationship attributes of AWS entities.
+type AWSRelationshipAttributes struct {
+	CloudRelationshipAttributes
+}
+
+// MarshalJSON encodes the AWS with the attributes and relationship attributes that are set.
+func (e *AWS) MarshalJSON() ([]byte, error) {
+	return marshalEntity("AWS", e.Entity, e.AWSAttributes, e.AWSRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a AWS with its attributes and relationship attributes.
+func (e *AWS) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AWSAttributes, &e.AWSRelationshipAttributes)
+}
+
+// ToJSON encodes the AWS as indented JSON.
+func (e *AWS) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a AWS, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *AWS) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the AWS with the given name and qualified name.
+func (e *AWS) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("AWS")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the AWS with only the attributes required to update it.
+func (e *AWS) TrimToRequired() (*AWS, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a AWS")
+	}
+	trimmed := &AWS{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// AccessControl is an entity of type AccessControl.
+// AccessControl: Base class for the assets that control access to other assets in Atlan.
+type AccessControl struct {
+	Entity
+	AccessControlAttributes
+	AccessControlRelationshipAttributes
+}
+
+// AccessControlAttributes holds the attributes of AccessControl entities.
+type AccessControlAttributes struct {
+	AssetAttributes
+	// Whether the access control is enabled (true) or not (false).
+	IsAccessControlEnabled *bool `json:"isAccessControlEnabled,omitempty"`
+	// GUIDs of the custom metadata sets that are hidden from the users of the access control.
+	DenyCustomMetadataGuids *[]string `json:"denyCustomMetadataGuids,omitempty"`
+	// Asset tabs that are hidden from the users of the access control.
+	DenyAssetTabs *[]string `json:"denyAssetTabs,omitempty"`
+	// Asset filters that are hidden from the users of the access control.
+	DenyAssetFilters *[]string `json:"denyAssetFilters,omitempty"`
+	// Link to the channel in which the access control is discussed.
+	ChannelLink *string `json:"channelLink,omitempty"`
+	// Types of asset that are hidden from the users of the access control.
+	DenyAssetTypes *[]string `json:"denyAssetTypes,omitempty"`
+	// Navigation pages that are hidden from the users of the access control.
+	DenyNavigationPages *[]string `json:"denyNavigationPages,omitempty"`
+	// Page the users of the access control land on by default.
+	DefaultNavigation *string `json:"defaultNavigation,omitempty"`
+	// Display preferences of the users of the access control.
+	DisplayPreferences *[]string `json:"displayPreferences,omitempty"`
+}
+
+// AccessControlRelationshipAttributes holds the relationship attributes of AccessControl entities.
+type AccessControlRelationshipAttributes struct {
+	AssetRelationshipAttributes
+	// Policies of the access control.
+	Policies *[]Reference `json:"policies,omitempty"`
+}
+
+// MarshalJSON encodes the AccessControl with the attributes and relationship attributes that are set.
+func (e *AccessControl) MarshalJSON() ([]byte, error) {
+	return marshalEntity("AccessControl", e.Entity, e.AccessControlAttributes, e.AccessControlRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a AccessControl with its attributes and relationship attributes.
+func (e *AccessControl) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AccessControlAttributes, &e.AccessControlRelationshipAttributes)
+}
+
+// ToJSON encodes the AccessControl as indented JSON.
+func (e *AccessControl) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a AccessControl, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *AccessControl) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the AccessControl with the given name and qualified name.
+func (e *AccessControl) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("AccessControl")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the AccessControl with only the attributes required to update it.
+func (e *AccessControl) TrimToRequired() (*AccessControl, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a AccessControl")
+	}
+	trimmed := &AccessControl{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Asset is an entity of type Asset.
+// Asset: Base class for all assets.
+type Asset struct {
+	Entity
+	AssetAttributes
+	AssetRelationshipAttributes
+}
+
+// AssetAttributes holds the attributes of Asset entities.
+type AssetAttributes struct {
+	ReferenceableAttributes
+	// Name of this asset. Fallback for display purposes, if displayName is empty.
+	Name *string `json:"name,omitempty"`
+	// Human-readable name of this asset used for display purposes (in user interface).
+	DisplayName *string `json:"displayName,omitempty"`
+	// Description of this asset, for example as crawled from a source. Fallback for display purposes, if userDescription is empty.
+	Description *string `json:"description,omitempty"`
+	// Description of this asset, as provided by a user. If present, this will be used for the description in user interface.
+	UserDescription *string `json:"userDescription,omitempty"`
+	// Name of the Atlan workspace in which this asset exists.
+	TenantId *string `json:"tenantId,omitempty"`
+	// Status of this asset's certification.
+	CertificateStatus *string `json:"certificateStatus,omitempty"`
+	// Human-readable descriptive message used to provide further detail to certificateStatus.
+	CertificateStatusMessage *string `json:"certificateStatusMessage,omitempty"`
+	// Name of the user who last updated the certification of this asset.
+	CertificateUpdatedBy *string `json:"certificateUpdatedBy,omitempty"`
+	// Time (epoch) at which the certification was last updated, in milliseconds.
+	CertificateUpdatedAt *int64 `json:"certificateUpdatedAt,omitempty"`
+	// Brief title for the announcement on this asset. Required when announcementType is specified.
+	AnnouncementTitle *string `json:"announcementTitle,omitempty"`
+	// Detailed message to include in the announcement on this asset.
+	AnnouncementMessage *string `json:"announcementMessage,omitempty"`
+	// Type of announcement on this asset.
+	AnnouncementType *string `json:"announcementType,omitempty"`
+	// Time (epoch) at which the announcement was last updated, in milliseconds.
+	AnnouncementUpdatedAt *int64 `json:"announcementUpdatedAt,omitempty"`
+	// Name of the user who last updated the announcement.
+	AnnouncementUpdatedBy *string `json:"announcementUpdatedBy,omitempty"`
+	// List of users who own this asset.
+	OwnerUsers *[]string `json:"ownerUsers,omitempty"`
+	// List of groups who own this asset.
+	OwnerGroups *[]string `json:"ownerGroups,omitempty"`
+	// List of users who administer this asset. (This is only used for certain asset types.)
+	AdminUsers *[]string `json:"adminUsers,omitempty"`
+	// List of groups who administer this asset. (This is only used for certain asset types.)
+	AdminGroups *[]string `json:"adminGroups,omitempty"`
+	// List of users who can view assets contained in a collection. (This is only used for certain asset types.)
+	ViewerUsers *[]string `json:"viewerUsers,omitempty"`
+	// List of groups who can view assets contained in a collection. (This is only used for certain asset types.)
+	ViewerGroups *[]string `json:"viewerGroups,omitempty"`
+	// Type of the connector through which this asset is accessible.
+	ConnectorName *string `json:"connectorName,omitempty"`
+	// Simple name of the connection through which this asset is accessible.
+	ConnectionName *string `json:"connectionName,omitempty"`
+	// Unique name of the connection through which this asset is accessible.
+	ConnectionQualifiedName *string `json:"connectionQualifiedName,omitempty"`
+	// Whether this asset has lineage (true) or not (false).
+	HasLineage *bool `json:"hasLineage,omitempty"`
+	// Whether this asset is discoverable through the UI (true) or not (false).
+	IsDiscoverable *bool `json:"isDiscoverable,omitempty"`
+	// Whether this asset can be edited in the UI (true) or not (false).
+	IsEditable *bool `json:"isEditable,omitempty"`
+	// Popularity score for this asset.
+	PopularityScore *float64 `json:"popularityScore,omitempty"`
+	// List of owners of this asset, in the source system.
+	SourceOwners *string `json:"sourceOwners,omitempty"`
+	// Name of the user who created this asset, in the source system.
+	SourceCreatedBy *string `json:"sourceCreatedBy,omitempty"`
+	// Time (epoch) at which this asset was created in the source system, in milliseconds.
+	SourceCreatedAt *int64 `json:"sourceCreatedAt,omitempty"`
+	// Time (epoch) at which this asset was last updated in the source system, in milliseconds.
+	SourceUpdatedAt *int64 `json:"sourceUpdatedAt,omitempty"`
+	// Name of the user who last updated this asset, in the source system.
+	SourceUpdatedBy *string `json:"sourceUpdatedBy,omitempty"`
+	// URL to the resource within the source application, used to create a button to view this asset in the source application.
+	SourceURL *string `json:"sourceURL,omitempty"`
+	// Name of the crawler that last synchronized this asset.
+	LastSyncWorkflowName *string `json:"lastSyncWorkflowName,omitempty"`
+	// Time (epoch) at which this asset was last crawled, in milliseconds.
+	LastSyncRunAt *int64 `json:"lastSyncRunAt,omitempty"`
+	// Name of the last run of the crawler that last synchronized this asset.
+	LastSyncRun *string `json:"lastSyncRun,omitempty"`
+	// List of tags attached to this asset.
+	AssetTags *[]string `json:"assetTags,omitempty"`
+}
+
+// AssetRelationshipAttributes holds the relationship attributes of Asset entities.
+type AssetRelationshipAttributes struct {
+	ReferenceableRelationshipAttributes
+	// Glossary terms that are linked to this asset.
+	Meanings *[]Reference `json:"meanings,omitempty"`
+}
+
+// MarshalJSON encodes the Asset with the attributes and relationship attributes that are set.
+func (e *Asset) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Asset", e.Entity, e.AssetAttributes, e.AssetRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Asset with its attributes and relationship attributes.
+func (e *Asset) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AssetAttributes, &e.AssetRelationshipAttributes)
+}
+
+// ToJSON encodes the Asset as indented JSON.
+func (e *Asset) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Asset, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Asset) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Asset with the given name and qualified name.
+func (e *Asset) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Asset")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Asset with only the attributes required to update it.
+func (e *Asset) TrimToRequired() (*Asset, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Asset")
+	}
+	trimmed := &Asset{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// AtlasGlossary is an entity of type AtlasGlossary.
+// AtlasGlossary: Instance of a glossary in Atlan.
+type AtlasGlossary struct {
+	Entity
+	AtlasGlossaryAttributes
+	AtlasGlossaryRelationshipAttributes
+}
+
+// AtlasGlossaryAttributes holds the attributes of AtlasGlossary entities.
+type AtlasGlossaryAttributes struct {
+	AssetAttributes
+	// Unused. A short definition of the glossary element.
+	ShortDescription *string `json:"shortDescription,omitempty"`
+	// Unused. A longer description of the glossary element.
+	LongDescription *string `json:"longDescription,omitempty"`
+	// Unused. Arbitrary set of additional attributes associated with this glossary element.
+	AdditionalAttributes *map[string]string `json:"additionalAttributes,omitempty"`
+	// Unused. Language of the glossary's contents.
+	Language *string `json:"language,omitempty"`
+	// Unused. Inteded usage for the glossary.
+	Usage *string `json:"usage,omitempty"`
+}
+
+// AtlasGlossaryRelationshipAttributes holds the relationship attributes of AtlasGlossary entities.
+type AtlasGlossaryRelationshipAttributes struct {
+	AssetRelationshipAttributes
+	// Terms within this glossary.
+	Terms *[]Reference `json:"terms,omitempty"`
+	// Categories within this glossary.
+	Categories *[]Reference `json:"categories,omitempty"`
+}
+
+// MarshalJSON encodes the AtlasGlossary with the attributes and relationship attributes that are set.
+func (e *AtlasGlossary) MarshalJSON() ([]byte, error) {
+	return marshalEntity("AtlasGlossary", e.Entity, e.AtlasGlossaryAttributes, e.AtlasGlossaryRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a AtlasGlossary with its attributes and relationship attributes.
+func (e *AtlasGlossary) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AtlasGlossaryAttributes, &e.AtlasGlossaryRelationshipAttributes)
+}
+
+// ToJSON encodes the AtlasGlossary as indented JSON.
+func (e *AtlasGlossary) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a AtlasGlossary, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *AtlasGlossary) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new glossary, named name.
+func (e *AtlasGlossary) Creator(name string) error {
+	if name == "" {
+		return errors.New("name is a required field")
+	}
+	e.TypeName = structs.StringPtr("AtlasGlossary")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(name)
+	return nil
+}
+
+// Updater sets the attributes required to update the AtlasGlossary with the given name and qualified name.
+func (e *AtlasGlossary) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("AtlasGlossary")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the AtlasGlossary with only the attributes required to update it.
+func (e *AtlasGlossary) TrimToRequired() (*AtlasGlossary, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a AtlasGlossary")
+	}
+	trimmed := &AtlasGlossary{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// AtlasGlossaryCategory is an entity of type AtlasGlossaryCategory.
+// AtlasGlossaryCategory: Instance of a category in a glossary, in Atlan.
+type AtlasGlossaryCategory struct {
+	Entity
+	AtlasGlossaryCategoryAttributes
+	AtlasGlossaryCategoryRelationshipAttributes
+}
+
+// AtlasGlossaryCategoryAttributes holds the attributes of AtlasGlossaryCategory entities.
+type AtlasGlossaryCategoryAttributes struct {
+	AssetAttributes
+	// Unused. A short definition of the glossary element.
+	ShortDescription *string `json:"shortDescription,omitempty"`
+	// Unused. A longer description of the glossary element.
+	LongDescription *string `json:"longDescription,omitempty"`
+	// Unused. Arbitrary set of additional attributes associated with this glossary element.
+	AdditionalAttributes *map[string]string `json:"additionalAttributes,omitempty"`
+}
+
+// AtlasGlossaryCategoryRelationshipAttributes holds the relationship attributes of AtlasGlossaryCategory entities.
+type AtlasGlossaryCategoryRelationshipAttributes struct {
+	AssetRelationshipAttributes
+	// Glossary in which the category is contained.
+	Anchor *Reference `json:"anchor,omitempty"`
+	// Parent category in which this category is located (or empty if it is a root-level category).
+	ParentCategory *Reference `json:"parentCategory,omitempty"`
+	// Child categories organized within this category.
+	ChildrenCategories *[]Reference `json:"childrenCategories,omitempty"`
+	// Terms organized within this category.
+	Terms *[]Reference `json:"terms,omitempty"`
+}
+
+// MarshalJSON encodes the AtlasGlossaryCategory with the attributes and relationship attributes that are set.
+func (e *AtlasGlossaryCategory) MarshalJSON() ([]byte, error) {
+	return marshalEntity("AtlasGlossaryCategory", e.Entity, e.AtlasGlossaryCategoryAttributes, e.AtlasGlossaryCategoryRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a AtlasGlossaryCategory with its attributes and relationship attributes.
+func (e *AtlasGlossaryCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AtlasGlossaryCategoryAttributes, &e.AtlasGlossaryCategoryRelationshipAttributes)
+}
+
+// ToJSON encodes the AtlasGlossaryCategory as indented JSON.
+func (e *AtlasGlossaryCategory) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a AtlasGlossaryCategory, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *AtlasGlossaryCategory) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new AtlasGlossaryCategory, named name, in the
+// glossary with the given GUID.
+func (e *AtlasGlossaryCategory) Creator(name, glossaryGuid string) error {
+	if name == "" || glossaryGuid == "" {
+		return errors.New("name and glossaryGuid are required fields")
+	}
+	e.TypeName = structs.StringPtr("AtlasGlossaryCategory")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(name)
+	e.Anchor = RefByGuid("AtlasGlossary", glossaryGuid)
+	return nil
+}
+
+// Updater sets the attributes required to update the AtlasGlossaryCategory with the given name and qualified name,
+// in the glossary with the given GUID.
+func (e *AtlasGlossaryCategory) Updater(name, qualifiedName, glossaryGuid string) error {
+	if name == "" || qualifiedName == "" || glossaryGuid == "" {
+		return errors.New("name, qualifiedName and glossaryGuid are required fields")
+	}
+	e.TypeName = structs.StringPtr("AtlasGlossaryCategory")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	e.Anchor = RefByGuid("AtlasGlossary", glossaryGuid)
+	return nil
+}
+
+// TrimToRequired returns a copy of the AtlasGlossaryCategory with only the attributes required to update it.
+func (e *AtlasGlossaryCategory) TrimToRequired() (*AtlasGlossaryCategory, error) {
+	if e.Name == nil || e.QualifiedName == nil || e.Anchor == nil || e.Anchor.Guid == "" {
+		return nil, errors.New("name, qualifiedName and the GUID of the anchor are required to trim a AtlasGlossaryCategory")
+	}
+	trimmed := &AtlasGlossaryCategory{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName, e.Anchor.Guid); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// AtlasGlossaryTerm is an entity of type AtlasGlossaryTerm.
+// AtlasGlossaryTerm: Instance of a term in a glossary, in Atlan.
+type AtlasGlossaryTerm struct {
+	Entity
+	AtlasGlossaryTermAttributes
+	AtlasGlossaryTermRelationshipAttributes
+}
+
+// AtlasGlossaryTermAttributes holds the attributes of AtlasGlossaryTerm entities.
+type AtlasGlossaryTermAttributes struct {
+	AssetAttributes
+	// Unused. A short definition of the glossary element.
+	ShortDescription *string `json:"shortDescription,omitempty"`
+	// Unused. A longer description of the glossary element.
+	LongDescription *string `json:"longDescription,omitempty"`
+	// Unused. Arbitrary set of additional attributes associated with this glossary element.
+	AdditionalAttributes *map[string]string `json:"additionalAttributes,omitempty"`
+	// Unused. Exmaples of the term.
+	Examples *[]string `json:"examples,omitempty"`
+	// Unused. Abbreviation of the term.
+	Abbreviation *string `json:"abbreviation,omitempty"`
+	// Unused. Intended usage for the term.
+	Usage *string `json:"usage,omitempty"`
+}
+
+// AtlasGlossaryTermRelationshipAttributes holds the relationship attributes of AtlasGlossaryTerm entities.
+type AtlasGlossaryTermRelationshipAttributes struct {
+	AssetRelationshipAttributes
+	// Glossary in which the term is contained.
+	Anchor *Reference `json:"anchor,omitempty"`
+	// Categories within which this term is organized.
+	Categories *[]Reference `json:"categories,omitempty"`
+	// Assets to which this term is linked.
+	AssignedEntities *[]Reference `json:"assignedEntities,omitempty"`
+	// Related terms that may also be of interest.
+	SeeAlso *[]Reference `json:"seeAlso,omitempty"`
+	// Terms that have the same (or a very similar) meaning, in the same language.
+	Synonyms *[]Reference `json:"synonyms,omitempty"`
+	// Terms that have the opposite (or near opposite) meaning, in the same language.
+	Antonyms *[]Reference `json:"antonyms,omitempty"`
+	// Terms that should be used instead of this term.
+	PreferredTerms *[]Reference `json:"preferredTerms,omitempty"`
+	// Unused. Terms that this term should be used instead of.
+	PreferredToTerms *[]Reference `json:"preferredToTerms,omitempty"`
+	// Unused. Terms that replace this term.
+	ReplacedBy *[]Reference `json:"replacedBy,omitempty"`
+	// Unused. Terms that this term replaces.
+	ReplacementTerms *[]Reference `json:"replacementTerms,omitempty"`
+	// Translated versions of this term, in other languages.
+	TranslationTerms *[]Reference `json:"translationTerms,omitempty"`
+	// Unused. Terms that this term translates.
+	TranslatedTerms *[]Reference `json:"translatedTerms,omitempty"`
+	// Unused. Terms that this term is a kind of.
+	IsA *[]Reference `json:"isA,omitempty"`
+	// Unused. Terms that are kinds of this term.
+	Classifies *[]Reference `json:"classifies,omitempty"`
+	// Unused. Terms that are valid values for this term.
+	ValidValues *[]Reference `json:"validValues,omitempty"`
+	// Unused. Terms for which this term is a valid value.
+	ValidValuesFor *[]Reference `json:"validValuesFor,omitempty"`
+}
+
+// MarshalJSON encodes the AtlasGlossaryTerm with the attributes and relationship attributes that are set.
+func (e *AtlasGlossaryTerm) MarshalJSON() ([]byte, error) {
+	return marshalEntity("AtlasGlossaryTerm", e.Entity, e.AtlasGlossaryTermAttributes, e.AtlasGlossaryTermRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a AtlasGlossaryTerm with its attributes and relationship attributes.
+func (e *AtlasGlossaryTerm) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AtlasGlossaryTermAttributes, &e.AtlasGlossaryTermRelationshipAttributes)
+}
+
+// ToJSON encodes the AtlasGlossaryTerm as indented JSON.
+func (e *AtlasGlossaryTerm) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a AtlasGlossaryTerm, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *AtlasGlossaryTerm) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new AtlasGlossaryTerm, named name, in the
+// glossary with the given GUID.
+func (e *AtlasGlossaryTerm) Creator(name, glossaryGuid string) error {
+	if name == "" || glossaryGuid == "" {
+		return errors.New("name and glossaryGuid are required fields")
+	}
+	e.TypeName = structs.StringPtr("AtlasGlossaryTerm")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(name)
+	e.Anchor = RefByGuid("AtlasGlossary", glossaryGuid)
+	return nil
+}
+
+// Updater sets the attributes required to update the AtlasGlossaryTerm with the given name and qualified name,
+// in the glossary with the given GUID.
+func (e *AtlasGlossaryTerm) Updater(name, qualifiedName, glossaryGuid string) error {
+	if name == "" || qualifiedName == "" || glossaryGuid == "" {
+		return errors.New("name, qualifiedName and glossaryGuid are required fields")
+	}
+	e.TypeName = structs.StringPtr("AtlasGlossaryTerm")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	e.Anchor = RefByGuid("AtlasGlossary", glossaryGuid)
+	return nil
+}
+
+// TrimToRequired returns a copy of the AtlasGlossaryTerm with only the attributes required to update it.
+func (e *AtlasGlossaryTerm) TrimToRequired() (*AtlasGlossaryTerm, error) {
+	if e.Name == nil || e.QualifiedName == nil || e.Anchor == nil || e.Anchor.Guid == "" {
+		return nil, errors.New("name, qualifiedName and the GUID of the anchor are required to trim a AtlasGlossaryTerm")
+	}
+	trimmed := &AtlasGlossaryTerm{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName, e.Anchor.Guid); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// AuthPolicy is an entity of type AuthPolicy.
+// AuthPolicy: Instance of an access policy of a persona or purpose in Atlan.
+type AuthPolicy struct {
+	Entity
+	AuthPolicyAttributes
+	AuthPolicyRelationshipAttributes
+}
+
+// AuthPolicyAttributes holds the attributes of AuthPolicy entities.
+type AuthPolicyAttributes struct {
+	AssetAttributes
+	// Type of the policy, for example allow or deny.
+	PolicyType *string `json:"policyType,omitempty"`
+	// Name of the service the policy is enforced by.
+	PolicyServiceName *string `json:"policyServiceName,omitempty"`
+	// Category of the policy, for example persona or purpose.
+	PolicyCategory *string `json:"policyCategory,omitempty"`
+	// Subcategory of the policy, for example metadata or data.
+	PolicySubCategory *string `json:"policySubCategory,omitempty"`
+	// Users the policy applies to.
+	PolicyUsers *[]string `json:"policyUsers,omitempty"`
+	// Groups the policy applies to.
+	PolicyGroups *[]string `json:"policyGroups,omitempty"`
+	// Roles the policy applies to.
+	PolicyRoles *[]string `json:"policyRoles,omitempty"`
+	// Actions the policy allows or denies.
+	PolicyActions *[]string `json:"policyActions,omitempty"`
+	// Resources the policy applies to.
+	PolicyResources *[]string `json:"policyResources,omitempty"`
+	// Category of the resources the policy applies to.
+	PolicyResourceCategory *string `json:"policyResourceCategory,omitempty"`
+	// Priority of the policy over the others that apply.
+	PolicyPriority *int `json:"policyPriority,omitempty"`
+	// Whether the policy is enabled (true) or not (false).
+	IsPolicyEnabled *bool `json:"isPolicyEnabled,omitempty"`
+	// Type of masking the policy applies to data.
+	PolicyMaskType *string `json:"policyMaskType,omitempty"`
+	// Schedules during which the policy applies.
+	PolicyValiditySchedule *[]Struct `json:"policyValiditySchedule,omitempty"`
+	// Signature of the resources of the policy.
+	PolicyResourceSignature *string `json:"policyResourceSignature,omitempty"`
+	// Whether the users of the policy can administer it (true) or not (false).
+	PolicyDelegateAdmin *bool `json:"policyDelegateAdmin,omitempty"`
+	// Conditions under which the policy applies.
+	PolicyConditions *[]Struct `json:"policyConditions,omitempty"`
+}
+
+// AuthPolicyRelationshipAttributes holds the relationship attributes of AuthPolicy entities.
+type AuthPolicyRelationshipAttributes struct {
+	AssetRelationshipAttributes
+	// Access control (persona or purpose) the policy belongs to.
+	AccessControl *Reference `json:"accessControl,omitempty"`
+}
+
+// MarshalJSON encodes the AuthPolicy with the attributes and relationship attributes that are set.
+func (e *AuthPolicy) MarshalJSON() ([]byte, error) {
+	return marshalEntity("AuthPolicy", e.Entity, e.AuthPolicyAttributes, e.AuthPolicyRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a AuthPolicy with its attributes and relationship attributes.
+func (e *AuthPolicy) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AuthPolicyAttributes, &e.AuthPolicyRelationshipAttributes)
+}
+
+// ToJSON encodes the AuthPolicy as indented JSON.
+func (e *AuthPolicy) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a AuthPolicy, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *AuthPolicy) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the AuthPolicy with the given name and qualified name.
+func (e *AuthPolicy) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("AuthPolicy")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the AuthPolicy with only the attributes required to update it.
+func (e *AuthPolicy) TrimToRequired() (*AuthPolicy, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a AuthPolicy")
+	}
+	trimmed := &AuthPolicy{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// AuthService is an entity of type AuthService.
+// AuthService: Instance of a service that enforces access policies in Atlan.
+type AuthService struct {
+	Entity
+	AuthServiceAttributes
+	AuthServiceRelationshipAttributes
+}
+
+// AuthServiceAttributes holds the attributes of AuthService entities.
+type AuthServiceAttributes struct {
+	AssetAttributes
+	// Type of the service.
+	AuthServiceType *string `json:"authServiceType,omitempty"`
+	// Name of the service that enforces the policies on Atlan tags.
+	TagService *string `json:"tagService,omitempty"`
+	// Whether the service is enabled (true) or not (false).
+	AuthServiceIsEnabled *bool `json:"authServiceIsEnabled,omitempty"`
+	// Configuration of the service.
+	AuthServiceConfig *map[string]string `json:"authServiceConfig,omitempty"`
+	// Time (epoch) at which the policies of the service were last synced, in milliseconds.
+	AuthServicePolicyLastSync *int64 `json:"authServicePolicyLastSync,omitempty"`
+}
+
+// AuthServiceRelationshipAttributes holds the relationship attributes of AuthService entities.
+type AuthServiceRelationshipAttributes struct {
+	AssetRelationshipAttributes
+}
+
+// MarshalJSON encodes the AuthService with the attributes and relationship attributes that are set.
+func (e *AuthService) MarshalJSON() ([]byte, error) {
+	return marshalEntity("AuthService", e.Entity, e.AuthServiceAttributes, e.AuthServiceRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a AuthService with its attributes and relationship attributes.
+func (e *AuthService) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.AuthServiceAttributes, &e.AuthServiceRelationshipAttributes)
+}
+
+// ToJSON encodes the AuthService as indented JSON.
+func (e *AuthService) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a AuthService, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *AuthService) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the AuthService with the given name and qualified name.
+func (e *AuthService) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("AuthService")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the AuthService with only the attributes required to update it.
+func (e *AuthService) TrimToRequired() (*AuthService, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a AuthService")
+	}
+	trimmed := &AuthService{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// BI is an entity of type BI.
+// BI: Base class for BI assets.
+type BI struct {
+	Entity
+	BIAttributes
+	BIRelationshipAttributes
+}
+
+// BIAttributes holds the attributes of BI entities.
+type BIAttributes struct {
+	CatalogAttributes
+}
+
+// BIRelationshipAttributes holds the relationship attributes of BI entities.
+type BIRelationshipAttributes struct {
+	CatalogRelationshipAttributes
+}
+
+// MarshalJSON encodes the BI with the attributes and relationship attributes that are set.
+func (e *BI) MarshalJSON() ([]byte, error) {
+	return marshalEntity("BI", e.Entity, e.BIAttributes, e.BIRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a BI with its attributes and relationship attributes.
+func (e *BI) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.BIAttributes, &e.BIRelationshipAttributes)
+}
+
+// ToJSON encodes the BI as indented JSON.
+func (e *BI) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a BI, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *BI) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the BI with the given name and qualified name.
+func (e *BI) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("BI")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the BI with only the attributes required to update it.
+func (e *BI) TrimToRequired() (*BI, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a BI")
+	}
+	trimmed := &BI{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// BIProcess is an entity of type BIProcess.
+// BIProcess: Instance of a BI lineage process in Atlan.
+type BIProcess struct {
+	Entity
+	BIProcessAttributes
+	BIProcessRelationshipAttributes
+}
+
+// BIProcessAttributes holds the attributes of BIProcess entities.
+type BIProcessAttributes struct {
+	ProcessAttributes
+}
+
+// BIProcessRelationshipAttributes holds the relationship attributes of BIProcess entities.
+type BIProcessRelationshipAttributes struct {
+	ProcessRelationshipAttributes
+}
+
+// MarshalJSON encodes the BIProcess with the attributes and relationship attributes that are set.
+func (e *BIProcess) MarshalJSON() ([]byte, error) {
+	return marshalEntity("BIProcess", e.Entity, e.BIProcessAttributes, e.BIProcessRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a BIProcess with its attributes and relationship attributes.
+func (e *BIProcess) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.BIProcessAttributes, &e.BIProcessRelationshipAttributes)
+}
+
+// ToJSON encodes the BIProcess as indented JSON.
+func (e *BIProcess) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a BIProcess, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *BIProcess) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the BIProcess with the given name and qualified name.
+func (e *BIProcess) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("BIProcess")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the BIProcess with only the attributes required to update it.
+func (e *BIProcess) TrimToRequired() (*BIProcess, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a BIProcess")
+	}
+	trimmed := &BIProcess{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Catalog is an entity of type Catalog.
+// Catalog: Base class for catalog assets.
+type Catalog struct {
+	Entity
+	CatalogAttributes
+	CatalogRelationshipAttributes
+}
+
+// CatalogAttributes holds the attributes of Catalog entities.
+type CatalogAttributes struct {
+	AssetAttributes
+}
+
+// CatalogRelationshipAttributes holds the relationship attributes of Catalog entities.
+type CatalogRelationshipAttributes struct {
+	AssetRelationshipAttributes
+	// Processes to which this asset provides input.
+	InputToProcesses *[]Reference `json:"inputToProcesses,omitempty"`
+	// Processes from which this asset is produced as output.
+	OutputFromProcesses *[]Reference `json:"outputFromProcesses,omitempty"`
+}
+
+// MarshalJSON encodes the Catalog with the attributes and relationship attributes that are set.
+func (e *Catalog) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Catalog", e.Entity, e.CatalogAttributes, e.CatalogRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Catalog with its attributes and relationship attributes.
+func (e *Catalog) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.CatalogAttributes, &e.CatalogRelationshipAttributes)
+}
+
+// ToJSON encodes the Catalog as indented JSON.
+func (e *Catalog) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Catalog, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Catalog) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Catalog with the given name and qualified name.
+func (e *Catalog) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Catalog")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Catalog with only the attributes required to update it.
+func (e *Catalog) TrimToRequired() (*Catalog, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Catalog")
+	}
+	trimmed := &Catalog{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Cloud is an entity of type Cloud.
+// Cloud: Base class for cloud assets.
+type Cloud struct {
+	Entity
+	CloudAttributes
+	CloudRelationshipAttributes
+}
+
+// CloudAttributes holds the attributes of Cloud entities.
+type CloudAttributes struct {
+	AssetAttributes
+}
+
+// CloudRelationshipAttributes holds the relationship attributes of Cloud entities.
+type CloudRelationshipAttributes struct {
+	AssetRelationshipAttributes
+}
+
+// MarshalJSON encodes the Cloud with the attributes and relationship attributes that are set.
+func (e *Cloud) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Cloud", e.Entity, e.CloudAttributes, e.CloudRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Cloud with its attributes and relationship attributes.
+func (e *Cloud) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.CloudAttributes, &e.CloudRelationshipAttributes)
+}
+
+// ToJSON encodes the Cloud as indented JSON.
+func (e *Cloud) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Cloud, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Cloud) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Cloud with the given name and qualified name.
+func (e *Cloud) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Cloud")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Cloud with only the attributes required to update it.
+func (e *Cloud) TrimToRequired() (*Cloud, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Cloud")
+	}
+	trimmed := &Cloud{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Column is an entity of type Column.
+// Column: Instance of a column in Atlan.
+type Column struct {
+	Entity
+	ColumnAttributes
+	ColumnRelationshipAttributes
+}
+
+// ColumnAttributes holds the attributes of Column entities.
+type ColumnAttributes struct {
+	SQLAttributes
+	// Data type of values in this column.
+	DataType *string `json:"dataType,omitempty"`
+	// Sub-data type of this column.
+	SubDataType           *string `json:"subDataType,omitempty"`
+	RawDataTypeDefinition *string `json:"rawDataTypeDefinition,omitempty"`
+	// Order (position) in which this column appears in the table (starting at 1).
+	Order             *int    `json:"order,omitempty"`
+	NestedColumnOrder *string `json:"nestedColumnOrder,omitempty"`
+	// Number of columns nested within this (STRUCT or NESTED) column.
+	NestedColumnCount *int `json:"nestedColumnCount,omitempty"`
+	// Whether this column is a partition column (true) or not (false).
+	IsPartition *bool `json:"isPartition,omitempty"`
+	// Order (position) of this partition column in the table.
+	PartitionOrder *int `json:"partitionOrder,omitempty"`
+	// Whether this column is a cluster column (true) or not (false).
+	IsClustered *bool `json:"isClustered,omitempty"`
+	// When true, this column is the primary key for the table.
+	IsPrimary *bool `json:"isPrimary,omitempty"`
+	// When true, this column is a foreign key to another table.
+	IsForeign *bool `json:"isForeign,omitempty"`
+	// When true, this column is indexed in the database.
+	IsIndexed *bool `json:"isIndexed,omitempty"`
+	// Whether this column is a sort column (true) or not (false).
+	IsSort *bool `json:"isSort,omitempty"`
+	// Whether this column is a distribution column (true) or not (false).
+	IsDist *bool `json:"isDist,omitempty"`
+	// Whether this column is pinned (true) or not (false).
+	IsPinned *bool `json:"isPinned,omitempty"`
+	// User who pinned this column.
+	PinnedBy *string `json:"pinnedBy,omitempty"`
+	// Time (epoch) at which this column was pinned, in milliseconds.
+	PinnedAt *int64 `json:"pinnedAt,omitempty"`
+	// Total number of digits allowed, when the dataType is numeric.
+	Precision *int `json:"precision,omitempty"`
+	// Default value for this column.
+	DefaultValue *string `json:"defaultValue,omitempty"`
+	// When true, the values in this column can be null.
+	IsNullable *bool `json:"isNullable,omitempty"`
+	// Number of digits allowed to the right of the decimal point.
+	NumericScale *float64 `json:"numericScale,omitempty"`
+	// Maximum length of a value in this column.
+	MaxLength *int64 `json:"maxLength,omitempty"`
+	// Number of rows that contain distinct values.
+	ColumnDistinctValuesCount *int64 `json:"columnDistinctValuesCount,omitempty"`
+	// Greatest value in a numeric column.
+	ColumnMax *float64 `json:"columnMax,omitempty"`
+	// Least value in a numeric column.
+	ColumnMin *float64 `json:"columnMin,omitempty"`
+	// Arithmetic mean of the values in a numeric column.
+	ColumnMean *float64 `json:"columnMean,omitempty"`
+	// Calculated sum of the values in a numeric column.
+	ColumnSum *float64 `json:"columnSum,omitempty"`
+	// Calculated median of the values in a numeric column.
+	ColumnMedian *float64 `json:"columnMedian,omitempty"`
+	// Calculated standard deviation of the values in a numeric column.
+	ColumnStandardDeviation *float64 `json:"columnStandardDeviation,omitempty"`
+	// Number of rows in which a value in this column appears only once.
+	ColumnUniqueValuesCount *int64 `json:"columnUniqueValuesCount,omitempty"`
+	// Average value in this column.
+	ColumnAverage *float64 `json:"columnAverage,omitempty"`
+	// Number of rows in a column that do not contain content.
+	ColumnMissingValuesCount *int64 `json:"columnMissingValuesCount,omitempty"`
+	// Level of nesting of this column, used for STRUCT and NESTED columns.
+	ColumnDepthLevel *int `json:"columnDepthLevel,omitempty"`
+}
+
+// ColumnRelationshipAttributes holds the relationship attributes of Column entities.
+type ColumnRelationshipAttributes struct {
+	SQLRelationshipAttributes
+	// Table in which this column exists.
+	Table *Reference `json:"table,omitempty"`
+	// View in which this column exists.
+	View *Reference `json:"view,omitempty"`
+	// Materialized view in which this column exists.
+	MaterialisedView *Reference `json:"materialisedView,omitempty"`
+	// Column in which this sub-column is nested.
+	ParentColumn *Reference `json:"parentColumn,omitempty"`
+	// Nested columns that exist within this column.
+	NestedColumns             *[]Reference `json:"nestedColumns,omitempty"`
+	InputToColumnProcesses    *[]Reference `json:"inputToColumnProcesses,omitempty"`
+	OutputFromColumnProcesses *[]Reference `json:"outputFromColumnProcesses,omitempty"`
+}
+
+// MarshalJSON encodes the Column with the attributes and relationship attributes that are set.
+func (e *Column) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Column", e.Entity, e.ColumnAttributes, e.ColumnRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Column with its attributes and relationship attributes.
+func (e *Column) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.ColumnAttributes, &e.ColumnRelationshipAttributes)
+}
+
+// ToJSON encodes the Column as indented JSON.
+func (e *Column) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Column, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Column) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new Column, named name, in the
+// parent of the given type (Table, View, MaterialisedView) and qualified name,
+// at the given position in the parent (starting at 1).
+func (e *Column) Creator(name, parentType, parentQualifiedName string, order int) error {
+	if name == "" || parentType == "" || parentQualifiedName == "" {
+		return errors.New("name, parentType and parentQualifiedName are required fields")
+	}
+	if order < 1 {
+		return errors.New("order must be a positive integer")
+	}
+	hierarchy, err := parseHierarchy(parentQualifiedName, 3)
+	if err != nil {
+		return err
+	}
+	switch parentType {
+	case "Table":
+		e.TableName = structs.StringPtr(hierarchy.names[2])
+		e.TableQualifiedName = structs.StringPtr(parentQualifiedName)
+		e.Table = RefByQualifiedName("Table", parentQualifiedName)
+	case "View":
+		e.ViewName = structs.StringPtr(hierarchy.names[2])
+		e.ViewQualifiedName = structs.StringPtr(parentQualifiedName)
+		e.View = RefByQualifiedName("View", parentQualifiedName)
+	case "MaterialisedView":
+		e.ViewName = structs.StringPtr(hierarchy.names[2])
+		e.ViewQualifiedName = structs.StringPtr(parentQualifiedName)
+		e.MaterialisedView = RefByQualifiedName("MaterialisedView", parentQualifiedName)
+	default:
+		return fmt.Errorf("a Column can't be created in a %s", parentType)
+	}
+	e.TypeName = structs.StringPtr("Column")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(parentQualifiedName + "/" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	e.Order = &order
+	e.DatabaseName = structs.StringPtr(hierarchy.names[0])
+	e.DatabaseQualifiedName = structs.StringPtr(hierarchy.qualifiedNames[0])
+	e.SchemaName = structs.StringPtr(hierarchy.names[1])
+	e.SchemaQualifiedName = structs.StringPtr(hierarchy.qualifiedNames[1])
+	return nil
+}
+
+// Updater sets the attributes required to update the Column with the given name and qualified name.
+func (e *Column) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Column")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Column with only the attributes required to update it.
+func (e *Column) TrimToRequired() (*Column, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Column")
+	}
+	trimmed := &Column{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// ColumnProcess is an entity of type ColumnProcess.
+// ColumnProcess: Instance of a column-level lineage process in Atlan.
+type ColumnProcess struct {
+	Entity
+	ColumnProcessAttributes
+	ColumnProcessRelationshipAttributes
+}
+
+// ColumnProcessAttributes holds the attributes of ColumnProcess entities.
+type ColumnProcessAttributes struct {
+	ProcessAttributes
+}
+
+// ColumnProcessRelationshipAttributes holds the relationship attributes of ColumnProcess entities.
+type ColumnProcessRelationshipAttributes struct {
+	ProcessRelationshipAttributes
+	// Parent process that contains this column-level process.
+	Process *Reference `json:"process,omitempty"`
+}
+
+// MarshalJSON encodes the ColumnProcess with the attributes and relationship attributes that are set.
+func (e *ColumnProcess) MarshalJSON() ([]byte, error) {
+	return marshalEntity("ColumnProcess", e.Entity, e.ColumnProcessAttributes, e.ColumnProcessRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a ColumnProcess with its attributes and relationship attributes.
+func (e *ColumnProcess) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.ColumnProcessAttributes, &e.ColumnProcessRelationshipAttributes)
+}
+
+// ToJSON encodes the ColumnProcess as indented JSON.
+func (e *ColumnProcess) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a ColumnProcess, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *ColumnProcess) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the ColumnProcess with the given name and qualified name.
+func (e *ColumnProcess) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("ColumnProcess")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the ColumnProcess with only the attributes required to update it.
+func (e *ColumnProcess) TrimToRequired() (*ColumnProcess, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a ColumnProcess")
+	}
+	trimmed := &ColumnProcess{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Connection is an entity of type Connection.
+// Connection: Instance of a connection to a data source in Atlan.
+type Connection struct {
+	Entity
+	ConnectionAttributes
+	ConnectionRelationshipAttributes
+}
+
+// ConnectionAttributes holds the attributes of Connection entities.
+type ConnectionAttributes struct {
+	AssetAttributes
+	// Type of connection, for example WAREHOUSE, RDBMS, etc.
+	Category *string `json:"category,omitempty"`
+	// Subcategory of this connection.
+	SubCategory *string `json:"subCategory,omitempty"`
+	// Host name of this connection's source.
+	Host *string `json:"host,omitempty"`
+	// Port number to this connection's source.
+	Port *int `json:"port,omitempty"`
+	// Whether using this connection to run queries on the source is allowed (true) or not (false).
+	AllowQuery *bool `json:"allowQuery,omitempty"`
+	// Whether using this connection to run preview queries on the source is allowed (true) or not (false).
+	AllowQueryPreview *bool `json:"allowQueryPreview,omitempty"`
+	// Configuration for preview queries.
+	QueryPreviewConfig *map[string]string `json:"queryPreviewConfig,omitempty"`
+	// Query config for this connection.
+	QueryConfig *string `json:"queryConfig,omitempty"`
+	// Credential strategy to use for this connection for queries.
+	CredentialStrategy *string `json:"credentialStrategy,omitempty"`
+	// Credential strategy to use for this connection for preview queries.
+	PreviewCredentialStrategy *string `json:"previewCredentialStrategy,omitempty"`
+	// Policy strategy is a configuration that determines whether the Atlan policy will be applied to the results of insight queries and whether the query will be rewritten, applicable for stream api call made from insight screen
+	PolicyStrategy *string `json:"policyStrategy,omitempty"`
+	// Username strategy to use for this connection for queries.
+	QueryUsernameStrategy *string `json:"queryUsernameStrategy,omitempty"`
+	// Maximum number of rows that can be returned for the source.
+	RowLimit *int64 `json:"rowLimit,omitempty"`
+	// Maximum time a query should be allowed to run before timing out.
+	QueryTimeout *int64 `json:"queryTimeout,omitempty"`
+	// Unique identifier (GUID) for the default credentials to use for this connection.
+	DefaultCredentialGuid *string `json:"defaultCredentialGuid,omitempty"`
+	// Unused. Only the value of connectorType impacts icons.
+	ConnectorIcon *string `json:"connectorIcon,omitempty"`
+	// Unused. Only the value of connectorType impacts icons.
+	ConnectorImage *string `json:"connectorImage,omitempty"`
+	// Unused. Only the value of connectorType impacts icons.
+	SourceLogo *string `json:"sourceLogo,omitempty"`
+	// Whether sample data can be previewed for this connection (true) or not (false).
+	IsSampleDataPreviewEnabled *bool `json:"isSampleDataPreviewEnabled,omitempty"`
+	// Number of days over which popularity is calculated, for example 30 days.
+	PopularityInsightsTimeframe *int `json:"popularityInsightsTimeframe,omitempty"`
+	// Whether this connection has popularity insights (true) or not (false).
+	HasPopularityInsights     *bool     `json:"hasPopularityInsights,omitempty"`
+	ConnectionDbtEnvironments *[]string `json:"connectionDbtEnvironments,omitempty"`
+	// Unique identifier (GUID) for the SSO credentials to use for this connection.
+	ConnectionSSOCredentialGuid *string `json:"connectionSSOCredentialGuid,omitempty"`
+	// Whether to upload to S3, GCP, or another storage location (true) or not (false).
+	UseObjectStorage *bool `json:"useObjectStorage,omitempty"`
+	// Number of rows after which results should be uploaded to storage.
+	ObjectStorageUploadThreshold *int64 `json:"objectStorageUploadThreshold,omitempty"`
+}
+
+// ConnectionRelationshipAttributes holds the relationship attributes of Connection entities.
+type ConnectionRelationshipAttributes struct {
+	AssetRelationshipAttributes
+}
+
+// MarshalJSON encodes the Connection with the attributes and relationship attributes that are set.
+func (e *Connection) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Connection", e.Entity, e.ConnectionAttributes, e.ConnectionRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Connection with its attributes and relationship attributes.
+func (e *Connection) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.ConnectionAttributes, &e.ConnectionRelationshipAttributes)
+}
+
+// ToJSON encodes the Connection as indented JSON.
+func (e *Connection) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Connection, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Connection) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new connection, named name, for the given
+// type of connector. At least one admin user or group is required.
+func (e *Connection) Creator(name string, connectorType atlan.AtlanConnectorType, adminUsers, adminGroups []string) error {
+	if name == "" || connectorType.Value == "" {
+		return errors.New("name and connectorType are required fields")
+	}
+	if len(adminUsers) == 0 && len(adminGroups) == 0 {
+		return errors.New("at least one admin user or group is required")
+	}
+	e.TypeName = structs.StringPtr("Connection")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(connectorType.ToQualifiedName())
+	e.ConnectorName = structs.StringPtr(connectorType.Value)
+	e.Category = structs.StringPtr(connectorType.Category.Name)
+	if len(adminUsers) > 0 {
+		e.AdminUsers = &adminUsers
+	}
+	if len(adminGroups) > 0 {
+		e.AdminGroups = &adminGroups
+	}
+	return nil
+}
+
+// Updater sets the attributes required to update the Connection with the given name and qualified name.
+func (e *Connection) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Connection")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Connection with only the attributes required to update it.
+func (e *Connection) TrimToRequired() (*Connection, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Connection")
+	}
+	trimmed := &Connection{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// DataContract is an entity of type DataContract.
+// DataContract: Instance of a data contract for an asset in Atlan.
+type DataContract struct {
+	Entity
+	DataContractAttributes
+	DataContractRelationshipAttributes
+}
+
+// DataContractAttributes holds the attributes of DataContract entities.
+type DataContractAttributes struct {
+	CatalogAttributes
+	// (Deprecated) Replaced by dataContractSpec.
+	DataContractJson *string `json:"dataContractJson,omitempty"`
+	// Actual content of the contract in YAML.
+	DataContractSpec *string `json:"dataContractSpec,omitempty"`
+	// Version of the contract.
+	DataContractVersion *int `json:"dataContractVersion,omitempty"`
+	// Unique identifier of the asset associated with the contract.
+	DataContractAssetGuid *string `json:"dataContractAssetGuid,omitempty"`
+}
+
+// DataContractRelationshipAttributes holds the relationship attributes of DataContract entities.
+type DataContractRelationshipAttributes struct {
+	CatalogRelationshipAttributes
+	// Asset the contract is the latest certified version for.
+	DataContractAssetCertified *Reference `json:"dataContractAssetCertified,omitempty"`
+	// Asset the contract is the latest version for.
+	DataContractAssetLatest *Reference `json:"dataContractAssetLatest,omitempty"`
+	// Previous version of the contract.
+	DataContractPreviousVersion *Reference `json:"dataContractPreviousVersion,omitempty"`
+	// Next version of the contract.
+	DataContractNextVersion *Reference `json:"dataContractNextVersion,omitempty"`
+}
+
+// MarshalJSON encodes the DataContract with the attributes and relationship attributes that are set.
+func (e *DataContract) MarshalJSON() ([]byte, error) {
+	return marshalEntity("DataContract", e.Entity, e.DataContractAttributes, e.DataContractRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a DataContract with its attributes and relationship attributes.
+func (e *DataContract) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.DataContractAttributes, &e.DataContractRelationshipAttributes)
+}
+
+// ToJSON encodes the DataContract as indented JSON.
+func (e *DataContract) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a DataContract, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *DataContract) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the DataContract with the given name and qualified name.
+func (e *DataContract) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("DataContract")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the DataContract with only the attributes required to update it.
+func (e *DataContract) TrimToRequired() (*DataContract, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a DataContract")
+	}
+	trimmed := &DataContract{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Database is an entity of type Database.
+// Database: Instance of a database in Atlan.
+type Database struct {
+	Entity
+	DatabaseAttributes
+	DatabaseRelationshipAttributes
+}
+
+// DatabaseAttributes holds the attributes of Database entities.
+type DatabaseAttributes struct {
+	SQLAttributes
+	// Number of schemas in this database.
+	SchemaCount *int `json:"schemaCount,omitempty"`
+}
+
+// DatabaseRelationshipAttributes holds the relationship attributes of Database entities.
+type DatabaseRelationshipAttributes struct {
+	SQLRelationshipAttributes
+	// Schemas that exist within this database.
+	Schemas *[]Reference `json:"schemas,omitempty"`
+}
+
+// MarshalJSON encodes the Database with the attributes and relationship attributes that are set.
+func (e *Database) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Database", e.Entity, e.DatabaseAttributes, e.DatabaseRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Database with its attributes and relationship attributes.
+func (e *Database) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.DatabaseAttributes, &e.DatabaseRelationshipAttributes)
+}
+
+// ToJSON encodes the Database as indented JSON.
+func (e *Database) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Database, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Database) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new Database, named name, in the
+// connection with the given qualified name.
+func (e *Database) Creator(name, connectionQualifiedName string) error {
+	if name == "" || connectionQualifiedName == "" {
+		return errors.New("name and connectionQualifiedName are required fields")
+	}
+	hierarchy, err := parseHierarchy(connectionQualifiedName, 0)
+	if err != nil {
+		return err
+	}
+	e.TypeName = structs.StringPtr("Database")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(connectionQualifiedName + "/" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	return nil
+}
+
+// Updater sets the attributes required to update the Database with the given name and qualified name.
+func (e *Database) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Database")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Database with only the attributes required to update it.
+func (e *Database) TrimToRequired() (*Database, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Database")
+	}
+	trimmed := &Database{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Dbt is an entity of type Dbt.
+// Dbt: Base class for dbt assets.
+type Dbt struct {
+	Entity
+	DbtAttributes
+	DbtRelationshipAttributes
+}
+
+// DbtAttributes holds the attributes of Dbt entities.
+type DbtAttributes struct {
+	CatalogAttributes
+	DbtAlias                 *string `json:"dbtAlias,omitempty"`
+	DbtMeta                  *string `json:"dbtMeta,omitempty"`
+	DbtUniqueId              *string `json:"dbtUniqueId,omitempty"`
+	DbtAccountName           *string `json:"dbtAccountName,omitempty"`
+	DbtProjectName           *string `json:"dbtProjectName,omitempty"`
+	DbtPackageName           *string `json:"dbtPackageName,omitempty"`
+	DbtJobName               *string `json:"dbtJobName,omitempty"`
+	DbtJobSchedule           *string `json:"dbtJobSchedule,omitempty"`
+	DbtJobStatus             *string `json:"dbtJobStatus,omitempty"`
+	DbtJobLastRun            *int64  `json:"dbtJobLastRun,omitempty"`
+	DbtJobNextRun            *int64  `json:"dbtJobNextRun,omitempty"`
+	DbtEnvironmentName       *string `json:"dbtEnvironmentName,omitempty"`
+	DbtConnectionContext     *string `json:"dbtConnectionContext,omitempty"`
+	DbtSemanticLayerProxyUrl *string `json:"dbtSemanticLayerProxyUrl,omitempty"`
+	// List of tags attached to this asset.
+	DbtTags *[]string `json:"dbtTags,omitempty"`
+}
+
+// DbtRelationshipAttributes holds the relationship attributes of Dbt entities.
+type DbtRelationshipAttributes struct {
+	CatalogRelationshipAttributes
+}
+
+// MarshalJSON encodes the Dbt with the attributes and relationship attributes that are set.
+func (e *Dbt) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Dbt", e.Entity, e.DbtAttributes, e.DbtRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Dbt with its attributes and relationship attributes.
+func (e *Dbt) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.DbtAttributes, &e.DbtRelationshipAttributes)
+}
+
+// ToJSON encodes the Dbt as indented JSON.
+func (e *Dbt) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Dbt, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Dbt) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Dbt with the given name and qualified name.
+func (e *Dbt) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Dbt")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Dbt with only the attributes required to update it.
+func (e *Dbt) TrimToRequired() (*Dbt, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Dbt")
+	}
+	trimmed := &Dbt{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// DbtModel is an entity of type DbtModel.
+// DbtModel: Instance of a dbt model in Atlan.
+type DbtModel struct {
+	Entity
+	DbtModelAttributes
+	DbtModelRelationshipAttributes
+}
+
+// DbtModelAttributes holds the attributes of DbtModel entities.
+type DbtModelAttributes struct {
+	DbtAttributes
+	DbtStatus              *string  `json:"dbtStatus,omitempty"`
+	DbtError               *string  `json:"dbtError,omitempty"`
+	DbtRawSQL              *string  `json:"dbtRawSQL,omitempty"`
+	DbtCompiledSQL         *string  `json:"dbtCompiledSQL,omitempty"`
+	DbtStats               *string  `json:"dbtStats,omitempty"`
+	DbtMaterializationType *string  `json:"dbtMaterializationType,omitempty"`
+	DbtModelCompiledAt     *int64   `json:"dbtModelCompiledAt,omitempty"`
+	DbtModelExecutionTime  *float64 `json:"dbtModelExecutionTime,omitempty"`
+}
+
+// DbtModelRelationshipAttributes holds the relationship attributes of DbtModel entities.
+type DbtModelRelationshipAttributes struct {
+	DbtRelationshipAttributes
+	DbtModelColumns *[]Reference `json:"dbtModelColumns,omitempty"`
+	SqlAsset        *Reference   `json:"sqlAsset,omitempty"`
+	DbtTests        *[]Reference `json:"dbtTests,omitempty"`
+}
+
+// MarshalJSON encodes the DbtModel with the attributes and relationship attributes that are set.
+func (e *DbtModel) MarshalJSON() ([]byte, error) {
+	return marshalEntity("DbtModel", e.Entity, e.DbtModelAttributes, e.DbtModelRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a DbtModel with its attributes and relationship attributes.
+func (e *DbtModel) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.DbtModelAttributes, &e.DbtModelRelationshipAttributes)
+}
+
+// ToJSON encodes the DbtModel as indented JSON.
+func (e *DbtModel) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a DbtModel, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *DbtModel) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the DbtModel with the given name and qualified name.
+func (e *DbtModel) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("DbtModel")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the DbtModel with only the attributes required to update it.
+func (e *DbtModel) TrimToRequired() (*DbtModel, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a DbtModel")
+	}
+	trimmed := &DbtModel{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// DbtModelColumn is an entity of type DbtModelColumn.
+// DbtModelColumn: Instance of a column of a dbt model in Atlan.
+type DbtModelColumn struct {
+	Entity
+	DbtModelColumnAttributes
+	DbtModelColumnRelationshipAttributes
+}
+
+// DbtModelColumnAttributes holds the attributes of DbtModelColumn entities.
+type DbtModelColumnAttributes struct {
+	DbtAttributes
+	DbtModelQualifiedName  *string `json:"dbtModelQualifiedName,omitempty"`
+	DbtModelColumnDataType *string `json:"dbtModelColumnDataType,omitempty"`
+	DbtModelColumnOrder    *int    `json:"dbtModelColumnOrder,omitempty"`
+}
+
+// DbtModelColumnRelationshipAttributes holds the relationship attributes of DbtModelColumn entities.
+type DbtModelColumnRelationshipAttributes struct {
+	DbtRelationshipAttributes
+	DbtModel  *Reference   `json:"dbtModel,omitempty"`
+	SqlColumn *Reference   `json:"sqlColumn,omitempty"`
+	DbtTests  *[]Reference `json:"dbtTests,omitempty"`
+}
+
+// MarshalJSON encodes the DbtModelColumn with the attributes and relationship attributes that are set.
+func (e *DbtModelColumn) MarshalJSON() ([]byte, error) {
+	return marshalEntity("DbtModelColumn", e.Entity, e.DbtModelColumnAttributes, e.DbtModelColumnRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a DbtModelColumn with its attributes and relationship attributes.
+func (e *DbtModelColumn) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.DbtModelColumnAttributes, &e.DbtModelColumnRelationshipAttributes)
+}
+
+// ToJSON encodes the DbtModelColumn as indented JSON.
+func (e *DbtModelColumn) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a DbtModelColumn, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *DbtModelColumn) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the DbtModelColumn with the given name and qualified name.
+func (e *DbtModelColumn) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("DbtModelColumn")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the DbtModelColumn with only the attributes required to update it.
+func (e *DbtModelColumn) TrimToRequired() (*DbtModelColumn, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a DbtModelColumn")
+	}
+	trimmed := &DbtModelColumn{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// DbtSource is an entity of type DbtSource.
+// DbtSource: Instance of a dbt source in Atlan.
+type DbtSource struct {
+	Entity
+	DbtSourceAttributes
+	DbtSourceRelationshipAttributes
+}
+
+// DbtSourceAttributes holds the attributes of DbtSource entities.
+type DbtSourceAttributes struct {
+	DbtAttributes
+	DbtState             *string `json:"dbtState,omitempty"`
+	DbtFreshnessCriteria *string `json:"dbtFreshnessCriteria,omitempty"`
+}
+
+// DbtSourceRelationshipAttributes holds the relationship attributes of DbtSource entities.
+type DbtSourceRelationshipAttributes struct {
+	DbtRelationshipAttributes
+	SqlAssets *[]Reference `json:"sqlAssets,omitempty"`
+	SqlAsset  *Reference   `json:"sqlAsset,omitempty"`
+	DbtTests  *[]Reference `json:"dbtTests,omitempty"`
+}
+
+// MarshalJSON encodes the DbtSource with the attributes and relationship attributes that are set.
+func (e *DbtSource) MarshalJSON() ([]byte, error) {
+	return marshalEntity("DbtSource", e.Entity, e.DbtSourceAttributes, e.DbtSourceRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a DbtSource with its attributes and relationship attributes.
+func (e *DbtSource) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.DbtSourceAttributes, &e.DbtSourceRelationshipAttributes)
+}
+
+// ToJSON encodes the DbtSource as indented JSON.
+func (e *DbtSource) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a DbtSource, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *DbtSource) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the DbtSource with the given name and qualified name.
+func (e *DbtSource) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("DbtSource")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the DbtSource with only the attributes required to update it.
+func (e *DbtSource) TrimToRequired() (*DbtSource, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a DbtSource")
+	}
+	trimmed := &DbtSource{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// DbtTest is an entity of type DbtTest.
+// DbtTest: Instance of a dbt test in Atlan.
+type DbtTest struct {
+	Entity
+	DbtTestAttributes
+	DbtTestRelationshipAttributes
+}
+
+// DbtTestAttributes holds the attributes of DbtTest entities.
+type DbtTestAttributes struct {
+	DbtAttributes
+	// Details of the results of the test. For errors, it reads "ERROR".
+	DbtTestStatus *string `json:"dbtTestStatus,omitempty"`
+	// Test results. Can be one of, in order of severity, "error", "fail", "warn", "pass".
+	DbtTestState *string `json:"dbtTestState,omitempty"`
+	// Error message in the case of state being "error".
+	DbtTestError *string `json:"dbtTestError,omitempty"`
+	// Raw SQL of the test.
+	DbtTestRawSQL *string `json:"dbtTestRawSQL,omitempty"`
+	// Compiled SQL of the test.
+	DbtTestCompiledSQL *string `json:"dbtTestCompiledSQL,omitempty"`
+	// Raw code of the test (when the test is defined using Python).
+	DbtTestRawCode *string `json:"dbtTestRawCode,omitempty"`
+	// Compiled code of the test (when the test is defined using Python).
+	DbtTestCompiledCode *string `json:"dbtTestCompiledCode,omitempty"`
+	// Language in which the test is written, for example: SQL or Python.
+	DbtTestLanguage *string `json:"dbtTestLanguage,omitempty"`
+}
+
+// DbtTestRelationshipAttributes holds the relationship attributes of DbtTest entities.
+type DbtTestRelationshipAttributes struct {
+	DbtRelationshipAttributes
+	DbtSources      *[]Reference `json:"dbtSources,omitempty"`
+	SqlAssets       *[]Reference `json:"sqlAssets,omitempty"`
+	DbtModels       *[]Reference `json:"dbtModels,omitempty"`
+	DbtModelColumns *[]Reference `json:"dbtModelColumns,omitempty"`
+}
+
+// MarshalJSON encodes the DbtTest with the attributes and relationship attributes that are set.
+func (e *DbtTest) MarshalJSON() ([]byte, error) {
+	return marshalEntity("DbtTest", e.Entity, e.DbtTestAttributes, e.DbtTestRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a DbtTest with its attributes and relationship attributes.
+func (e *DbtTest) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.DbtTestAttributes, &e.DbtTestRelationshipAttributes)
+}
+
+// ToJSON encodes the DbtTest as indented JSON.
+func (e *DbtTest) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a DbtTest, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *DbtTest) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the DbtTest with the given name and qualified name.
+func (e *DbtTest) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("DbtTest")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the DbtTest with only the attributes required to update it.
+func (e *DbtTest) TrimToRequired() (*DbtTest, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a DbtTest")
+	}
+	trimmed := &DbtTest{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// EventStore is an entity of type EventStore.
+// EventStore: Base class for event store assets.
+type EventStore struct {
+	Entity
+	EventStoreAttributes
+	EventStoreRelationshipAttributes
+}
+
+// EventStoreAttributes holds the attributes of EventStore entities.
+type EventStoreAttributes struct {
+	CatalogAttributes
+}
+
+// EventStoreRelationshipAttributes holds the relationship attributes of EventStore entities.
+type EventStoreRelationshipAttributes struct {
+	CatalogRelationshipAttributes
+}
+
+// MarshalJSON encodes the EventStore with the attributes and relationship attributes that are set.
+func (e *EventStore) MarshalJSON() ([]byte, error) {
+	return marshalEntity("EventStore", e.Entity, e.EventStoreAttributes, e.EventStoreRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a EventStore with its attributes and relationship attributes.
+func (e *EventStore) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.EventStoreAttributes, &e.EventStoreRelationshipAttributes)
+}
+
+// ToJSON encodes the EventStore as indented JSON.
+func (e *EventStore) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a EventStore, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *EventStore) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the EventStore with the given name and qualified name.
+func (e *EventStore) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("EventStore")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the EventStore with only the attributes required to update it.
+func (e *EventStore) TrimToRequired() (*EventStore, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a EventStore")
+	}
+	trimmed := &EventStore{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Kafka is an entity of type Kafka.
+// Kafka: Base class for Kafka assets.
+type Kafka struct {
+	Entity
+	KafkaAttributes
+	KafkaRelationshipAttributes
+}
+
+// KafkaAttributes holds the attributes of Kafka entities.
+type KafkaAttributes struct {
+	EventStoreAttributes
+}
+
+// KafkaRelationshipAttributes holds the relationship attributes of Kafka entities.
+type KafkaRelationshipAttributes struct {
+	EventStoreRelationshipAttributes
+}
+
+// MarshalJSON encodes the Kafka with the attributes and relationship attributes that are set.
+func (e *Kafka) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Kafka", e.Entity, e.KafkaAttributes, e.KafkaRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Kafka with its attributes and relationship attributes.
+func (e *Kafka) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.KafkaAttributes, &e.KafkaRelationshipAttributes)
+}
+
+// ToJSON encodes the Kafka as indented JSON.
+func (e *Kafka) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Kafka, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Kafka) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Kafka with the given name and qualified name.
+func (e *Kafka) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Kafka")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Kafka with only the attributes required to update it.
+func (e *Kafka) TrimToRequired() (*Kafka, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Kafka")
+	}
+	trimmed := &Kafka{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// KafkaConsumerGroup is an entity of type KafkaConsumerGroup.
+// KafkaConsumerGroup: Instance of a Kafka consumer group in Atlan.
+type KafkaConsumerGroup struct {
+	Entity
+	KafkaConsumerGroupAttributes
+	KafkaConsumerGroupRelationshipAttributes
+}
+
+// KafkaConsumerGroupAttributes holds the attributes of KafkaConsumerGroup entities.
+type KafkaConsumerGroupAttributes struct {
+	KafkaAttributes
+	// List of consumption properties for Kafka topics, for this consumer group.
+	KafkaConsumerGroupTopicConsumptionProperties *[]Struct `json:"kafkaConsumerGroupTopicConsumptionProperties,omitempty"`
+	// Number of members in this consumer group.
+	KafkaConsumerGroupMemberCount *int64 `json:"kafkaConsumerGroupMemberCount,omitempty"`
+	// Simple names of the topics consumed by this consumer group.
+	KafkaTopicNames *[]string `json:"kafkaTopicNames,omitempty"`
+	// Unique names of the topics consumed by this consumer group.
+	KafkaTopicQualifiedNames *[]string `json:"kafkaTopicQualifiedNames,omitempty"`
+}
+
+// KafkaConsumerGroupRelationshipAttributes holds the relationship attributes of KafkaConsumerGroup entities.
+type KafkaConsumerGroupRelationshipAttributes struct {
+	KafkaRelationshipAttributes
+	// Topics consumed by this consumer group.
+	KafkaTopics *[]Reference `json:"kafkaTopics,omitempty"`
+}
+
+// MarshalJSON encodes the KafkaConsumerGroup with the attributes and relationship attributes that are set.
+func (e *KafkaConsumerGroup) MarshalJSON() ([]byte, error) {
+	return marshalEntity("KafkaConsumerGroup", e.Entity, e.KafkaConsumerGroupAttributes, e.KafkaConsumerGroupRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a KafkaConsumerGroup with its attributes and relationship attributes.
+func (e *KafkaConsumerGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.KafkaConsumerGroupAttributes, &e.KafkaConsumerGroupRelationshipAttributes)
+}
+
+// ToJSON encodes the KafkaConsumerGroup as indented JSON.
+func (e *KafkaConsumerGroup) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a KafkaConsumerGroup, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *KafkaConsumerGroup) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the KafkaConsumerGroup with the given name and qualified name.
+func (e *KafkaConsumerGroup) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("KafkaConsumerGroup")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the KafkaConsumerGroup with only the attributes required to update it.
+func (e *KafkaConsumerGroup) TrimToRequired() (*KafkaConsumerGroup, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a KafkaConsumerGroup")
+	}
+	trimmed := &KafkaConsumerGroup{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// KafkaTopic is an entity of type KafkaTopic.
+// KafkaTopic: Instance of a Kafka topic in Atlan.
+type KafkaTopic struct {
+	Entity
+	KafkaTopicAttributes
+	KafkaTopicRelationshipAttributes
+}
+
+// KafkaTopicAttributes holds the attributes of KafkaTopic entities.
+type KafkaTopicAttributes struct {
+	KafkaAttributes
+	// Whether this topic is an internal topic (true) or not (false).
+	KafkaTopicIsInternal *bool `json:"kafkaTopicIsInternal,omitempty"`
+	// Type of compression used for this topic.
+	KafkaTopicCompressionType *string `json:"kafkaTopicCompressionType,omitempty"`
+	// Replication factor for this topic.
+	KafkaTopicReplicationFactor *int64 `json:"kafkaTopicReplicationFactor,omitempty"`
+	// Segment size for this topic.
+	KafkaTopicSegmentBytes *int64 `json:"kafkaTopicSegmentBytes,omitempty"`
+	// Amount of time messages will be retained in this topic, in milliseconds.
+	KafkaTopicRetentionTimeInMs *int64 `json:"kafkaTopicRetentionTimeInMs,omitempty"`
+	// Number of partitions for this topic.
+	KafkaTopicPartitionsCount *int64 `json:"kafkaTopicPartitionsCount,omitempty"`
+	// Size of this topic, in bytes.
+	KafkaTopicSizeInBytes *int64 `json:"kafkaTopicSizeInBytes,omitempty"`
+	// Number of (unexpired) messages in this topic.
+	KafkaTopicRecordCount *int64 `json:"kafkaTopicRecordCount,omitempty"`
+	// Cleanup policy for this topic.
+	KafkaTopicCleanupPolicy *string `json:"kafkaTopicCleanupPolicy,omitempty"`
+}
+
+// KafkaTopicRelationshipAttributes holds the relationship attributes of KafkaTopic entities.
+type KafkaTopicRelationshipAttributes struct {
+	KafkaRelationshipAttributes
+	// Consumer groups subscribed to this topic.
+	KafkaConsumerGroups *[]Reference `json:"kafkaConsumerGroups,omitempty"`
+}
+
+// MarshalJSON encodes the KafkaTopic with the attributes and relationship attributes that are set.
+func (e *KafkaTopic) MarshalJSON() ([]byte, error) {
+	return marshalEntity("KafkaTopic", e.Entity, e.KafkaTopicAttributes, e.KafkaTopicRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a KafkaTopic with its attributes and relationship attributes.
+func (e *KafkaTopic) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.KafkaTopicAttributes, &e.KafkaTopicRelationshipAttributes)
+}
+
+// ToJSON encodes the KafkaTopic as indented JSON.
+func (e *KafkaTopic) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a KafkaTopic, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *KafkaTopic) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new KafkaTopic, named name, in the
+// connection with the given qualified name.
+func (e *KafkaTopic) Creator(name, connectionQualifiedName string) error {
+	if name == "" || connectionQualifiedName == "" {
+		return errors.New("name and connectionQualifiedName are required fields")
+	}
+	hierarchy, err := parseHierarchy(connectionQualifiedName, 0)
+	if err != nil {
+		return err
+	}
+	e.TypeName = structs.StringPtr("KafkaTopic")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(connectionQualifiedName + "/topic/" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	return nil
+}
+
+// Updater sets the attributes required to update the KafkaTopic with the given name and qualified name.
+func (e *KafkaTopic) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("KafkaTopic")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the KafkaTopic with only the attributes required to update it.
+func (e *KafkaTopic) TrimToRequired() (*KafkaTopic, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a KafkaTopic")
+	}
+	trimmed := &KafkaTopic{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// MaterialisedView is an entity of type MaterialisedView.
+// MaterialisedView: Instance of a database materialized view in Atlan.
+type MaterialisedView struct {
+	Entity
+	MaterialisedViewAttributes
+	MaterialisedViewRelationshipAttributes
+}
+
+// MaterialisedViewAttributes holds the attributes of MaterialisedView entities.
+type MaterialisedViewAttributes struct {
+	SQLAttributes
+	// Refresh mode for this materialized view.
+	RefreshMode *string `json:"refreshMode,omitempty"`
+	// Refresh method for this materialized view.
+	RefreshMethod *string `json:"refreshMethod,omitempty"`
+	// Staleness of this materialized view.
+	Staleness *string `json:"staleness,omitempty"`
+	// Time (epoch) from which this materialized view is stale, in milliseconds.
+	StaleSinceDate *int64 `json:"staleSinceDate,omitempty"`
+	// Number of columns in this table.
+	ColumnCount *int64 `json:"columnCount,omitempty"`
+	// Number of rows in this table.
+	RowCount *int64 `json:"rowCount,omitempty"`
+	// Size of this table, in bytes.
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	// Alias for this table.
+	Alias *string `json:"alias,omitempty"`
+	// Whether this table is temporary (true) or not (false).
+	IsTemporary *bool `json:"isTemporary,omitempty"`
+	// Whether preview queries are allowed for this table (true) or not (false).
+	IsQueryPreview *bool `json:"isQueryPreview,omitempty"`
+	// Configuration for preview queries.
+	QueryPreviewConfig *map[string]string `json:"queryPreviewConfig,omitempty"`
+	// SQL definition of this materialized view.
+	Definition *string `json:"definition,omitempty"`
+}
+
+// MaterialisedViewRelationshipAttributes holds the relationship attributes of MaterialisedView entities.
+type MaterialisedViewRelationshipAttributes struct {
+	SQLRelationshipAttributes
+	// Columns that exist within this materialized view.
+	Columns *[]Reference `json:"columns,omitempty"`
+	// Schema in which this materialized view exists.
+	AtlanSchema *Reference `json:"atlanSchema,omitempty"`
+}
+
+// MarshalJSON encodes the MaterialisedView with the attributes and relationship attributes that are set.
+func (e *MaterialisedView) MarshalJSON() ([]byte, error) {
+	return marshalEntity("MaterialisedView", e.Entity, e.MaterialisedViewAttributes, e.MaterialisedViewRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a MaterialisedView with its attributes and relationship attributes.
+func (e *MaterialisedView) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.MaterialisedViewAttributes, &e.MaterialisedViewRelationshipAttributes)
+}
+
+// ToJSON encodes the MaterialisedView as indented JSON.
+func (e *MaterialisedView) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a MaterialisedView, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *MaterialisedView) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new MaterialisedView, named name, in the
+// schema with the given qualified name.
+func (e *MaterialisedView) Creator(name, schemaQualifiedName string) error {
+	if name == "" || schemaQualifiedName == "" {
+		return errors.New("name and schemaQualifiedName are required fields")
+	}
+	hierarchy, err := parseHierarchy(schemaQualifiedName, 2)
+	if err != nil {
+		return err
+	}
+	e.SchemaName = structs.StringPtr(hierarchy.names[1])
+	e.SchemaQualifiedName = structs.StringPtr(schemaQualifiedName)
+	e.AtlanSchema = RefByQualifiedName("Schema", schemaQualifiedName)
+	e.TypeName = structs.StringPtr("MaterialisedView")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(schemaQualifiedName + "/" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	e.DatabaseName = structs.StringPtr(hierarchy.names[0])
+	e.DatabaseQualifiedName = structs.StringPtr(hierarchy.qualifiedNames[0])
+	return nil
+}
+
+// Updater sets the attributes required to update the MaterialisedView with the given name and qualified name.
+func (e *MaterialisedView) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("MaterialisedView")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the MaterialisedView with only the attributes required to update it.
+func (e *MaterialisedView) TrimToRequired() (*MaterialisedView, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a MaterialisedView")
+	}
+	trimmed := &MaterialisedView{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// ObjectStore is an entity of type ObjectStore.
+// ObjectStore: Base class for object store assets.
+type ObjectStore struct {
+	Entity
+	ObjectStoreAttributes
+	ObjectStoreRelationshipAttributes
+}
+
+// ObjectStoreAttributes holds the attributes of ObjectStore entities.
+type ObjectStoreAttributes struct {
+	CatalogAttributes
+}
+
+// ObjectStoreRelationshipAttributes holds the relationship attributes of ObjectStore entities.
+type ObjectStoreRelationshipAttributes struct {
+	CatalogRelationshipAttributes
+}
+
+// MarshalJSON encodes the ObjectStore with the attributes and relationship attributes that are set.
+func (e *ObjectStore) MarshalJSON() ([]byte, error) {
+	return marshalEntity("ObjectStore", e.Entity, e.ObjectStoreAttributes, e.ObjectStoreRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a ObjectStore with its attributes and relationship attributes.
+func (e *ObjectStore) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.ObjectStoreAttributes, &e.ObjectStoreRelationshipAttributes)
+}
+
+// ToJSON encodes the ObjectStore as indented JSON.
+func (e *ObjectStore) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a ObjectStore, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *ObjectStore) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the ObjectStore with the given name and qualified name.
+func (e *ObjectStore) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("ObjectStore")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the ObjectStore with only the attributes required to update it.
+func (e *ObjectStore) TrimToRequired() (*ObjectStore, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a ObjectStore")
+	}
+	trimmed := &ObjectStore{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Persona is an entity of type Persona.
+// Persona: Instance of a persona in Atlan, which grants its users and groups access to assets.
+type Persona struct {
+	Entity
+	PersonaAttributes
+	PersonaRelationshipAttributes
+}
+
+// PersonaAttributes holds the attributes of Persona entities.
+type PersonaAttributes struct {
+	AccessControlAttributes
+	// Groups to which the persona is assigned.
+	PersonaGroups *[]string `json:"personaGroups,omitempty"`
+	// Users to whom the persona is assigned.
+	PersonaUsers *[]string `json:"personaUsers,omitempty"`
+	// ID of the role that backs the persona.
+	RoleId *string `json:"roleId,omitempty"`
+}
+
+// PersonaRelationshipAttributes holds the relationship attributes of Persona entities.
+type PersonaRelationshipAttributes struct {
+	AccessControlRelationshipAttributes
+}
+
+// MarshalJSON encodes the Persona with the attributes and relationship attributes that are set.
+func (e *Persona) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Persona", e.Entity, e.PersonaAttributes, e.PersonaRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Persona with its attributes and relationship attributes.
+func (e *Persona) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.PersonaAttributes, &e.PersonaRelationshipAttributes)
+}
+
+// ToJSON encodes the Persona as indented JSON.
+func (e *Persona) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Persona, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Persona) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Persona with the given name and qualified name.
+func (e *Persona) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Persona")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Persona with only the attributes required to update it.
+func (e *Persona) TrimToRequired() (*Persona, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Persona")
+	}
+	trimmed := &Persona{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// PowerBI is an entity of type PowerBI.
+// PowerBI: Base class for Power BI assets.
+type PowerBI struct {
+	Entity
+	PowerBIAttributes
+	PowerBIRelationshipAttributes
+}
+
+// PowerBIAttributes holds the attributes of PowerBI entities.
+type PowerBIAttributes struct {
+	BIAttributes
+	// Whether this asset is hidden in Power BI (true) or not (false).
+	PowerBIIsHidden *bool `json:"powerBIIsHidden,omitempty"`
+	// Unique name of the Power BI table in which this asset exists.
+	PowerBITableQualifiedName *string `json:"powerBITableQualifiedName,omitempty"`
+	// Format of this asset, as specified in the FORMAT_STRING of the MDX cell property.
+	PowerBIFormatString *string `json:"powerBIFormatString,omitempty"`
+	// Endorsement status of this asset, in Power BI.
+	PowerBIEndorsement *string `json:"powerBIEndorsement,omitempty"`
+}
+
+// PowerBIRelationshipAttributes holds the relationship attributes of PowerBI entities.
+type PowerBIRelationshipAttributes struct {
+	BIRelationshipAttributes
+}
+
+// MarshalJSON encodes the PowerBI with the attributes and relationship attributes that are set.
+func (e *PowerBI) MarshalJSON() ([]byte, error) {
+	return marshalEntity("PowerBI", e.Entity, e.PowerBIAttributes, e.PowerBIRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a PowerBI with its attributes and relationship attributes.
+func (e *PowerBI) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.PowerBIAttributes, &e.PowerBIRelationshipAttributes)
+}
+
+// ToJSON encodes the PowerBI as indented JSON.
+func (e *PowerBI) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a PowerBI, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *PowerBI) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the PowerBI with the given name and qualified name.
+func (e *PowerBI) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("PowerBI")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the PowerBI with only the attributes required to update it.
+func (e *PowerBI) TrimToRequired() (*PowerBI, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a PowerBI")
+	}
+	trimmed := &PowerBI{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// PowerBIDashboard is an entity of type PowerBIDashboard.
+// PowerBIDashboard: Instance of a Power BI dashboard in Atlan.
+type PowerBIDashboard struct {
+	Entity
+	PowerBIDashboardAttributes
+	PowerBIDashboardRelationshipAttributes
+}
+
+// PowerBIDashboardAttributes holds the attributes of PowerBIDashboard entities.
+type PowerBIDashboardAttributes struct {
+	PowerBIAttributes
+	// Unique name of the workspace in which this dashboard exists.
+	WorkspaceQualifiedName *string `json:"workspaceQualifiedName,omitempty"`
+	// Deprecated. See 'sourceUrl' instead.
+	WebUrl *string `json:"webUrl,omitempty"`
+	// Number of tiles in this table.
+	TileCount *int64 `json:"tileCount,omitempty"`
+}
+
+// PowerBIDashboardRelationshipAttributes holds the relationship attributes of PowerBIDashboard entities.
+type PowerBIDashboardRelationshipAttributes struct {
+	PowerBIRelationshipAttributes
+	// Workspace in which this dashboard exists.
+	Workspace *Reference `json:"workspace,omitempty"`
+}
+
+// MarshalJSON encodes the PowerBIDashboard with the attributes and relationship attributes that are set.
+func (e *PowerBIDashboard) MarshalJSON() ([]byte, error) {
+	return marshalEntity("PowerBIDashboard", e.Entity, e.PowerBIDashboardAttributes, e.PowerBIDashboardRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a PowerBIDashboard with its attributes and relationship attributes.
+func (e *PowerBIDashboard) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.PowerBIDashboardAttributes, &e.PowerBIDashboardRelationshipAttributes)
+}
+
+// ToJSON encodes the PowerBIDashboard as indented JSON.
+func (e *PowerBIDashboard) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a PowerBIDashboard, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *PowerBIDashboard) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the PowerBIDashboard with the given name and qualified name.
+func (e *PowerBIDashboard) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("PowerBIDashboard")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the PowerBIDashboard with only the attributes required to update it.
+func (e *PowerBIDashboard) TrimToRequired() (*PowerBIDashboard, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a PowerBIDashboard")
+	}
+	trimmed := &PowerBIDashboard{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// PowerBIReport is an entity of type PowerBIReport.
+// PowerBIReport: Instance of a Power BI report in Atlan.
+type PowerBIReport struct {
+	Entity
+	PowerBIReportAttributes
+	PowerBIReportRelationshipAttributes
+}
+
+// PowerBIReportAttributes holds the attributes of PowerBIReport entities.
+type PowerBIReportAttributes struct {
+	PowerBIAttributes
+	// Unique name of the workspace in which this report exists.
+	WorkspaceQualifiedName *string `json:"workspaceQualifiedName,omitempty"`
+	// Unique name of the dataset used to build this report.
+	DatasetQualifiedName *string `json:"datasetQualifiedName,omitempty"`
+	// Deprecated. See 'sourceUrl' instead.
+	WebUrl *string `json:"webUrl,omitempty"`
+	// Number of pages in this report.
+	PageCount *int64 `json:"pageCount,omitempty"`
+}
+
+// PowerBIReportRelationshipAttributes holds the relationship attributes of PowerBIReport entities.
+type PowerBIReportRelationshipAttributes struct {
+	PowerBIRelationshipAttributes
+	// Workspace in which this report exists.
+	Workspace *Reference `json:"workspace,omitempty"`
+}
+
+// MarshalJSON encodes the PowerBIReport with the attributes and relationship attributes that are set.
+func (e *PowerBIReport) MarshalJSON() ([]byte, error) {
+	return marshalEntity("PowerBIReport", e.Entity, e.PowerBIReportAttributes, e.PowerBIReportRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a PowerBIReport with its attributes and relationship attributes.
+func (e *PowerBIReport) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.PowerBIReportAttributes, &e.PowerBIReportRelationshipAttributes)
+}
+
+// ToJSON encodes the PowerBIReport as indented JSON.
+func (e *PowerBIReport) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a PowerBIReport, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *PowerBIReport) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the PowerBIReport with the given name and qualified name.
+func (e *PowerBIReport) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("PowerBIReport")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the PowerBIReport with only the attributes required to update it.
+func (e *PowerBIReport) TrimToRequired() (*PowerBIReport, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a PowerBIReport")
+	}
+	trimmed := &PowerBIReport{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// PowerBIWorkspace is an entity of type PowerBIWorkspace.
+// PowerBIWorkspace: Instance of a Power BI workspace in Atlan.
+type PowerBIWorkspace struct {
+	Entity
+	PowerBIWorkspaceAttributes
+	PowerBIWorkspaceRelationshipAttributes
+}
+
+// PowerBIWorkspaceAttributes holds the attributes of PowerBIWorkspace entities.
+type PowerBIWorkspaceAttributes struct {
+	PowerBIAttributes
+	// Deprecated.
+	WebUrl *string `json:"webUrl,omitempty"`
+	// Number of reports in this workspace.
+	ReportCount *int64 `json:"reportCount,omitempty"`
+	// Number of dashboards in this workspace.
+	DashboardCount *int64 `json:"dashboardCount,omitempty"`
+	// Number of datasets in this workspace.
+	DatasetCount *int64 `json:"datasetCount,omitempty"`
+	// Number of dataflows in this workspace.
+	DataflowCount *int64 `json:"dataflowCount,omitempty"`
+}
+
+// PowerBIWorkspaceRelationshipAttributes holds the relationship attributes of PowerBIWorkspace entities.
+type PowerBIWorkspaceRelationshipAttributes struct {
+	PowerBIRelationshipAttributes
+	// Reports that exist within this workspace.
+	Reports *[]Reference `json:"reports,omitempty"`
+	// Dashboards that exist within this workspace.
+	Dashboards *[]Reference `json:"dashboards,omitempty"`
+}
+
+// MarshalJSON encodes the PowerBIWorkspace with the attributes and relationship attributes that are set.
+func (e *PowerBIWorkspace) MarshalJSON() ([]byte, error) {
+	return marshalEntity("PowerBIWorkspace", e.Entity, e.PowerBIWorkspaceAttributes, e.PowerBIWorkspaceRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a PowerBIWorkspace with its attributes and relationship attributes.
+func (e *PowerBIWorkspace) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.PowerBIWorkspaceAttributes, &e.PowerBIWorkspaceRelationshipAttributes)
+}
+
+// ToJSON encodes the PowerBIWorkspace as indented JSON.
+func (e *PowerBIWorkspace) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a PowerBIWorkspace, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *PowerBIWorkspace) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the PowerBIWorkspace with the given name and qualified name.
+func (e *PowerBIWorkspace) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("PowerBIWorkspace")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the PowerBIWorkspace with only the attributes required to update it.
+func (e *PowerBIWorkspace) TrimToRequired() (*PowerBIWorkspace, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a PowerBIWorkspace")
+	}
+	trimmed := &PowerBIWorkspace{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Process is an entity of type Process.
+// Process: Instance of a lineage process in Atlan.
+type Process struct {
+	Entity
+	ProcessAttributes
+	ProcessRelationshipAttributes
+}
+
+// ProcessAttributes holds the attributes of Process entities.
+type ProcessAttributes struct {
+	AssetAttributes
+	// Assets that are inputs to this process.
+	Inputs *[]Reference `json:"inputs,omitempty"`
+	// Assets that are outputs from this process.
+	Outputs *[]Reference `json:"outputs,omitempty"`
+	// Code that ran within the process.
+	Code *string `json:"code,omitempty"`
+	// SQL query that ran to produce the outputs.
+	Sql *string `json:"sql,omitempty"`
+	// Parsed AST of the code or SQL statements that describe the logic of this process.
+	Ast *string `json:"ast,omitempty"`
+	// Additional Context of the ETL pipeline/notebook which creates the process.
+	AdditionalEtlContext *string `json:"additionalEtlContext,omitempty"`
+}
+
+// ProcessRelationshipAttributes holds the relationship attributes of Process entities.
+type ProcessRelationshipAttributes struct {
+	AssetRelationshipAttributes
+	// Processes that detail column-level lineage for this process.
+	ColumnProcesses *[]Reference `json:"columnProcesses,omitempty"`
+}
+
+// MarshalJSON encodes the Process with the attributes and relationship attributes that are set.
+func (e *Process) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Process", e.Entity, e.ProcessAttributes, e.ProcessRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Process with its attributes and relationship attributes.
+func (e *Process) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.ProcessAttributes, &e.ProcessRelationshipAttributes)
+}
+
+// ToJSON encodes the Process as indented JSON.
+func (e *Process) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Process, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Process) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Process with the given name and qualified name.
+func (e *Process) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Process")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Process with only the attributes required to update it.
+func (e *Process) TrimToRequired() (*Process, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Process")
+	}
+	trimmed := &Process{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Purpose is an entity of type Purpose.
+// Purpose: Instance of a purpose in Atlan, which grants access to the assets with some Atlan tags.
+type Purpose struct {
+	Entity
+	PurposeAttributes
+	PurposeRelationshipAttributes
+}
+
+// PurposeAttributes holds the attributes of Purpose entities.
+type PurposeAttributes struct {
+	AccessControlAttributes
+	// Atlan tags whose assets the purpose controls access to.
+	PurposeClassifications *[]string `json:"purposeClassifications,omitempty"`
+}
+
+// PurposeRelationshipAttributes holds the relationship attributes of Purpose entities.
+type PurposeRelationshipAttributes struct {
+	AccessControlRelationshipAttributes
+}
+
+// MarshalJSON encodes the Purpose with the attributes and relationship attributes that are set.
+func (e *Purpose) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Purpose", e.Entity, e.PurposeAttributes, e.PurposeRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Purpose with its attributes and relationship attributes.
+func (e *Purpose) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.PurposeAttributes, &e.PurposeRelationshipAttributes)
+}
+
+// ToJSON encodes the Purpose as indented JSON.
+func (e *Purpose) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Purpose, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Purpose) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Purpose with the given name and qualified name.
+func (e *Purpose) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Purpose")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Purpose with only the attributes required to update it.
+func (e *Purpose) TrimToRequired() (*Purpose, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Purpose")
+	}
+	trimmed := &Purpose{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Referenceable is an entity of type Referenceable.
+// Referenceable: Base type for everything that can be referenced.
+type Referenceable struct {
+	Entity
+	ReferenceableAttributes
+	ReferenceableRelationshipAttributes
+}
+
+// ReferenceableAttributes holds the attributes of Referenceable entities.
+type ReferenceableAttributes struct {
+	// Unique name for this asset. This is typically a concatenation of the asset's name onto its parent's qualifiedName.
+	QualifiedName *string `json:"qualifiedName,omitempty"`
+}
+
+// ReferenceableRelationshipAttributes holds the relationship attributes of Referenceable entities.
+type ReferenceableRelationshipAttributes struct {
+}
+
+// MarshalJSON encodes the Referenceable with the attributes and relationship attributes that are set.
+func (e *Referenceable) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Referenceable", e.Entity, e.ReferenceableAttributes, e.ReferenceableRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Referenceable with its attributes and relationship attributes.
+func (e *Referenceable) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.ReferenceableAttributes, &e.ReferenceableRelationshipAttributes)
+}
+
+// ToJSON encodes the Referenceable as indented JSON.
+func (e *Referenceable) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Referenceable, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Referenceable) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// S3 is an entity of type S3.
+// S3: Base class for S3 assets.
+type S3 struct {
+	Entity
+	S3Attributes
+	S3RelationshipAttributes
+}
+
+// S3Attributes holds the attributes of S3 entities.
+type S3Attributes struct {
+	ObjectStoreAttributes
+	// Entity tag for the asset. An entity tag is a hash of the object and represents changes to the contents of an object only, not its metadata.
+	S3ETag       *string `json:"s3ETag,omitempty"`
+	S3Encryption *string `json:"s3Encryption,omitempty"`
+	// Amazon Resource Name (ARN) for this asset. This uniquely identifies the asset in AWS, and thus must be unique across all AWS asset instances.
+	AwsArn *string `json:"awsArn,omitempty"`
+	// Group of AWS region and service objects.
+	AwsPartition *string `json:"awsPartition,omitempty"`
+	// Type of service in which the asset exists.
+	AwsService *string `json:"awsService,omitempty"`
+	// Physical region where the data center in which the asset exists is clustered.
+	AwsRegion *string `json:"awsRegion,omitempty"`
+	// 12-digit number that uniquely identifies an AWS account.
+	AwsAccountId *string `json:"awsAccountId,omitempty"`
+	// Unique resource ID assigned when a new resource is created.
+	AwsResourceId *string `json:"awsResourceId,omitempty"`
+	// Root user's name.
+	AwsOwnerName *string `json:"awsOwnerName,omitempty"`
+	// Root user's ID.
+	AwsOwnerId *string `json:"awsOwnerId,omitempty"`
+	// List of tags that have been applied to the asset in AWS.
+	AwsTags *[]Struct `json:"awsTags,omitempty"`
+}
+
+// S3RelationshipAttributes holds the relationship attributes of S3 entities.
+type S3RelationshipAttributes struct {
+	ObjectStoreRelationshipAttributes
+}
+
+// MarshalJSON encodes the S3 with the attributes and relationship attributes that are set.
+func (e *S3) MarshalJSON() ([]byte, error) {
+	return marshalEntity("S3", e.Entity, e.S3Attributes, e.S3RelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a S3 with its attributes and relationship attributes.
+func (e *S3) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.S3Attributes, &e.S3RelationshipAttributes)
+}
+
+// ToJSON encodes the S3 as indented JSON.
+func (e *S3) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a S3, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *S3) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the S3 with the given name and qualified name.
+func (e *S3) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("S3")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the S3 with only the attributes required to update it.
+func (e *S3) TrimToRequired() (*S3, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a S3")
+	}
+	trimmed := &S3{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// S3Bucket is an entity of type S3Bucket.
+// S3Bucket: Instance of an S3 bucket in Atlan.
+type S3Bucket struct {
+	Entity
+	S3BucketAttributes
+	S3BucketRelationshipAttributes
+}
+
+// S3BucketAttributes holds the attributes of S3Bucket entities.
+type S3BucketAttributes struct {
+	S3Attributes
+	// Number of objects within the bucket.
+	S3ObjectCount *int64 `json:"s3ObjectCount,omitempty"`
+	// Whether versioning is enabled for the bucket (true) or not (false).
+	S3BucketVersioningEnabled *bool `json:"s3BucketVersioningEnabled,omitempty"`
+}
+
+// S3BucketRelationshipAttributes holds the relationship attributes of S3Bucket entities.
+type S3BucketRelationshipAttributes struct {
+	S3RelationshipAttributes
+	// Objects that exist within this bucket.
+	Objects *[]Reference `json:"objects,omitempty"`
+}
+
+// MarshalJSON encodes the S3Bucket with the attributes and relationship attributes that are set.
+func (e *S3Bucket) MarshalJSON() ([]byte, error) {
+	return marshalEntity("S3Bucket", e.Entity, e.S3BucketAttributes, e.S3BucketRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a S3Bucket with its attributes and relationship attributes.
+func (e *S3Bucket) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.S3BucketAttributes, &e.S3BucketRelationshipAttributes)
+}
+
+// ToJSON encodes the S3Bucket as indented JSON.
+func (e *S3Bucket) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a S3Bucket, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *S3Bucket) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the S3Bucket with the given name and qualified name.
+func (e *S3Bucket) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("S3Bucket")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the S3Bucket with only the attributes required to update it.
+func (e *S3Bucket) TrimToRequired() (*S3Bucket, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a S3Bucket")
+	}
+	trimmed := &S3Bucket{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// S3Object is an entity of type S3Object.
+// S3Object: Instance of an S3 object in Atlan.
+type S3Object struct {
+	Entity
+	S3ObjectAttributes
+	S3ObjectRelationshipAttributes
+}
+
+// S3ObjectAttributes holds the attributes of S3Object entities.
+type S3ObjectAttributes struct {
+	S3Attributes
+	// Time (epoch) at which this object was last updated, in milliseconds, or when it was created if it has never been modified.
+	S3ObjectLastModifiedTime *int64 `json:"s3ObjectLastModifiedTime,omitempty"`
+	// Simple name of the bucket in which this object exists.
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+	// Unique name of the bucket in which this object exists.
+	S3BucketQualifiedName *string `json:"s3BucketQualifiedName,omitempty"`
+	// Object size in bytes.
+	S3ObjectSize *int64 `json:"s3ObjectSize,omitempty"`
+	// Storage class used for storing this object, for example: standard, intelligent-tiering, glacier, etc.
+	S3ObjectStorageClass *string `json:"s3ObjectStorageClass,omitempty"`
+	// Unique identity of this object in an S3 bucket. This is usually the concatenation of any prefix (folder) in the S3 bucket with the name of the object (file) itself.
+	S3ObjectKey *string `json:"s3ObjectKey,omitempty"`
+	// Type of content in this object, for example: text/plain, application/json, etc.
+	S3ObjectContentType *string `json:"s3ObjectContentType,omitempty"`
+	// Information about how this object's content should be presented.
+	S3ObjectContentDisposition *string `json:"s3ObjectContentDisposition,omitempty"`
+	// Version of this object. This is only applicable when versioning is enabled on the bucket in which this object exists.
+	S3ObjectVersionId *string `json:"s3ObjectVersionId,omitempty"`
+}
+
+// S3ObjectRelationshipAttributes holds the relationship attributes of S3Object entities.
+type S3ObjectRelationshipAttributes struct {
+	S3RelationshipAttributes
+	// Bucket in which this object exists.
+	Bucket *Reference `json:"bucket,omitempty"`
+}
+
+// MarshalJSON encodes the S3Object with the attributes and relationship attributes that are set.
+func (e *S3Object) MarshalJSON() ([]byte, error) {
+	return marshalEntity("S3Object", e.Entity, e.S3ObjectAttributes, e.S3ObjectRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a S3Object with its attributes and relationship attributes.
+func (e *S3Object) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.S3ObjectAttributes, &e.S3ObjectRelationshipAttributes)
+}
+
+// ToJSON encodes the S3Object as indented JSON.
+func (e *S3Object) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a S3Object, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *S3Object) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the S3Object with the given name and qualified name.
+func (e *S3Object) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("S3Object")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the S3Object with only the attributes required to update it.
+func (e *S3Object) TrimToRequired() (*S3Object, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a S3Object")
+	}
+	trimmed := &S3Object{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// SQL is an entity of type SQL.
+// SQL: Base class for SQL assets.
+type SQL struct {
+	Entity
+	SQLAttributes
+	SQLRelationshipAttributes
+}
+
+// SQLAttributes holds the attributes of SQL entities.
+type SQLAttributes struct {
+	CatalogAttributes
+	// Number of times this asset has been queried.
+	QueryCount *int64 `json:"queryCount,omitempty"`
+	// Number of unique users who have queried this asset.
+	QueryUserCount *int64 `json:"queryUserCount,omitempty"`
+	// Map of unique users who have queried this asset to the number of times they have queried it.
+	QueryUserMap *map[string]int `json:"queryUserMap,omitempty"`
+	// Time (epoch) at which the query count was last updated, in milliseconds.
+	QueryCountUpdatedAt *int64 `json:"queryCountUpdatedAt,omitempty"`
+	// Simple name of the database in which this SQL asset exists, or empty if it does not exist within a database.
+	DatabaseName *string `json:"databaseName,omitempty"`
+	// Unique name of the database in which this SQL asset exists, or empty if it does not exist within a database.
+	DatabaseQualifiedName *string `json:"databaseQualifiedName,omitempty"`
+	// Simple name of the schema in which this SQL asset exists, or empty if it does not exist within a schema.
+	SchemaName *string `json:"schemaName,omitempty"`
+	// Unique name of the schema in which this SQL asset exists, or empty if it does not exist within a schema.
+	SchemaQualifiedName *string `json:"schemaQualifiedName,omitempty"`
+	// Simple name of the table in which this SQL asset exists, or empty if it does not exist within a table.
+	TableName *string `json:"tableName,omitempty"`
+	// Unique name of the table in which this SQL asset exists, or empty if it does not exist within a table.
+	TableQualifiedName *string `json:"tableQualifiedName,omitempty"`
+	// Simple name of the view in which this SQL asset exists, or empty if it does not exist within a view.
+	ViewName *string `json:"viewName,omitempty"`
+	// Unique name of the view in which this SQL asset exists, or empty if it does not exist within a view.
+	ViewQualifiedName *string `json:"viewQualifiedName,omitempty"`
+	// Whether this asset has been profiled (true) or not (false).
+	IsProfiled *bool `json:"isProfiled,omitempty"`
+	// Time (epoch) at which this asset was last profiled, in milliseconds.
+	LastProfiledAt *int64 `json:"lastProfiledAt,omitempty"`
+}
+
+// SQLRelationshipAttributes holds the relationship attributes of SQL entities.
+type SQLRelationshipAttributes struct {
+	CatalogRelationshipAttributes
+	DbtSources    *[]Reference `json:"dbtSources,omitempty"`
+	SqlDbtModels  *[]Reference `json:"sqlDbtModels,omitempty"`
+	SqlDBTSources *[]Reference `json:"sqlDBTSources,omitempty"`
+	DbtModels     *[]Reference `json:"dbtModels,omitempty"`
+	DbtTests      *[]Reference `json:"dbtTests,omitempty"`
+}
+
+// MarshalJSON encodes the SQL with the attributes and relationship attributes that are set.
+func (e *SQL) MarshalJSON() ([]byte, error) {
+	return marshalEntity("SQL", e.Entity, e.SQLAttributes, e.SQLRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a SQL with its attributes and relationship attributes.
+func (e *SQL) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.SQLAttributes, &e.SQLRelationshipAttributes)
+}
+
+// ToJSON encodes the SQL as indented JSON.
+func (e *SQL) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a SQL, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *SQL) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the SQL with the given name and qualified name.
+func (e *SQL) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("SQL")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the SQL with only the attributes required to update it.
+func (e *SQL) TrimToRequired() (*SQL, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a SQL")
+	}
+	trimmed := &SQL{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Schema is an entity of type Schema.
+// Schema: Instance of a database schema in Atlan.
+type Schema struct {
+	Entity
+	SchemaAttributes
+	SchemaRelationshipAttributes
+}
+
+// SchemaAttributes holds the attributes of Schema entities.
+type SchemaAttributes struct {
+	SQLAttributes
+	// Number of tables in this schema.
+	TableCount *int `json:"tableCount,omitempty"`
+	// Number of views in this schema.
+	ViewsCount *int `json:"viewsCount,omitempty"`
+	// Unique name of the Linked Schema on which this Schema is dependent.
+	LinkedSchemaQualifiedName *string `json:"linkedSchemaQualifiedName,omitempty"`
+}
+
+// SchemaRelationshipAttributes holds the relationship attributes of Schema entities.
+type SchemaRelationshipAttributes struct {
+	SQLRelationshipAttributes
+	// Tables that exist within this schema.
+	Tables *[]Reference `json:"tables,omitempty"`
+	// Views that exist within this schema.
+	Views *[]Reference `json:"views,omitempty"`
+	// Materialized views that exist within this schema.
+	MaterialisedViews *[]Reference `json:"materialisedViews,omitempty"`
+	// Database in which this schema exists.
+	Database *Reference `json:"database,omitempty"`
+}
+
+// MarshalJSON encodes the Schema with the attributes and relationship attributes that are set.
+func (e *Schema) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Schema", e.Entity, e.SchemaAttributes, e.SchemaRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Schema with its attributes and relationship attributes.
+func (e *Schema) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.SchemaAttributes, &e.SchemaRelationshipAttributes)
+}
+
+// ToJSON encodes the Schema as indented JSON.
+func (e *Schema) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Schema, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Schema) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new Schema, named name, in the
+// database with the given qualified name.
+func (e *Schema) Creator(name, databaseQualifiedName string) error {
+	if name == "" || databaseQualifiedName == "" {
+		return errors.New("name and databaseQualifiedName are required fields")
+	}
+	hierarchy, err := parseHierarchy(databaseQualifiedName, 1)
+	if err != nil {
+		return err
+	}
+	e.DatabaseName = structs.StringPtr(hierarchy.names[0])
+	e.DatabaseQualifiedName = structs.StringPtr(databaseQualifiedName)
+	e.Database = RefByQualifiedName("Database", databaseQualifiedName)
+	e.TypeName = structs.StringPtr("Schema")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(databaseQualifiedName + "/" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	return nil
+}
+
+// Updater sets the attributes required to update the Schema with the given name and qualified name.
+func (e *Schema) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Schema")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Schema with only the attributes required to update it.
+func (e *Schema) TrimToRequired() (*Schema, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Schema")
+	}
+	trimmed := &Schema{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Table is an entity of type Table.
+// Table: Instance of a database table in Atlan.
+type Table struct {
+	Entity
+	TableAttributes
+	TableRelationshipAttributes
+}
+
+// TableAttributes holds the attributes of Table entities.
+type TableAttributes struct {
+	SQLAttributes
+	// Number of columns in this table.
+	ColumnCount *int64 `json:"columnCount,omitempty"`
+	// Number of rows in this table.
+	RowCount *int64 `json:"rowCount,omitempty"`
+	// Size of this table, in bytes.
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	// Alias for this table.
+	Alias *string `json:"alias,omitempty"`
+	// Whether this table is temporary (true) or not (false).
+	IsTemporary *bool `json:"isTemporary,omitempty"`
+	// Whether preview queries are allowed for this table (true) or not (false).
+	IsQueryPreview *bool `json:"isQueryPreview,omitempty"`
+	// Configuration for preview queries.
+	QueryPreviewConfig *map[string]string `json:"queryPreviewConfig,omitempty"`
+	// External location of this table, for example: an S3 object location.
+	ExternalLocation *string `json:"externalLocation,omitempty"`
+	// Region of the external location of this table, for example: S3 region.
+	ExternalLocationRegion *string `json:"externalLocationRegion,omitempty"`
+	// Format of the external location of this table, for example: JSON, CSV, PARQUET, etc.
+	ExternalLocationFormat *string `json:"externalLocationFormat,omitempty"`
+	// Whether this table is partitioned (true) or not (false).
+	IsPartitioned *bool `json:"isPartitioned,omitempty"`
+	// Partition strategy for this table.
+	PartitionStrategy *string `json:"partitionStrategy,omitempty"`
+	// Number of partitions in this table.
+	PartitionCount *int64 `json:"partitionCount,omitempty"`
+	// List of partitions in this table.
+	PartitionList *string `json:"partitionList,omitempty"`
+}
+
+// TableRelationshipAttributes holds the relationship attributes of Table entities.
+type TableRelationshipAttributes struct {
+	SQLRelationshipAttributes
+	// Columns that exist within this table.
+	Columns *[]Reference `json:"columns,omitempty"`
+	// Schema in which this table exists.
+	AtlanSchema *Reference `json:"atlanSchema,omitempty"`
+	// Dimension tables related to this fact table.
+	Dimensions *[]Reference `json:"dimensions,omitempty"`
+	// Fact tables related to this dimension table.
+	Facts *[]Reference `json:"facts,omitempty"`
+}
+
+// MarshalJSON encodes the Table with the attributes and relationship attributes that are set.
+func (e *Table) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Table", e.Entity, e.TableAttributes, e.TableRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Table with its attributes and relationship attributes.
+func (e *Table) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.TableAttributes, &e.TableRelationshipAttributes)
+}
+
+// ToJSON encodes the Table as indented JSON.
+func (e *Table) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Table, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Table) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new Table, named name, in the
+// schema with the given qualified name.
+func (e *Table) Creator(name, schemaQualifiedName string) error {
+	if name == "" || schemaQualifiedName == "" {
+		return errors.New("name and schemaQualifiedName are required fields")
+	}
+	hierarchy, err := parseHierarchy(schemaQualifiedName, 2)
+	if err != nil {
+		return err
+	}
+	e.SchemaName = structs.StringPtr(hierarchy.names[1])
+	e.SchemaQualifiedName = structs.StringPtr(schemaQualifiedName)
+	e.AtlanSchema = RefByQualifiedName("Schema", schemaQualifiedName)
+	e.TypeName = structs.StringPtr("Table")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(schemaQualifiedName + "/" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	e.DatabaseName = structs.StringPtr(hierarchy.names[0])
+	e.DatabaseQualifiedName = structs.StringPtr(hierarchy.qualifiedNames[0])
+	return nil
+}
+
+// Updater sets the attributes required to update the Table with the given name and qualified name.
+func (e *Table) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Table")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Table with only the attributes required to update it.
+func (e *Table) TrimToRequired() (*Table, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Table")
+	}
+	trimmed := &Table{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Tableau is an entity of type Tableau.
+// Tableau: Base class for Tableau assets.
+type Tableau struct {
+	Entity
+	TableauAttributes
+	TableauRelationshipAttributes
+}
+
+// TableauAttributes holds the attributes of Tableau entities.
+type TableauAttributes struct {
+	BIAttributes
+}
+
+// TableauRelationshipAttributes holds the relationship attributes of Tableau entities.
+type TableauRelationshipAttributes struct {
+	BIRelationshipAttributes
+}
+
+// MarshalJSON encodes the Tableau with the attributes and relationship attributes that are set.
+func (e *Tableau) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Tableau", e.Entity, e.TableauAttributes, e.TableauRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Tableau with its attributes and relationship attributes.
+func (e *Tableau) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.TableauAttributes, &e.TableauRelationshipAttributes)
+}
+
+// ToJSON encodes the Tableau as indented JSON.
+func (e *Tableau) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Tableau, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Tableau) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Tableau with the given name and qualified name.
+func (e *Tableau) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Tableau")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Tableau with only the attributes required to update it.
+func (e *Tableau) TrimToRequired() (*Tableau, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Tableau")
+	}
+	trimmed := &Tableau{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// TableauWorkbook is an entity of type TableauWorkbook.
+// TableauWorkbook: Instance of a Tableau workbook in Atlan.
+type TableauWorkbook struct {
+	Entity
+	TableauWorkbookAttributes
+	TableauWorkbookRelationshipAttributes
+}
+
+// TableauWorkbookAttributes holds the attributes of TableauWorkbook entities.
+type TableauWorkbookAttributes struct {
+	TableauAttributes
+	// Unique name of the site in which this workbook exists.
+	SiteQualifiedName *string `json:"siteQualifiedName,omitempty"`
+	// Unique name of the project in which this workbook exists.
+	ProjectQualifiedName *string `json:"projectQualifiedName,omitempty"`
+	// Simple name of the top-level project in which this workbook exists.
+	TopLevelProjectName *string `json:"topLevelProjectName,omitempty"`
+	// Unique name of the top-level project in which this workbook exists.
+	TopLevelProjectQualifiedName *string `json:"topLevelProjectQualifiedName,omitempty"`
+	// List of top-level projects with their nested child projects.
+	ProjectHierarchy *[]map[string]string `json:"projectHierarchy,omitempty"`
+}
+
+// TableauWorkbookRelationshipAttributes holds the relationship attributes of TableauWorkbook entities.
+type TableauWorkbookRelationshipAttributes struct {
+	TableauRelationshipAttributes
+}
+
+// MarshalJSON encodes the TableauWorkbook with the attributes and relationship attributes that are set.
+func (e *TableauWorkbook) MarshalJSON() ([]byte, error) {
+	return marshalEntity("TableauWorkbook", e.Entity, e.TableauWorkbookAttributes, e.TableauWorkbookRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a TableauWorkbook with its attributes and relationship attributes.
+func (e *TableauWorkbook) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.TableauWorkbookAttributes, &e.TableauWorkbookRelationshipAttributes)
+}
+
+// ToJSON encodes the TableauWorkbook as indented JSON.
+func (e *TableauWorkbook) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a TableauWorkbook, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *TableauWorkbook) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the TableauWorkbook with the given name and qualified name.
+func (e *TableauWorkbook) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("TableauWorkbook")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the TableauWorkbook with only the attributes required to update it.
+func (e *TableauWorkbook) TrimToRequired() (*TableauWorkbook, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a TableauWorkbook")
+	}
+	trimmed := &TableauWorkbook{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// View is an entity of type View.
+// View: Instance of a database view in Atlan.
+type View struct {
+	Entity
+	ViewAttributes
+	ViewRelationshipAttributes
+}
+
+// ViewAttributes holds the attributes of View entities.
+type ViewAttributes struct {
+	SQLAttributes
+	// Number of columns in this table.
+	ColumnCount *int64 `json:"columnCount,omitempty"`
+	// Number of rows in this table.
+	RowCount *int64 `json:"rowCount,omitempty"`
+	// Size of this table, in bytes.
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	// Alias for this table.
+	Alias *string `json:"alias,omitempty"`
+	// Whether this table is temporary (true) or not (false).
+	IsTemporary *bool `json:"isTemporary,omitempty"`
+	// Whether preview queries are allowed for this table (true) or not (false).
+	IsQueryPreview *bool `json:"isQueryPreview,omitempty"`
+	// Configuration for preview queries.
+	QueryPreviewConfig *map[string]string `json:"queryPreviewConfig,omitempty"`
+	// SQL definition of this view.
+	Definition *string `json:"definition,omitempty"`
+}
+
+// ViewRelationshipAttributes holds the relationship attributes of View entities.
+type ViewRelationshipAttributes struct {
+	SQLRelationshipAttributes
+	// Columns that exist within this view.
+	Columns *[]Reference `json:"columns,omitempty"`
+	// Schema in which this view exists.
+	AtlanSchema *Reference `json:"atlanSchema,omitempty"`
+}
+
+// MarshalJSON encodes the View with the attributes and relationship attributes that are set.
+func (e *View) MarshalJSON() ([]byte, error) {
+	return marshalEntity("View", e.Entity, e.ViewAttributes, e.ViewRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a View with its attributes and relationship attributes.
+func (e *View) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.ViewAttributes, &e.ViewRelationshipAttributes)
+}
+
+// ToJSON encodes the View as indented JSON.
+func (e *View) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a View, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *View) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Creator sets the attributes required to create a new View, named name, in the
+// schema with the given qualified name.
+func (e *View) Creator(name, schemaQualifiedName string) error {
+	if name == "" || schemaQualifiedName == "" {
+		return errors.New("name and schemaQualifiedName are required fields")
+	}
+	hierarchy, err := parseHierarchy(schemaQualifiedName, 2)
+	if err != nil {
+		return err
+	}
+	e.SchemaName = structs.StringPtr(hierarchy.names[1])
+	e.SchemaQualifiedName = structs.StringPtr(schemaQualifiedName)
+	e.AtlanSchema = RefByQualifiedName("Schema", schemaQualifiedName)
+	e.TypeName = structs.StringPtr("View")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(schemaQualifiedName + "/" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	e.DatabaseName = structs.StringPtr(hierarchy.names[0])
+	e.DatabaseQualifiedName = structs.StringPtr(hierarchy.qualifiedNames[0])
+	return nil
+}
+
+// Updater sets the attributes required to update the View with the given name and qualified name.
+func (e *View) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("View")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the View with only the attributes required to update it.
+func (e *View) TrimToRequired() (*View, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a View")
+	}
+	trimmed := &View{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// Workflow is an entity of type Workflow.
+// Workflow: Instance of a workflow in Atlan.
+type Workflow struct {
+	Entity
+	WorkflowAttributes
+	WorkflowRelationshipAttributes
+}
+
+// WorkflowAttributes holds the attributes of Workflow entities.
+type WorkflowAttributes struct {
+	AssetAttributes
+	// GUID of the workflow template from which the workflow was created.
+	WorkflowTemplateGuid *string `json:"workflowTemplateGuid,omitempty"`
+	// Type of the workflow.
+	WorkflowType *string `json:"workflowType,omitempty"`
+	// Workflow configuration.
+	WorkflowConfig *string `json:"workflowConfig,omitempty"`
+	// Status of the workflow.
+	WorkflowStatus *string `json:"workflowStatus,omitempty"`
+	// Time duration after which a run of the workflow will expire.
+	WorkflowRunExpiresIn *string `json:"workflowRunExpiresIn,omitempty"`
+	// Username of the user who created the workflow.
+	WorkflowCreatedBy *string `json:"workflowCreatedBy,omitempty"`
+	// Username of the user who updated the workflow.
+	WorkflowUpdatedBy *string `json:"workflowUpdatedBy,omitempty"`
+	// Deletion time of the workflow.
+	WorkflowDeletedAt *int64 `json:"workflowDeletedAt,omitempty"`
+}
+
+// WorkflowRelationshipAttributes holds the relationship attributes of Workflow entities.
+type WorkflowRelationshipAttributes struct {
+	AssetRelationshipAttributes
+}
+
+// MarshalJSON encodes the Workflow with the attributes and relationship attributes that are set.
+func (e *Workflow) MarshalJSON() ([]byte, error) {
+	return marshalEntity("Workflow", e.Entity, e.WorkflowAttributes, e.WorkflowRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a Workflow with its attributes and relationship attributes.
+func (e *Workflow) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.WorkflowAttributes, &e.WorkflowRelationshipAttributes)
+}
+
+// ToJSON encodes the Workflow as indented JSON.
+func (e *Workflow) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a Workflow, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *Workflow) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the Workflow with the given name and qualified name.
+func (e *Workflow) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("Workflow")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the Workflow with only the attributes required to update it.
+func (e *Workflow) TrimToRequired() (*Workflow, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a Workflow")
+	}
+	trimmed := &Workflow{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// WorkflowRun is an entity of type WorkflowRun.
+// WorkflowRun: Instance of a run of a workflow in Atlan.
+type WorkflowRun struct {
+	Entity
+	WorkflowRunAttributes
+	WorkflowRunRelationshipAttributes
+}
+
+// WorkflowRunAttributes holds the attributes of WorkflowRun entities.
+type WorkflowRunAttributes struct {
+	AssetAttributes
+	// GUID of the workflow of the run.
+	WorkflowRunWorkflowGuid *string `json:"workflowRunWorkflowGuid,omitempty"`
+	// Type of the run.
+	WorkflowRunType *string `json:"workflowRunType,omitempty"`
+	// GUID of the asset the run was triggered on.
+	WorkflowRunOnAssetGuid *string `json:"workflowRunOnAssetGuid,omitempty"`
+	// Comment on the run.
+	WorkflowRunComment *string `json:"workflowRunComment,omitempty"`
+	// Configuration of the run.
+	WorkflowRunConfig *string `json:"workflowRunConfig,omitempty"`
+	// Status of the run.
+	WorkflowRunStatus *string `json:"workflowRunStatus,omitempty"`
+	// Time at which the run expires.
+	WorkflowRunExpiresAt *int64 `json:"workflowRunExpiresAt,omitempty"`
+	// Username of the user who created the run.
+	WorkflowRunCreatedBy *string `json:"workflowRunCreatedBy,omitempty"`
+	// Username of the user who updated the run.
+	WorkflowRunUpdatedBy *string `json:"workflowRunUpdatedBy,omitempty"`
+	// Deletion time of the run.
+	WorkflowRunDeletedAt *int64 `json:"workflowRunDeletedAt,omitempty"`
+}
+
+// WorkflowRunRelationshipAttributes holds the relationship attributes of WorkflowRun entities.
+type WorkflowRunRelationshipAttributes struct {
+	AssetRelationshipAttributes
+}
+
+// MarshalJSON encodes the WorkflowRun with the attributes and relationship attributes that are set.
+func (e *WorkflowRun) MarshalJSON() ([]byte, error) {
+	return marshalEntity("WorkflowRun", e.Entity, e.WorkflowRunAttributes, e.WorkflowRunRelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a WorkflowRun with its attributes and relationship attributes.
+func (e *WorkflowRun) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.WorkflowRunAttributes, &e.WorkflowRunRelationshipAttributes)
+}
+
+// ToJSON encodes the WorkflowRun as indented JSON.
+func (e *WorkflowRun) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a WorkflowRun, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *WorkflowRun) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+
+// Updater sets the attributes required to update the WorkflowRun with the given name and qualified name.
+func (e *WorkflowRun) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("WorkflowRun")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the WorkflowRun with only the attributes required to update it.
+func (e *WorkflowRun) TrimToRequired() (*WorkflowRun, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a WorkflowRun")
+	}
+	trimmed := &WorkflowRun{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+
+// generatedTypes are the generated types of the entity types, by type name.
+var generatedTypes = map[string]func() Object{
+	"AWS":                   func() Object { return &AWS{} },
+	"AccessControl":         func() Object { return &AccessControl{} },
+	"Asset":                 func() Object { return &Asset{} },
+	"AtlasGlossary":         func() Object { return &AtlasGlossary{} },
+	"AtlasGlossaryCategory": func() Object { return &AtlasGlossaryCategory{} },
+	"AtlasGlossaryTerm":     func() Object { return &AtlasGlossaryTerm{} },
+	"AuthPolicy":            func() Object { return &AuthPolicy{} },
+	"AuthService":           func() Object { return &AuthService{} },
+	"BI":                    func() Object { return &BI{} },
+	"BIProcess":             func() Object { return &BIProcess{} },
+	"Catalog":               func() Object { return &Catalog{} },
+	"Cloud":                 func() Object { return &Cloud{} },
+	"Column":                func() Object { return &Column{} },
+	"ColumnProcess":         func() Object { return &ColumnProcess{} },
+	"Connection":            func() Object { return &Connection{} },
+	"DataContract":          func() Object { return &DataContract{} },
+	"Database":              func() Object { return &Database{} },
+	"Dbt":                   func() Object { return &Dbt{} },
+	"DbtModel":              func() Object { return &DbtModel{} },
+	"DbtModelColumn":        func() Object { return &DbtModelColumn{} },
+	"DbtSource":             func() Object { return &DbtSource{} },
+	"DbtTest":               func() Object { return &DbtTest{} },
+	"EventStore":            func() Object { return &EventStore{} },
+	"Kafka":                 func() Object { return &Kafka{} },
+	"KafkaConsumerGroup":    func() Object { return &KafkaConsumerGroup{} },
+	"KafkaTopic":            func() Object { return &KafkaTopic{} },
+	"MaterialisedView":      func() Object { return &MaterialisedView{} },
+	"ObjectStore":           func() Object { return &ObjectStore{} },
+	"Persona":               func() Object { return &Persona{} },
+	"PowerBI":               func() Object { return &PowerBI{} },
+	"PowerBIDashboard":      func() Object { return &PowerBIDashboard{} },
+	"PowerBIReport":         func() Object { return &PowerBIReport{} },
+	"PowerBIWorkspace":      func() Object { return &PowerBIWorkspace{} },
+	"Process":               func() Object { return &Process{} },
+	"Purpose":               func() Object { return &Purpose{} },
+	"Referenceable":         func() Object { return &Referenceable{} },
+	"S3":                    func() Object { return &S3{} },
+	"S3Bucket":              func() Object { return &S3Bucket{} },
+	"S3Object":              func() Object { return &S3Object{} },
+	"SQL":                   func() Object { return &SQL{} },
+	"Schema":                func() Object { return &Schema{} },
+	"Table":                 func() Object { return &Table{} },
+	"Tableau":               func() Object { return &Tableau{} },
+	"TableauWorkbook":       func() Object { return &TableauWorkbook{} },
+	"View":                  func() Object { return &View{} },
+	"Workflow":              func() Object { return &Workflow{} },
+	"WorkflowRun":           func() Object { return &WorkflowRun{} },
+}
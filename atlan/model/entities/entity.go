@@ -0,0 +1,223 @@
+// Package entities holds a Go type for each asset type in the generator's snapshot of the
+// metamodel, generated from their type definitions. Each type holds the attributes and
+// relationship attributes of its asset type, including those it inherits, and encodes to and
+// decodes from the JSON that Atlan sends and accepts:
+//
+//	table := &entities.Table{}
+//	if err := table.Creator("ORDERS", "default/snowflake/1234567890/DB/SCHEMA"); err != nil {
+//		return err
+//	}
+//	table.Description = structs.StringPtr("All orders.")
+//	response, err := assets.Save(table)
+//
+// The snapshot holds only the 47 asset types that the SDK models by hand, so the other asset
+// types of Atlan have no Go type yet: they decode as the generic Asset (or a type registered
+// with Register) until the snapshot is refreshed from a tenant.
+//
+// To regenerate the types when the metamodel changes, see the generator command.
+package entities
+
+//go:generate go run ../../../generator -typedefs ../../../generator/typedefs.json -types entities_gen.go -fields ""
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model/structs"
+)
+
+// Entity holds the properties that every entity in Atlan has, outside of its attributes.
+type Entity struct {
+	// Type of the asset. For example Table, Column, and so on.
+	TypeName *string `json:"typeName,omitempty"`
+	// Globally unique identifier (GUID) of any object in Atlan.
+	Guid *string `json:"guid,omitempty"`
+	// Asset status in Atlan (active vs deleted).
+	Status *atlan.AtlanStatus `json:"status,omitempty"`
+	// Atlan user who created this asset.
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// Atlan user who last updated this asset.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// Time (in milliseconds) when the asset was created.
+	CreateTime *int64 `json:"createTime,omitempty"`
+	// Time (in milliseconds) when the asset was last updated.
+	UpdateTime *int64 `json:"updateTime,omitempty"`
+	// Version of the asset, incremented on every update.
+	Version *int64 `json:"version,omitempty"`
+	// Whether only some of the attributes of the asset were returned.
+	IsIncomplete *bool `json:"isIncomplete,omitempty"`
+	// Atlan tags directly assigned to or propagated to the asset.
+	AtlanTags *[]structs.AtlanTag `json:"classifications,omitempty"`
+	// Atlan tags to add to (or update on) the asset, when it is saved appending Atlan tags.
+	AddOrUpdateAtlanTags *[]structs.AtlanTag `json:"addOrUpdateClassifications,omitempty"`
+	// Atlan tags to remove from the asset, when it is saved appending Atlan tags.
+	RemoveAtlanTags *[]structs.AtlanTag `json:"removeClassifications,omitempty"`
+	// Custom metadata of the asset, keyed by the internal names of the custom metadata sets
+	// and then of their attributes.
+	BusinessAttributes *map[string]map[string]interface{} `json:"businessAttributes,omitempty"`
+	// Custom metadata of the asset, keyed by the human-readable names of the custom metadata sets
+	// and then of their attributes. See SetCustomMetadata.
+	CustomMetadata map[string]map[string]interface{} `json:"-"`
+	// Labels of the asset.
+	Labels *[]string `json:"labels,omitempty"`
+	// Depth of the asset within lineage, only set on assets returned by the lineage list API.
+	Depth *int64 `json:"depth,omitempty"`
+	// Attributes that the Go type of the asset doesn't declare, as returned by Atlan, so that
+	// they aren't lost for types (or attributes) the SDK doesn't model. They are encoded along
+	// with the declared attributes.
+	UnknownAttributes map[string]json.RawMessage `json:"-"`
+	// Relationship attributes that the Go type of the asset doesn't declare, as returned by Atlan.
+	UnknownRelationshipAttributes map[string]json.RawMessage `json:"-"`
+}
+
+// Reference is a reference to another entity, as held by a relationship attribute. Atlan
+// returns references with the GUID of the entity, and accepts either its GUID or its unique
+// attributes (qualifiedName).
+type Reference struct {
+	TypeName           string            `json:"typeName"`
+	Guid               string            `json:"guid,omitempty"`
+	UniqueAttributes   *UniqueAttributes `json:"uniqueAttributes,omitempty"`
+	DisplayText        string            `json:"displayText,omitempty"`
+	EntityStatus       string            `json:"entityStatus,omitempty"`
+	RelationshipGuid   string            `json:"relationshipGuid,omitempty"`
+	RelationshipType   string            `json:"relationshipType,omitempty"`
+	RelationshipStatus string            `json:"relationshipStatus,omitempty"`
+}
+
+// UniqueAttributes are the attributes that identify an entity without its GUID.
+type UniqueAttributes struct {
+	QualifiedName string `json:"qualifiedName"`
+}
+
+// RefByGuid returns a reference to the entity of the given type with the given GUID.
+func RefByGuid(typeName, guid string) *Reference {
+	return &Reference{TypeName: typeName, Guid: guid}
+}
+
+// RefByQualifiedName returns a reference to the entity of the given type with the given
+// qualified name.
+func RefByQualifiedName(typeName, qualifiedName string) *Reference {
+	return &Reference{TypeName: typeName, UniqueAttributes: &UniqueAttributes{QualifiedName: qualifiedName}}
+}
+
+// Struct is the value of an attribute whose type is a struct of the metamodel, such as an AwsTag.
+type Struct struct {
+	TypeName   string                 `json:"typeName,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// entityJSON is the JSON form of an entity, with its attributes and relationship attributes
+// in separate objects.
+type entityJSON struct {
+	Entity
+	Attributes             json.RawMessage `json:"attributes,omitempty"`
+	RelationshipAttributes json.RawMessage `json:"relationshipAttributes,omitempty"`
+}
+
+// marshalEntity encodes an entity of the given type from its properties, attributes and
+// relationship attributes. Only the attributes that are set are included, along with any
+// unknown attributes of the entity.
+func marshalEntity(typeName string, entity Entity, attributes, relationshipAttributes interface{}) ([]byte, error) {
+	if entity.TypeName == nil {
+		entity.TypeName = &typeName
+	}
+	encoded := entityJSON{Entity: entity}
+	var err error
+	if encoded.Attributes, err = json.Marshal(attributes); err != nil {
+		return nil, err
+	}
+	if encoded.Attributes, err = withUnknownAttributes(encoded.Attributes, entity.UnknownAttributes); err != nil {
+		return nil, err
+	}
+	relationships, err := json.Marshal(relationshipAttributes)
+	if err != nil {
+		return nil, err
+	}
+	if relationships, err = withUnknownAttributes(relationships, entity.UnknownRelationshipAttributes); err != nil {
+		return nil, err
+	}
+	if string(relationships) != "{}" {
+		encoded.RelationshipAttributes = relationships
+	}
+	return json.Marshal(encoded)
+}
+
+// unmarshalEntity decodes the properties, attributes and relationship attributes of an
+// entity. Relationship attributes can be returned with the other attributes (for example, by
+// an index search asking for them), so both objects are decoded into them. Attributes that
+// neither struct declares are kept in the unknown attributes of the entity.
+func unmarshalEntity(data []byte, entity *Entity, attributes, relationshipAttributes interface{}) error {
+	var decoded entityJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*entity = decoded.Entity
+	var err error
+	if len(decoded.Attributes) > 0 {
+		if err = json.Unmarshal(decoded.Attributes, attributes); err != nil {
+			return err
+		}
+		if err = json.Unmarshal(decoded.Attributes, relationshipAttributes); err != nil {
+			return err
+		}
+		if entity.UnknownAttributes, err = unknownAttributes(decoded.Attributes, attributes, relationshipAttributes); err != nil {
+			return err
+		}
+	}
+	if len(decoded.RelationshipAttributes) > 0 {
+		if err = json.Unmarshal(decoded.RelationshipAttributes, relationshipAttributes); err != nil {
+			return err
+		}
+		if entity.UnknownRelationshipAttributes, err = unknownAttributes(decoded.RelationshipAttributes, relationshipAttributes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fromJSON decodes an entity either on its own, or wrapped with its referred entities as
+// returned by GET_ENTITY_BY_GUID.
+func fromJSON(data []byte, entity json.Unmarshaler) error {
+	return entity.UnmarshalJSON(unwrapEntity(data))
+}
+
+// unwrapEntity returns the entity of a response of GET_ENTITY_BY_GUID, or data itself if it
+// is not wrapped.
+func unwrapEntity(data []byte) []byte {
+	var wrapper struct {
+		Entity json.RawMessage `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err == nil && len(wrapper.Entity) > 0 {
+		return wrapper.Entity
+	}
+	return data
+}
+
+// qualifiedNameHierarchy is a qualified name split into the connection it belongs to and the
+// assets between the connection and itself, from the outermost in.
+type qualifiedNameHierarchy struct {
+	connectorName           string
+	connectionQualifiedName string
+	names                   []string
+	qualifiedNames          []string
+}
+
+// parseHierarchy splits the qualified name of an asset that is the given number of levels
+// below its connection (0 for the connection itself).
+func parseHierarchy(qualifiedName string, levels int) (*qualifiedNameHierarchy, error) {
+	tokens := strings.Split(qualifiedName, "/")
+	if len(tokens) != 3+levels {
+		return nil, fmt.Errorf("qualified name %s must have %d parts separated by '/'", qualifiedName, 3+levels)
+	}
+	hierarchy := &qualifiedNameHierarchy{
+		connectorName:           tokens[1],
+		connectionQualifiedName: strings.Join(tokens[:3], "/"),
+	}
+	for i := 3; i < len(tokens); i++ {
+		hierarchy.names = append(hierarchy.names, tokens[i])
+		hierarchy.qualifiedNames = append(hierarchy.qualifiedNames, strings.Join(tokens[:i+1], "/"))
+	}
+	return hierarchy, nil
+}
@@ -0,0 +1,240 @@
+package entities
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/atlanhq/atlan-go/atlan/model/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatorsDeriveQualifiedNames(t *testing.T) {
+	table := &Table{}
+	require.NoError(t, table.Creator("ORDERS", "default/snowflake/123/DB/SCHEMA"))
+	assert.Equal(t, "Table", *table.TypeName)
+	assert.Equal(t, "default/snowflake/123/DB/SCHEMA/ORDERS", *table.QualifiedName)
+	assert.Equal(t, "snowflake", *table.ConnectorName)
+	assert.Equal(t, "default/snowflake/123", *table.ConnectionQualifiedName)
+	assert.Equal(t, "DB", *table.DatabaseName)
+	assert.Equal(t, "default/snowflake/123/DB", *table.DatabaseQualifiedName)
+	assert.Equal(t, "SCHEMA", *table.SchemaName)
+	assert.Equal(t, "default/snowflake/123/DB/SCHEMA", *table.SchemaQualifiedName)
+	assert.Equal(t, RefByQualifiedName("Schema", "default/snowflake/123/DB/SCHEMA"), table.AtlanSchema)
+
+	column := &Column{}
+	require.NoError(t, column.Creator("ID", "MaterialisedView", "default/snowflake/123/DB/SCHEMA/MV", 2))
+	assert.Equal(t, "default/snowflake/123/DB/SCHEMA/MV/ID", *column.QualifiedName)
+	assert.Equal(t, 2, *column.Order)
+	assert.Equal(t, "MV", *column.ViewName)
+	assert.Equal(t, "default/snowflake/123/DB/SCHEMA/MV", *column.ViewQualifiedName)
+	assert.Equal(t, "SCHEMA", *column.SchemaName)
+	assert.Equal(t, "MaterialisedView", column.MaterialisedView.TypeName)
+	assert.Nil(t, column.Table)
+
+	assert.EqualError(t, (&Column{}).Creator("ID", "Schema", "default/snowflake/123/DB/SCHEMA/MV", 1),
+		"a Column can't be created in a Schema")
+	assert.EqualError(t, (&Column{}).Creator("ID", "Table", "default/snowflake/123/DB/SCHEMA/ORDERS", 0),
+		"order must be a positive integer")
+	assert.EqualError(t, (&Table{}).Creator("ORDERS", "default/snowflake/123/DB"),
+		"qualified name default/snowflake/123/DB must have 5 parts separated by '/'")
+
+	topic := &KafkaTopic{}
+	require.NoError(t, topic.Creator("orders", "default/kafka/123"))
+	assert.Equal(t, "default/kafka/123/topic/orders", *topic.QualifiedName)
+}
+
+func TestEntityJSON(t *testing.T) {
+	table := &Table{}
+	require.NoError(t, table.Creator("ORDERS", "default/snowflake/123/DB/SCHEMA"))
+	table.ColumnCount = new(int64)
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"typeName": "Table",
+		"attributes": {
+			"name": "ORDERS",
+			"qualifiedName": "default/snowflake/123/DB/SCHEMA/ORDERS",
+			"connectorName": "snowflake",
+			"connectionQualifiedName": "default/snowflake/123",
+			"databaseName": "DB",
+			"databaseQualifiedName": "default/snowflake/123/DB",
+			"schemaName": "SCHEMA",
+			"schemaQualifiedName": "default/snowflake/123/DB/SCHEMA",
+			"columnCount": 0
+		},
+		"relationshipAttributes": {
+			"atlanSchema": {"typeName": "Schema", "uniqueAttributes": {"qualifiedName": "default/snowflake/123/DB/SCHEMA"}}
+		}
+	}`, string(data))
+
+	// Relationship attributes are decoded both from their own object and from the attributes
+	decoded := &Table{}
+	require.NoError(t, decoded.FromJSON([]byte(`{"referredEntities": {}, "entity": {
+		"typeName": "Table",
+		"guid": "1234",
+		"status": "ACTIVE",
+		"attributes": {
+			"name": "ORDERS",
+			"qualifiedName": "default/snowflake/123/DB/SCHEMA/ORDERS",
+			"atlanSchema": {"typeName": "Schema", "guid": "5678"}
+		},
+		"relationshipAttributes": {
+			"columns": [{"typeName": "Column", "guid": "9012", "displayText": "ID"}]
+		}
+	}}`)))
+	assert.Equal(t, "1234", *decoded.Guid)
+	assert.Equal(t, "ORDERS", *decoded.Name)
+	assert.Equal(t, "5678", decoded.AtlanSchema.Guid)
+	require.Len(t, *decoded.Columns, 1)
+	assert.Equal(t, "ID", (*decoded.Columns)[0].DisplayText)
+
+	trimmed, err := decoded.TrimToRequired()
+	require.NoError(t, err)
+	data, err = json.Marshal(trimmed)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"typeName": "Table",
+		"guid": "1234",
+		"attributes": {"name": "ORDERS", "qualifiedName": "default/snowflake/123/DB/SCHEMA/ORDERS"}
+	}`, string(data))
+}
+
+func TestAttributesOfOtherSupertypesAreDeclared(t *testing.T) {
+	object := &S3Object{}
+	require.NoError(t, object.Updater("data.csv", "default/s3/123/bucket/data.csv"))
+	object.AwsArn = structs.StringPtr("arn:aws:s3:::bucket/data.csv")
+	data, err := json.Marshal(object)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"awsArn":"arn:aws:s3:::bucket/data.csv"`)
+}
+
+func TestDecodeKeepsUnknownAttributes(t *testing.T) {
+	data := []byte(`{"typeName": "Table", "guid": "1",
+		"attributes": {"name": "ORDERS", "icebergCatalogName": "polaris"},
+		"relationshipAttributes": {"sparkOrchestrations": [{"typeName": "SparkJob", "guid": "2"}]}}`)
+	object, err := Decode(data)
+	require.NoError(t, err)
+	table, ok := object.(*Table)
+	require.True(t, ok)
+	assert.Equal(t, "ORDERS", *table.Name)
+	assert.Equal(t, "1", *table.GetEntity().Guid)
+	assert.JSONEq(t, `"polaris"`, string(table.UnknownAttributes["icebergCatalogName"]))
+	assert.Contains(t, table.UnknownRelationshipAttributes, "sparkOrchestrations")
+
+	// Unknown attributes are sent back as they were returned
+	encoded, err := json.Marshal(table)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+}
+
+func TestEveryEntityTypeOfTheSnapshotIsGenerated(t *testing.T) {
+	data, err := os.ReadFile("../../../generator/typedefs.json")
+	require.NoError(t, err)
+	var typeDefs struct {
+		EntityDefs []struct {
+			Name string `json:"name"`
+		} `json:"entityDefs"`
+	}
+	require.NoError(t, json.Unmarshal(data, &typeDefs))
+	require.NotEmpty(t, typeDefs.EntityDefs)
+	for _, def := range typeDefs.EntityDefs {
+		assert.Contains(t, generatedTypes, def.Name, "entities_gen.go is out of date with the snapshot")
+	}
+
+	for typeName, want := range map[string]Object{
+		"Persona": &Persona{}, "Purpose": &Purpose{}, "AuthPolicy": &AuthPolicy{}, "DataContract": &DataContract{},
+	} {
+		object, err := Decode([]byte(`{"typeName": "` + typeName + `", "attributes": {"name": "N"}}`))
+		require.NoError(t, err)
+		assert.IsType(t, want, object, "%s is decoded into its own type", typeName)
+	}
+}
+
+type customTable struct {
+	Table
+}
+
+func TestRegister(t *testing.T) {
+	Register("CustomTable", func() Object { return &customTable{} })
+	defer func() {
+		registryMu.Lock()
+		delete(registry, "CustomTable")
+		registryMu.Unlock()
+	}()
+
+	object, err := Decode([]byte(`{"typeName": "CustomTable", "attributes": {"name": "T"}}`))
+	require.NoError(t, err)
+	custom, ok := object.(*customTable)
+	require.True(t, ok)
+	assert.Equal(t, "T", *custom.Name)
+
+	_, ok = New("NotAType")
+	assert.False(t, ok)
+}
+
+func TestProcessCreatorHashesItsInputsAndOutputs(t *testing.T) {
+	inputs := []Reference{*RefByGuid("Table", "in-1"), *RefByQualifiedName("Table", "default/snowflake/123/DB/SCHEMA/IN")}
+	outputs := []Reference{*RefByGuid("View", "out-1")}
+
+	process := &Process{}
+	require.NoError(t, process.Creator("load", "default/snowflake/123", "", inputs, outputs, nil))
+	// Golden qualified names, as computed by the Java and Python SDKs for the same process
+	assert.Equal(t, "default/snowflake/123/0287a70e93704c2a871439a25cebdc8c", *process.QualifiedName)
+	assert.Equal(t, "snowflake", *process.ConnectorName)
+	assert.Equal(t, inputs, process.ProcessInputs())
+
+	again := &Process{}
+	require.NoError(t, again.Creator("load", "default/snowflake/123", "", inputs, outputs, nil))
+	assert.Equal(t, *process.QualifiedName, *again.QualifiedName, "the same process gets the same qualified name")
+
+	byGuid := &Process{}
+	require.NoError(t, byGuid.Creator("load", "default/snowflake/123", "", inputs[:1], outputs, nil))
+	assert.Equal(t, *process.QualifiedName, *byGuid.QualifiedName, "inputs without a GUID don't contribute to the hash")
+
+	withID := &Process{}
+	require.NoError(t, withID.Creator("load", "default/snowflake/123", "job-42", inputs, outputs, nil))
+	assert.Equal(t, "default/snowflake/123/job-42", *withID.QualifiedName)
+
+	parent := RefByGuid("Process", "p1")
+	columnProcess := &ColumnProcess{}
+	require.NoError(t, columnProcess.Creator("load", "default/snowflake/123", "", inputs, outputs, parent))
+	assert.Equal(t, "default/snowflake/123/396033881f8fc6ab50e685853cc79d66", *columnProcess.QualifiedName)
+	assert.Equal(t, parent, columnProcess.Process)
+
+	assert.EqualError(t, (&ColumnProcess{}).Creator("load", "default/snowflake/123", "", inputs, outputs, nil),
+		"parentProcess is required for a ColumnProcess")
+	assert.EqualError(t, (&Process{}).Creator("load", "default/snowflake/123", "", nil, outputs, nil),
+		"name, connectionQualifiedName, inputs and outputs are required fields")
+}
+
+func TestGlossaryTermsAreUpdatedInTheirGlossary(t *testing.T) {
+	term := &AtlasGlossaryTerm{}
+	require.NoError(t, term.Updater("Revenue", "t1@g1", "g1"))
+	assert.Equal(t, RefByGuid("AtlasGlossary", "g1"), term.Anchor)
+	assert.EqualError(t, (&AtlasGlossaryTerm{}).Updater("Revenue", "t1@g1", ""),
+		"name, qualifiedName and glossaryGuid are required fields")
+
+	term.ShortDescription = structs.StringPtr("Money in")
+	trimmed, err := term.TrimToRequired()
+	require.NoError(t, err)
+	assert.Nil(t, trimmed.ShortDescription)
+	assert.Equal(t, "g1", trimmed.Anchor.Guid)
+
+	_, err = (&AtlasGlossaryCategory{}).TrimToRequired()
+	assert.Error(t, err)
+}
+
+func TestGlossaryCategoriesCanBeCreatedUnderAParent(t *testing.T) {
+	category := &AtlasGlossaryCategory{}
+	require.NoError(t, category.CreatorWithParent("Finance", "g1", RefByGuid("AtlasGlossaryCategory", "c1")))
+	assert.Equal(t, "Finance", *category.Name)
+	assert.Equal(t, RefByGuid("AtlasGlossary", "g1"), category.Anchor)
+	assert.Equal(t, RefByGuid("AtlasGlossaryCategory", "c1"), category.ParentCategory)
+
+	assert.EqualError(t, (&AtlasGlossaryCategory{}).CreatorWithParent("Finance", "g1", nil),
+		"parentCategory is required for a subcategory")
+	assert.EqualError(t, (&AtlasGlossaryCategory{}).CreatorWithParent("Finance", "", RefByGuid("AtlasGlossaryCategory", "c1")),
+		"name and glossaryGuid are required fields")
+}
@@ -0,0 +1,17 @@
+package entities
+
+import "errors"
+
+// CreatorWithParent sets the attributes required to create an AtlasGlossaryCategory with the
+// given name in the glossary with the given GUID, as a subcategory of the given parent category
+// (referenced by GUID, or by qualified name).
+func (e *AtlasGlossaryCategory) CreatorWithParent(name, glossaryGuid string, parentCategory *Reference) error {
+	if parentCategory == nil {
+		return errors.New("parentCategory is required for a subcategory")
+	}
+	if err := e.Creator(name, glossaryGuid); err != nil {
+		return err
+	}
+	e.ParentCategory = parentCategory
+	return nil
+}
@@ -0,0 +1,136 @@
+package entities
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/atlanhq/atlan-go/atlan/model/structs"
+)
+
+// Creator sets the attributes required to create a Process from the inputs to the outputs in
+// the connection with the given qualified name. The qualified name of the process is
+// connectionQualifiedName/id when id is given, or is otherwise derived from a hash of its name,
+// connection and the GUIDs of its parent process, inputs and outputs (in the same way as the
+// other Atlan SDKs), so that creating the same process again updates it. parentProcess is optional.
+func (e *Process) Creator(name, connectionQualifiedName, id string, inputs, outputs []Reference, parentProcess *Reference) error {
+	if err := validateProcess(name, connectionQualifiedName, inputs, outputs); err != nil {
+		return err
+	}
+	hierarchy, err := parseHierarchy(connectionQualifiedName, 0)
+	if err != nil {
+		return err
+	}
+	e.TypeName = structs.StringPtr("Process")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(ProcessQualifiedName(name, connectionQualifiedName, id, inputs, outputs, parentProcess))
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	e.Inputs = &inputs
+	e.Outputs = &outputs
+	return nil
+}
+
+// Creator sets the attributes required to create a ColumnProcess from the input columns to the
+// output columns, within the given parent process. Its qualified name is derived in the same way
+// as that of a Process.
+func (e *ColumnProcess) Creator(name, connectionQualifiedName, id string, inputs, outputs []Reference, parentProcess *Reference) error {
+	if err := validateProcess(name, connectionQualifiedName, inputs, outputs); err != nil {
+		return err
+	}
+	if parentProcess == nil {
+		return errors.New("parentProcess is required for a ColumnProcess")
+	}
+	hierarchy, err := parseHierarchy(connectionQualifiedName, 0)
+	if err != nil {
+		return err
+	}
+	e.TypeName = structs.StringPtr("ColumnProcess")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(ProcessQualifiedName(name, connectionQualifiedName, id, inputs, outputs, parentProcess))
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+	e.Inputs = &inputs
+	e.Outputs = &outputs
+	e.Process = parentProcess
+	return nil
+}
+
+// validateProcess checks the fields required to create a process.
+func validateProcess(name, connectionQualifiedName string, inputs, outputs []Reference) error {
+	if name == "" || connectionQualifiedName == "" || len(inputs) == 0 || len(outputs) == 0 {
+		return errors.New("name, connectionQualifiedName, inputs and outputs are required fields")
+	}
+	return nil
+}
+
+// ProcessQualifiedName returns the qualified name of a process (or column process): the given
+// id under the connection if there is one, or otherwise the MD5 hash of the name, connection
+// qualified name and the GUIDs of the parent process, inputs and outputs, as the Java and Python
+// SDKs compute it. References without a GUID (by qualified name) don't contribute to the hash.
+func ProcessQualifiedName(name, connectionQualifiedName, id string, inputs, outputs []Reference, parentProcess *Reference) string {
+	if strings.TrimSpace(id) != "" {
+		return connectionQualifiedName + "/" + id
+	}
+	var sb strings.Builder
+	sb.WriteString(name)
+	sb.WriteString(connectionQualifiedName)
+	if parentProcess != nil {
+		sb.WriteString(parentProcess.Guid)
+	}
+	for _, input := range inputs {
+		sb.WriteString(input.Guid)
+	}
+	for _, output := range outputs {
+		sb.WriteString(output.Guid)
+	}
+	hash := md5.Sum([]byte(sb.String()))
+	return connectionQualifiedName + "/" + hex.EncodeToString(hash[:])
+}
+
+// ProcessInputs returns the assets the process reads from.
+func (a *ProcessAttributes) ProcessInputs() []Reference {
+	if a.Inputs == nil {
+		return nil
+	}
+	return *a.Inputs
+}
+
+// ProcessOutputs returns the assets the process writes to.
+func (a *ProcessAttributes) ProcessOutputs() []Reference {
+	if a.Outputs == nil {
+		return nil
+	}
+	return *a.Outputs
+}
+
+// lineageProcess is implemented by the processes of every generated type: Process,
+// ColumnProcess, BIProcess and so on.
+type lineageProcess interface {
+	ProcessInputs() []Reference
+	ProcessOutputs() []Reference
+}
+
+// ProcessIO returns the inputs and outputs of an entity that is a process, or false if it
+// isn't one. Processes without a generated type are recognized by the inputs and outputs in
+// their unknown attributes.
+func ProcessIO(object Object) (inputs, outputs []Reference, ok bool) {
+	if process, ok := object.(lineageProcess); ok {
+		return process.ProcessInputs(), process.ProcessOutputs(), true
+	}
+	unknown := object.GetEntity().UnknownAttributes
+	rawInputs, hasInputs := unknown["inputs"]
+	rawOutputs, hasOutputs := unknown["outputs"]
+	if !hasInputs && !hasOutputs {
+		return nil, nil, false
+	}
+	if hasInputs && json.Unmarshal(rawInputs, &inputs) != nil {
+		return nil, nil, false
+	}
+	if hasOutputs && json.Unmarshal(rawOutputs, &outputs) != nil {
+		return nil, nil, false
+	}
+	return inputs, outputs, true
+}
@@ -0,0 +1,152 @@
+package entities
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Object is an entity decoded into the Go type registered for its typeName, for use in a
+// type switch:
+//
+//	switch asset := object.(type) {
+//	case *entities.Table:
+//		fmt.Println(*asset.ColumnCount)
+//	case *entities.Column:
+//		fmt.Println(*asset.DataType)
+//	}
+type Object interface {
+	json.Marshaler
+	json.Unmarshaler
+	FromJSON(data []byte) error
+	// GetEntity returns the properties of the entity outside of its attributes.
+	GetEntity() *Entity
+}
+
+// GetEntity returns the properties of the entity outside of its attributes.
+func (e *Entity) GetEntity() *Entity {
+	return e
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]func() Object)
+)
+
+func init() {
+	for typeName, newObject := range generatedTypes {
+		registry[typeName] = newObject
+	}
+}
+
+// Register sets the Go type that entities of typeName are decoded into, replacing the
+// generated type (if any). newObject must return a new, empty instance of the type.
+func Register(typeName string, newObject func() Object) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[typeName] = newObject
+}
+
+// New returns a new, empty instance of the Go type registered for typeName, or false if no
+// type is registered for it.
+func New(typeName string) (Object, bool) {
+	registryMu.RLock()
+	newObject, ok := registry[typeName]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return newObject(), true
+}
+
+// Decode decodes an entity into the Go type registered for its typeName, either on its own or
+// as returned by GET_ENTITY_BY_GUID. Entities of types without a registered Go type are
+// decoded as an Asset, which keeps their typeName and holds the attributes it doesn't declare
+// in its UnknownAttributes.
+func Decode(data []byte) (Object, error) {
+	data = unwrapEntity(data)
+	var header struct {
+		TypeName string `json:"typeName"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	object, ok := New(header.TypeName)
+	if !ok {
+		object = &Asset{}
+	}
+	if err := object.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// unknownAttributes returns the attributes in data that none of the given structs declares,
+// or nil if they declare all of them.
+func unknownAttributes(data []byte, declaredBy ...interface{}) (map[string]json.RawMessage, error) {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+	for _, target := range declaredBy {
+		for name := range jsonNames(reflect.TypeOf(target)) {
+			delete(attributes, name)
+		}
+	}
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	return attributes, nil
+}
+
+// withUnknownAttributes adds the unknown attributes to the encoded attributes, unless they are
+// set on the struct itself.
+func withUnknownAttributes(encoded []byte, unknown map[string]json.RawMessage) ([]byte, error) {
+	if len(unknown) == 0 {
+		return encoded, nil
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &attributes); err != nil {
+		return nil, err
+	}
+	for name, value := range unknown {
+		if _, ok := attributes[name]; !ok {
+			attributes[name] = value
+		}
+	}
+	return json.Marshal(attributes)
+}
+
+// jsonNamesCache holds the JSON names of the fields of each struct type, by reflect.Type.
+var jsonNamesCache sync.Map
+
+// jsonNames returns the JSON names of the fields of a struct type (or a pointer to one),
+// including the fields of embedded structs.
+func jsonNames(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if cached, ok := jsonNamesCache.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		switch {
+		case tag == "-":
+		case field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct:
+			for embedded := range jsonNames(field.Type) {
+				names[embedded] = true
+			}
+		case name != "":
+			names[name] = true
+		case field.IsExported():
+			names[field.Name] = true
+		}
+	}
+	jsonNamesCache.Store(t, names)
+	return names
+}
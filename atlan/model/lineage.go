@@ -0,0 +1,217 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
+)
+
+// Conditions that combine the criteria of a LineageFilterList.
+const (
+	LineageFilterConditionAnd = "AND"
+	LineageFilterConditionOr  = "OR"
+)
+
+// LineageFilter is a criterion on an attribute of the assets or relationships in lineage,
+// for example {"__typeName", "not_contains", "Process"}.
+type LineageFilter struct {
+	AttributeName  string `json:"attributeName"`
+	Operator       string `json:"operator"`
+	AttributeValue string `json:"attributeValue"`
+}
+
+// LineageFilterList combines the criteria of a lineage filter with a condition
+// (LineageFilterConditionAnd or LineageFilterConditionOr).
+type LineageFilterList struct {
+	Condition string          `json:"condition"`
+	Criteria  []LineageFilter `json:"criterion"`
+}
+
+// LineageListRequest is a request to the lineage list API, which returns the assets (and
+// processes) upstream or downstream of an asset, a page at a time.
+type LineageListRequest struct {
+	// GUID of the asset from which to fetch lineage.
+	Guid string `json:"guid"`
+	// Number of results to return in each page.
+	Size int `json:"size"`
+	// Offset of the first result of the page.
+	From int `json:"from"`
+	// Number of hops across which to fetch lineage.
+	Depth int `json:"depth"`
+	// Direction of the lineage: atlan.LineageDirectionUpstream or atlan.LineageDirectionDownstream.
+	Direction atlan.LineageDirection `json:"direction"`
+	// Filters the assets included in the results, without stopping traversal through them.
+	EntityFilters *LineageFilterList `json:"entityFilters,omitempty"`
+	// Filters the assets through which lineage is traversed.
+	EntityTraversalFilters *LineageFilterList `json:"entityTraversalFilters,omitempty"`
+	// Filters the relationships through which lineage is traversed.
+	RelationshipTraversalFilters *LineageFilterList `json:"relationshipTraversalFilters,omitempty"`
+	// Attributes to include on each asset in the results.
+	Attributes []string `json:"attributes,omitempty"`
+	// Attributes to include on each relationship of the assets in the results.
+	RelationAttributes []string `json:"relationAttributes,omitempty"`
+	// Whether to leave the terms assigned to each asset out of the results.
+	ExcludeMeanings bool `json:"excludeMeanings"`
+	// Whether to leave the Atlan tags of each asset out of the results.
+	ExcludeClassifications bool `json:"excludeClassifications"`
+	// Whether to include the immediate neighbors of each asset in the results.
+	ImmediateNeighbors bool `json:"immediateNeighbors"`
+	// Whether to traverse lineage through processes that have been archived.
+	AllowDeletedProcesses bool `json:"allowDeletedProcesses"`
+}
+
+// NewLineageListRequest returns a request for all the lineage of the asset with the given GUID
+// in the given direction, without terms or Atlan tags, in pages of 10.
+func NewLineageListRequest(guid string, direction atlan.LineageDirection) *LineageListRequest {
+	return &LineageListRequest{
+		Guid:                   guid,
+		Size:                   10,
+		Depth:                  1000000,
+		Direction:              direction,
+		ExcludeMeanings:        true,
+		ExcludeClassifications: true,
+	}
+}
+
+// LineageListResponse is a page of results of the lineage list API. Each entity is decoded into
+// the type generated for its typeName (see entities.Decode), with its Depth set.
+type LineageListResponse struct {
+	Entities         []entities.Object
+	HasMore          bool
+	EntityCount      int64
+	SearchParameters *LineageListRequest
+}
+
+func (lr *LineageListResponse) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Entities         []json.RawMessage   `json:"entities"`
+		HasMore          bool                `json:"hasMore"`
+		EntityCount      int64               `json:"entityCount"`
+		SearchParameters *LineageListRequest `json:"searchParameters"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	lr.Entities = make([]entities.Object, 0, len(aux.Entities))
+	for _, entityData := range aux.Entities {
+		entity, err := entities.Decode(entityData)
+		if err != nil {
+			return err
+		}
+		lr.Entities = append(lr.Entities, entity)
+	}
+	lr.HasMore = aux.HasMore
+	lr.EntityCount = aux.EntityCount
+	lr.SearchParameters = aux.SearchParameters
+	return nil
+}
+
+// LineageGraph is the lineage between assets through the processes that read from and write to
+// them, as returned by the lineage list API.
+type LineageGraph struct {
+	// GUID of the asset from which lineage was fetched.
+	BaseGuid string
+	// Assets in the lineage (other than processes), by GUID.
+	Assets map[string]entities.Object
+	// Processes in the lineage (Process, ColumnProcess and so on), by GUID.
+	Processes map[string]entities.Object
+	inputs    map[string][]string // GUIDs of the inputs of each process
+	outputs   map[string][]string // GUIDs of the outputs of each process
+	consumers map[string][]string // GUIDs of the processes that read from each asset
+	producers map[string][]string // GUIDs of the processes that write to each asset
+}
+
+// NewLineageGraph returns the lineage graph of the given assets and processes.
+func NewLineageGraph(baseGuid string, objects []entities.Object) *LineageGraph {
+	graph := &LineageGraph{
+		BaseGuid:  baseGuid,
+		Assets:    make(map[string]entities.Object),
+		Processes: make(map[string]entities.Object),
+		inputs:    make(map[string][]string),
+		outputs:   make(map[string][]string),
+		consumers: make(map[string][]string),
+		producers: make(map[string][]string),
+	}
+	for _, object := range objects {
+		graph.Add(object)
+	}
+	return graph
+}
+
+// Add adds an asset or process to the graph. Processes link their inputs to their outputs, so
+// they need their inputs and outputs attributes.
+func (g *LineageGraph) Add(object entities.Object) {
+	entity := object.GetEntity()
+	if entity.Guid == nil {
+		return
+	}
+	guid := *entity.Guid
+	inputs, outputs, isProcess := entities.ProcessIO(object)
+	if !isProcess {
+		g.Assets[guid] = object
+		return
+	}
+	if _, exists := g.Processes[guid]; exists {
+		return
+	}
+	g.Processes[guid] = object
+	for _, input := range inputs {
+		if input.Guid != "" {
+			g.inputs[guid] = append(g.inputs[guid], input.Guid)
+			g.consumers[input.Guid] = append(g.consumers[input.Guid], guid)
+		}
+	}
+	for _, output := range outputs {
+		if output.Guid != "" {
+			g.outputs[guid] = append(g.outputs[guid], output.Guid)
+			g.producers[output.Guid] = append(g.producers[output.Guid], guid)
+		}
+	}
+}
+
+// Upstream returns the assets upstream of the asset with the given GUID in the graph, up to
+// depth processes away (or all of them if depth is 0), nearest first.
+func (g *LineageGraph) Upstream(guid string, depth int) []entities.Object {
+	return g.traverse(guid, depth, g.producers, g.inputs)
+}
+
+// Downstream returns the assets downstream of the asset with the given GUID in the graph, up to
+// depth processes away (or all of them if depth is 0), nearest first.
+func (g *LineageGraph) Downstream(guid string, depth int) []entities.Object {
+	return g.traverse(guid, depth, g.consumers, g.outputs)
+}
+
+// ImpactedAssets returns every asset downstream of the asset with the given GUID in the graph,
+// which a change to it could impact.
+func (g *LineageGraph) ImpactedAssets(guid string) []entities.Object {
+	return g.Downstream(guid, 0)
+}
+
+// traverse walks the graph breadth first from an asset, through the processes linked to each
+// asset and then the assets linked to each process. Assets that are not in the graph (for
+// example, because they were filtered out of the results) are walked through but not returned.
+func (g *LineageGraph) traverse(guid string, depth int, processesOf, assetsOf map[string][]string) []entities.Object {
+	var found []entities.Object
+	visited := map[string]bool{guid: true}
+	current := []string{guid}
+	for hops := 1; len(current) > 0 && (depth <= 0 || hops <= depth); hops++ {
+		var next []string
+		for _, asset := range current {
+			for _, process := range processesOf[asset] {
+				for _, linked := range assetsOf[process] {
+					if visited[linked] {
+						continue
+					}
+					visited[linked] = true
+					next = append(next, linked)
+					if object, ok := g.Assets[linked]; ok {
+						found = append(found, object)
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return found
+}
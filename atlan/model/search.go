@@ -5,10 +5,13 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/atlanhq/atlan-go/atlan"
+	"github.com/atlanhq/atlan-go/atlan/model/entities"
 	"github.com/atlanhq/atlan-go/atlan/model/structs"
 )
 
@@ -121,6 +124,115 @@ type MatchQuery struct {
 	PrefixLength                    *int
 }
 
+// ConstantScoreQuery represents a constant_score query in the Atlas search DSL, matching the
+// filter without scoring it and giving every match the same score.
+type ConstantScoreQuery struct {
+	Filter Query
+	Boost  *float64
+}
+
+// DisMaxQuery represents a dis_max query in the Atlas search DSL, matching any of the queries
+// and scoring each match by its best matching query (plus TieBreaker times the others).
+type DisMaxQuery struct {
+	Queries    []Query
+	TieBreaker *float64
+	Boost      *float64
+}
+
+// FunctionScoreQuery represents a function_score query in the Atlas search DSL, adjusting the
+// score of the matches of the query by its functions.
+type FunctionScoreQuery struct {
+	Query     Query
+	Functions []ScoreFunction
+	ScoreMode *string
+	BoostMode *string
+	MaxBoost  *float64
+	MinScore  *float64
+	Boost     *float64
+}
+
+// ScoreFunction is a function of a FunctionScoreQuery, applied to the matches of its
+// Filter (or to all matches, if there is no filter).
+type ScoreFunction struct {
+	Filter           Query
+	Weight           *float64
+	FieldValueFactor *FieldValueFactor
+}
+
+// FieldValueFactor scores matches by the value of a numeric field of the documents.
+type FieldValueFactor struct {
+	Field    string
+	Factor   *float64
+	Modifier *string
+	Missing  *float64
+}
+
+// BoostingQuery represents a boosting query in the Atlas search DSL, matching the positive query
+// while demoting (rather than excluding) the matches of the negative query.
+type BoostingQuery struct {
+	Positive      Query
+	Negative      Query
+	NegativeBoost float64
+}
+
+// ScriptQuery represents a script query in the Atlas search DSL, matching the documents for
+// which the script (in Lang, painless by default) returns true.
+type ScriptQuery struct {
+	Source string
+	Lang   *string
+	Params map[string]interface{}
+	Boost  *float64
+}
+
+// SpanTermQuery represents a span_term query in the Atlas search DSL, matching the positions of
+// a term in a text field, to be combined by the other span queries.
+type SpanTermQuery struct {
+	Field string
+	Value string
+	Boost *float64
+}
+
+// SpanNearQuery represents a span_near query in the Atlas search DSL, matching spans of its
+// clauses that are at most Slop positions apart (and in order, when InOrder).
+type SpanNearQuery struct {
+	Clauses []Query
+	Slop    int
+	InOrder bool
+}
+
+// SpanFirstQuery represents a span_first query in the Atlas search DSL, matching spans of its
+// query that end within the first End positions of the field.
+type SpanFirstQuery struct {
+	Match Query
+	End   int
+}
+
+// SpanOrQuery represents a span_or query in the Atlas search DSL, matching the spans of any of
+// its clauses.
+type SpanOrQuery struct {
+	Clauses []Query
+}
+
+// SpanNotQuery represents a span_not query in the Atlas search DSL, matching the spans of
+// Include that don't overlap a span of Exclude.
+type SpanNotQuery struct {
+	Include Query
+	Exclude Query
+}
+
+// SpanWithinQuery represents a span_within query in the Atlas search DSL, matching the spans of
+// Little that are enclosed by a span of Big.
+type SpanWithinQuery struct {
+	Big    Query
+	Little Query
+}
+
+// SpanMultiQuery represents a span_multi query in the Atlas search DSL, wrapping a prefix,
+// wildcard, regexp, fuzzy or range query so that it can be combined by the other span queries.
+type SpanMultiQuery struct {
+	Match Query
+}
+
 type SortItem struct {
 	Field      string
 	Order      atlan.SortOrder
@@ -398,6 +510,215 @@ func (m *MatchQuery) ToJSON() map[string]interface{} {
 	}
 }
 
+// ToJSON returns the JSON representation of the ConstantScoreQuery.
+func (c *ConstantScoreQuery) ToJSON() map[string]interface{} {
+	query := map[string]interface{}{
+		"filter": c.Filter.ToJSON(),
+	}
+	if c.Boost != nil {
+		query["boost"] = *c.Boost
+	}
+	return map[string]interface{}{
+		"constant_score": query,
+	}
+}
+
+// ToJSON returns the JSON representation of the DisMaxQuery.
+func (d *DisMaxQuery) ToJSON() map[string]interface{} {
+	queries := make([]map[string]interface{}, len(d.Queries))
+	for i, q := range d.Queries {
+		queries[i] = q.ToJSON()
+	}
+	query := map[string]interface{}{
+		"queries": queries,
+	}
+	if d.TieBreaker != nil {
+		query["tie_breaker"] = *d.TieBreaker
+	}
+	if d.Boost != nil {
+		query["boost"] = *d.Boost
+	}
+	return map[string]interface{}{
+		"dis_max": query,
+	}
+}
+
+// ToJSON returns the JSON representation of the FunctionScoreQuery.
+func (f *FunctionScoreQuery) ToJSON() map[string]interface{} {
+	query := make(map[string]interface{})
+	if f.Query != nil {
+		query["query"] = f.Query.ToJSON()
+	}
+	if len(f.Functions) > 0 {
+		functions := make([]map[string]interface{}, len(f.Functions))
+		for i, function := range f.Functions {
+			functions[i] = function.ToJSON()
+		}
+		query["functions"] = functions
+	}
+	if f.ScoreMode != nil {
+		query["score_mode"] = *f.ScoreMode
+	}
+	if f.BoostMode != nil {
+		query["boost_mode"] = *f.BoostMode
+	}
+	if f.MaxBoost != nil {
+		query["max_boost"] = *f.MaxBoost
+	}
+	if f.MinScore != nil {
+		query["min_score"] = *f.MinScore
+	}
+	if f.Boost != nil {
+		query["boost"] = *f.Boost
+	}
+	return map[string]interface{}{
+		"function_score": query,
+	}
+}
+
+// ToJSON returns the JSON representation of the ScoreFunction.
+func (s *ScoreFunction) ToJSON() map[string]interface{} {
+	function := make(map[string]interface{})
+	if s.Filter != nil {
+		function["filter"] = s.Filter.ToJSON()
+	}
+	if s.Weight != nil {
+		function["weight"] = *s.Weight
+	}
+	if s.FieldValueFactor != nil {
+		factor := map[string]interface{}{
+			"field": s.FieldValueFactor.Field,
+		}
+		if s.FieldValueFactor.Factor != nil {
+			factor["factor"] = *s.FieldValueFactor.Factor
+		}
+		if s.FieldValueFactor.Modifier != nil {
+			factor["modifier"] = *s.FieldValueFactor.Modifier
+		}
+		if s.FieldValueFactor.Missing != nil {
+			factor["missing"] = *s.FieldValueFactor.Missing
+		}
+		function["field_value_factor"] = factor
+	}
+	return function
+}
+
+// ToJSON returns the JSON representation of the BoostingQuery.
+func (b *BoostingQuery) ToJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"boosting": map[string]interface{}{
+			"positive":       b.Positive.ToJSON(),
+			"negative":       b.Negative.ToJSON(),
+			"negative_boost": b.NegativeBoost,
+		},
+	}
+}
+
+// ToJSON returns the JSON representation of the ScriptQuery.
+func (s *ScriptQuery) ToJSON() map[string]interface{} {
+	script := map[string]interface{}{
+		"source": s.Source,
+	}
+	if s.Lang != nil {
+		script["lang"] = *s.Lang
+	}
+	if len(s.Params) > 0 {
+		script["params"] = s.Params
+	}
+	query := map[string]interface{}{
+		"script": script,
+	}
+	if s.Boost != nil {
+		query["boost"] = *s.Boost
+	}
+	return map[string]interface{}{
+		"script": query,
+	}
+}
+
+// ToJSON returns the JSON representation of the SpanTermQuery.
+func (s *SpanTermQuery) ToJSON() map[string]interface{} {
+	term := map[string]interface{}{
+		"value": s.Value,
+	}
+	if s.Boost != nil {
+		term["boost"] = *s.Boost
+	}
+	return map[string]interface{}{
+		"span_term": map[string]interface{}{
+			s.Field: term,
+		},
+	}
+}
+
+// ToJSON returns the JSON representation of the SpanNearQuery.
+func (s *SpanNearQuery) ToJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"span_near": map[string]interface{}{
+			"clauses":  queriesToJSON(s.Clauses),
+			"slop":     s.Slop,
+			"in_order": s.InOrder,
+		},
+	}
+}
+
+// ToJSON returns the JSON representation of the SpanFirstQuery.
+func (s *SpanFirstQuery) ToJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"span_first": map[string]interface{}{
+			"match": s.Match.ToJSON(),
+			"end":   s.End,
+		},
+	}
+}
+
+// ToJSON returns the JSON representation of the SpanOrQuery.
+func (s *SpanOrQuery) ToJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"span_or": map[string]interface{}{
+			"clauses": queriesToJSON(s.Clauses),
+		},
+	}
+}
+
+// ToJSON returns the JSON representation of the SpanNotQuery.
+func (s *SpanNotQuery) ToJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"span_not": map[string]interface{}{
+			"include": s.Include.ToJSON(),
+			"exclude": s.Exclude.ToJSON(),
+		},
+	}
+}
+
+// ToJSON returns the JSON representation of the SpanWithinQuery.
+func (s *SpanWithinQuery) ToJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"span_within": map[string]interface{}{
+			"big":    s.Big.ToJSON(),
+			"little": s.Little.ToJSON(),
+		},
+	}
+}
+
+// ToJSON returns the JSON representation of the SpanMultiQuery.
+func (s *SpanMultiQuery) ToJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"span_multi": map[string]interface{}{
+			"match": s.Match.ToJSON(),
+		},
+	}
+}
+
+// queriesToJSON returns the JSON representation of each of the queries.
+func queriesToJSON(queries []Query) []map[string]interface{} {
+	clauses := make([]map[string]interface{}, 0, len(queries))
+	for _, query := range queries {
+		clauses = append(clauses, query.ToJSON())
+	}
+	return clauses
+}
+
 // ToJSON returns the JSON representation of the SortItem.
 func (s *SortItem) ToJSON() map[string]interface{} {
 	sortField := map[string]interface{}{"order": s.Order}
@@ -486,30 +807,47 @@ type IndexSearchRequest struct {
 type Dsl struct {
 	From                int                      `json:"from"`
 	Size                int                      `json:"size"`
-	Aggregation         map[string]interface{}   `json:"aggregation,omitempty"`
+	Aggregation         map[string]interface{}   `json:"aggregations,omitempty"`
 	Query               map[string]interface{}   `json:"query"`
 	TrackTotalHits      bool                     `json:"track_total_hits"`
 	PostFilter          *Query                   `json:"post_filter,omitempty"`
 	Sort                []map[string]interface{} `json:"sort,omitempty"`
+	SearchAfter         []interface{}            `json:"search_after,omitempty"`
 	IncludesOnResults   []string                 `json:"includesOnResults,omitempty"`
 	IncludesOnRelations []string                 `json:"includesOnRelations,omitempty"`
 }
 
 // IndexSearchResponse represents a search response in the Atlas search DSL.
 type IndexSearchResponse struct {
-	QueryType        string           `json:"queryType"`
-	SearchParameters SearchParameters `json:"searchParameters"`
-	Entities         []SearchAssets   `json:"entities"`
-	ApproximateCount int64            `json:"approximateCount"`
+	QueryType        string                       `json:"queryType"`
+	SearchParameters SearchParameters             `json:"searchParameters"`
+	Entities         []SearchAssets               `json:"entities"`
+	ApproximateCount int64                        `json:"approximateCount"`
+	Aggregations     map[string]AggregationResult `json:"aggregations,omitempty"`
 }
 
 func (isr *IndexSearchResponse) UnmarshalJSON(data []byte) error {
+	return isr.unmarshal(data, false)
+}
+
+// UnmarshalIndexSearchResponse decodes an index search response. When typed, each of its
+// entities is also decoded into the type generated for its typeName, returned by ToEntity.
+func UnmarshalIndexSearchResponse(data []byte, typed bool) (*IndexSearchResponse, error) {
+	isr := &IndexSearchResponse{}
+	if err := isr.unmarshal(data, typed); err != nil {
+		return nil, err
+	}
+	return isr, nil
+}
+
+func (isr *IndexSearchResponse) unmarshal(data []byte, typed bool) error {
 	// Define an auxiliary struct to decode the JSON
 	type AuxIndexSearchResponse struct {
-		QueryType        string            `json:"queryType"`
-		SearchParameters json.RawMessage   `json:"searchParameters"`
-		Entities         []json.RawMessage `json:"entities"`
-		ApproximateCount int64             `json:"approximateCount"`
+		QueryType        string                       `json:"queryType"`
+		SearchParameters json.RawMessage              `json:"searchParameters"`
+		Entities         []json.RawMessage            `json:"entities"`
+		ApproximateCount int64                        `json:"approximateCount"`
+		Aggregations     map[string]AggregationResult `json:"aggregations"`
 	}
 
 	// Unmarshal into the auxiliary struct
@@ -528,8 +866,8 @@ func (isr *IndexSearchResponse) UnmarshalJSON(data []byte) error {
 	// Unmarshal each entity into SearchAssets
 	var entities []SearchAssets
 	for _, entityData := range aux.Entities {
-		var sa SearchAssets
-		if err := json.Unmarshal(entityData, &sa); err != nil {
+		sa, err := decodeSearchAsset(entityData, typed)
+		if err != nil {
 			return err
 		}
 		// Populate custom metadata set for each entity
@@ -539,10 +877,99 @@ func (isr *IndexSearchResponse) UnmarshalJSON(data []byte) error {
 	isr.Entities = entities
 	isr.QueryType = aux.QueryType
 	isr.ApproximateCount = aux.ApproximateCount
+	isr.Aggregations = aux.Aggregations
 
 	return nil
 }
 
+// DecodeIndexSearchResponse decodes an index search response from r one entity at a time,
+// so that memory use is bounded by the size of a single entity rather than the size of the page.
+// Each entity is passed to onEntity as soon as it is decoded, and is not kept in the Entities
+// of the returned response. attributes are the attributes requested by the search, used to
+// unflatten the custom metadata of the entities. When typed, each entity is also decoded into the
+// type generated for its typeName, returned by ToEntity. Decoding stops at the first error of onEntity.
+func DecodeIndexSearchResponse(r io.Reader, attributes []string, typed bool, onEntity func(*SearchAssets) error) (*IndexSearchResponse, error) {
+	isr := &IndexSearchResponse{}
+	decoder := json.NewDecoder(r)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch token {
+		case "queryType":
+			err = decoder.Decode(&isr.QueryType)
+		case "searchParameters":
+			err = decoder.Decode(&isr.SearchParameters)
+		case "approximateCount":
+			err = decoder.Decode(&isr.ApproximateCount)
+		case "aggregations":
+			err = decoder.Decode(&isr.Aggregations)
+		case "entities":
+			err = isr.decodeEntities(decoder, attributes, typed, onEntity)
+		default:
+			var skipped json.RawMessage
+			err = decoder.Decode(&skipped)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return nil, err
+	}
+	return isr, nil
+}
+
+// decodeEntities decodes the array of entities of an index search response, passing each one to onEntity.
+func (isr *IndexSearchResponse) decodeEntities(decoder *json.Decoder, attributes []string, typed bool, onEntity func(*SearchAssets) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		// No entities in the page
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("unexpected token %v, expected the array of entities", token)
+	}
+	for decoder.More() {
+		var sa SearchAssets
+		if typed {
+			var data json.RawMessage
+			if err := decoder.Decode(&data); err != nil {
+				return err
+			}
+			if sa, err = decodeSearchAsset(data, true); err != nil {
+				return err
+			}
+		} else if err := decoder.Decode(&sa); err != nil {
+			return err
+		}
+		sa.CustomMetadataSets = isr.unflattenCustomMetadata(attributes, sa.rawSearchAttributes)
+		if err := onEntity(&sa); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, ']')
+}
+
+// expectDelim reads the next token of the decoder, failing unless it is the given delimiter.
+func expectDelim(decoder *json.Decoder, expected json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("unexpected token %v, expected %v", token, expected)
+	}
+	return nil
+}
+
 // Helper function to unflatten custom metadata structures from index search results
 func (isr *IndexSearchResponse) unflattenCustomMetadata(searchParameterAttributes []string, searchAttributes map[string]interface{}) map[string]map[string]interface{} {
 	if len(searchParameterAttributes) == 0 || len(searchAttributes) == 0 {
@@ -596,6 +1023,9 @@ type SearchAssets struct {
 	SearchMeanings      []Meanings        `json:"meanings,omitempty"` // If meanings as json is already defined in Asset struct then defining the meanings here would result in an empty response.
 	NotNull             *bool             `json:"notNull,omitempty"`
 	rawSearchAttributes map[string]interface{}
+	// entity is the asset decoded into its generated type, when the search response is decoded
+	// with typed decoding.
+	entity entities.Object
 }
 
 type Meanings struct {
@@ -954,7 +1384,6 @@ func (sa *SearchAssets) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
-
 	// Directly handling the SearchMeanings if present (This is done because Meanings in IndexSearchResponse conflicits with meanings of type AtlasGlossaryTerm defined in /structs/asset.go .
 	type SearchMeaningsData struct {
 		SearchMeanings []Meanings `json:"meanings,omitempty"`
@@ -1115,6 +1544,91 @@ func (sa *SearchAssets) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// ToEntity returns the asset decoded into the type generated for its typeName, keeping every
+// attribute returned for it (including those SearchAssets doesn't declare), for use in a type
+// switch. See entities.Decode. Assets are only decoded into their type when the search response
+// is decoded with typed decoding (see UnmarshalIndexSearchResponse and DecodeIndexSearchResponse).
+func (sa *SearchAssets) ToEntity() (entities.Object, error) {
+	if sa.entity == nil {
+		return nil, fmt.Errorf("asset was not decoded into its type")
+	}
+	return sa.entity, nil
+}
+
+// decodeSearchAsset decodes an entity of an index search response, and also into the type
+// generated for its typeName when typed.
+func decodeSearchAsset(data []byte, typed bool) (SearchAssets, error) {
+	var sa SearchAssets
+	if err := json.Unmarshal(data, &sa); err != nil {
+		return sa, err
+	}
+	if typed {
+		entity, err := entities.Decode(data)
+		if err != nil {
+			return sa, err
+		}
+		sa.entity = entity
+	}
+	return sa, nil
+}
+
+// SortValues returns the values of the asset for each field of the sort, as needed to fetch
+// the results that follow it with search_after. It returns false if any of the values is not
+// available on the asset, for example because the sorted attribute was not included on results.
+func (sa *SearchAssets) SortValues(sort []map[string]interface{}) ([]interface{}, bool) {
+	values := make([]interface{}, 0, len(sort))
+	for _, sortItem := range sort {
+		for field := range sortItem {
+			value, ok := sa.sortValue(field)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, value)
+		}
+	}
+	return values, true
+}
+
+func (sa *SearchAssets) sortValue(field string) (interface{}, bool) {
+	var value interface{}
+	switch field {
+	case "__guid":
+		value = sa.Guid
+	case "__typeName", "__typeName.keyword":
+		value = sa.TypeName
+	case "__timestamp":
+		value = sa.CreateTime
+	case "__createdBy":
+		value = sa.CreatedBy
+	case "__modifiedBy":
+		value = sa.UpdatedBy
+	case "__state":
+		value = sa.Status
+	default:
+		attribute := field
+		for _, suffix := range []string{".keyword", ".text", ".stemmed"} {
+			attribute = strings.TrimSuffix(attribute, suffix)
+		}
+		value, ok := sa.rawSearchAttributes[attribute]
+		return value, ok && value != nil
+	}
+	switch v := value.(type) {
+	case *string:
+		if v != nil {
+			return *v, true
+		}
+	case *int:
+		if v != nil {
+			return *v, true
+		}
+	case *atlan.AtlanStatus:
+		if v != nil {
+			return string(*v), true
+		}
+	}
+	return nil, false
+}
+
 func (sa *SearchAssets) FromJSON(data []byte) error {
 	return json.Unmarshal(data, sa)
 }
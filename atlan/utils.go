@@ -2,6 +2,7 @@ package atlan
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	gonanoid "github.com/matoous/go-nanoid"
@@ -24,9 +25,10 @@ func MakeUnique(input string) string {
 
 var sNextID = int64(time.Now().UnixNano()/int64(time.Millisecond)) + 1
 
+// NextID returns a new placeholder (negative) GUID, unique within the process. It is safe for
+// concurrent use.
 func NextID() string {
-	sNextID++
-	return fmt.Sprintf("-%d", sNextID)
+	return fmt.Sprintf("-%d", atomic.AddInt64(&sNextID, 1))
 }
 
 // Helper function to check if a slice Contains a string
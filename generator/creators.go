@@ -0,0 +1,313 @@
+package main
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// creatorSpec describes how the Creator of an entity type builds the qualified names of a
+// new asset, which the metamodel itself does not describe.
+type creatorSpec struct {
+	kind string
+	// ancestors are the prefixes of the name and qualifiedName attributes that hold each
+	// level between the connection and the parent of the asset, from the outermost in.
+	ancestors []string
+	// parents are the types of asset the asset can be created in. Assets without parents
+	// are created directly in a connection.
+	parents []parentSpec
+	// segment is inserted in the qualified name between the parent and the name of the asset.
+	segment string
+	// order is the attribute that holds the position of the asset in its parent, which the
+	// Creator then requires, or empty for assets without a position.
+	order string
+}
+
+type parentSpec struct {
+	typeName     string
+	relationship string
+	// prefix of the name and qualifiedName attributes that hold the parent on the asset.
+	prefix string
+}
+
+const (
+	// connectionCreator creates a connection for a type of connector.
+	connectionCreator = "connection"
+	// hierarchyCreator creates an asset under a parent, in the hierarchy of a connection.
+	hierarchyCreator = "hierarchy"
+	// glossaryCreator creates a glossary.
+	glossaryCreator = "glossary"
+	// anchoredCreator creates an asset anchored in a glossary.
+	anchoredCreator = "anchored"
+)
+
+var sqlAncestors = []string{"database"}
+
+// creatorSpecs are the entity types that have a Creator.
+var creatorSpecs = map[string]creatorSpec{
+	"Connection": {kind: connectionCreator},
+	"Database":   {kind: hierarchyCreator},
+	"Schema": {kind: hierarchyCreator, parents: []parentSpec{
+		{"Database", "database", "database"},
+	}},
+	"Table": {kind: hierarchyCreator, ancestors: sqlAncestors, parents: []parentSpec{
+		{"Schema", "atlanSchema", "schema"},
+	}},
+	"View": {kind: hierarchyCreator, ancestors: sqlAncestors, parents: []parentSpec{
+		{"Schema", "atlanSchema", "schema"},
+	}},
+	"MaterialisedView": {kind: hierarchyCreator, ancestors: sqlAncestors, parents: []parentSpec{
+		{"Schema", "atlanSchema", "schema"},
+	}},
+	"Column": {kind: hierarchyCreator, ancestors: []string{"database", "schema"}, order: "order", parents: []parentSpec{
+		{"Table", "table", "table"},
+		{"View", "view", "view"},
+		{"MaterialisedView", "materialisedView", "view"},
+	}},
+	"KafkaTopic":            {kind: hierarchyCreator, segment: "topic"},
+	"AtlasGlossary":         {kind: glossaryCreator},
+	"AtlasGlossaryTerm":     {kind: anchoredCreator},
+	"AtlasGlossaryCategory": {kind: anchoredCreator},
+}
+
+// creatorLayout is the Creator of an entity type, with its attributes resolved to the fields
+// of the generated struct.
+type creatorLayout struct {
+	Kind     string
+	Name     string
+	TypeName string
+	Param    string
+	Levels   int
+	Segment  string
+	// OrderField is the field that holds the position of the asset, when the Creator takes one.
+	OrderField string
+	Ancestors  []ancestorLayout
+	Parents    []parentLayout
+}
+
+type ancestorLayout struct {
+	Index              int
+	NameField          string
+	QualifiedNameField string
+}
+
+type parentLayout struct {
+	TypeName           string
+	Description        string
+	Relationship       string
+	NameField          string
+	QualifiedNameField string
+}
+
+func (c *creatorLayout) imports() []string {
+	switch {
+	case c.Kind == connectionCreator:
+		return []string{"github.com/atlanhq/atlan-go/atlan"}
+	case len(c.Parents) > 1:
+		return []string{"fmt"}
+	default:
+		return nil
+	}
+}
+
+// creatorLayout resolves the attributes that the Creator of an entity type sets, so that a
+// specification that doesn't match the metamodel fails to generate.
+func (m *metamodel) creatorLayout(entity *entityType, spec creatorSpec) (*creatorLayout, error) {
+	attributes := m.allAttributes(entity)
+	field := func(attribute string) (string, error) {
+		if name, ok := attributes[attribute]; ok {
+			return name, nil
+		}
+		return "", fmt.Errorf("entity type %s has no attribute %s for its Creator", entity.def.Name, attribute)
+	}
+	required := []string{"name", "qualifiedName"}
+	switch spec.kind {
+	case connectionCreator:
+		required = append(required, "connectorName", "category", "adminUsers", "adminGroups")
+	case hierarchyCreator:
+		required = append(required, "connectorName", "connectionQualifiedName")
+	case anchoredCreator:
+		required = append(required, "anchor")
+	}
+	for _, attribute := range required {
+		if _, err := field(attribute); err != nil {
+			return nil, err
+		}
+	}
+
+	creator := &creatorLayout{
+		Kind:     spec.kind,
+		Name:     goTypeName(entity.def.Name),
+		TypeName: entity.def.Name,
+		Param:    "connectionQualifiedName",
+		Segment:  spec.segment,
+	}
+	if spec.order != "" {
+		var err error
+		if creator.OrderField, err = field(spec.order); err != nil {
+			return nil, err
+		}
+	}
+	if len(spec.parents) == 0 {
+		return creator, nil
+	}
+	creator.Levels = len(spec.ancestors) + 1
+	for i, prefix := range spec.ancestors {
+		ancestor := ancestorLayout{Index: i}
+		var err error
+		if ancestor.NameField, err = field(prefix + "Name"); err != nil {
+			return nil, err
+		}
+		if ancestor.QualifiedNameField, err = field(prefix + "QualifiedName"); err != nil {
+			return nil, err
+		}
+		creator.Ancestors = append(creator.Ancestors, ancestor)
+	}
+	for _, parent := range spec.parents {
+		resolved := parentLayout{TypeName: parent.typeName, Description: lowerFirst(parent.typeName)}
+		var err error
+		if resolved.Relationship, err = field(parent.relationship); err != nil {
+			return nil, err
+		}
+		if resolved.NameField, err = field(parent.prefix + "Name"); err != nil {
+			return nil, err
+		}
+		if resolved.QualifiedNameField, err = field(parent.prefix + "QualifiedName"); err != nil {
+			return nil, err
+		}
+		creator.Parents = append(creator.Parents, resolved)
+	}
+	if len(creator.Parents) == 1 {
+		creator.Param = creator.Parents[0].Description + "QualifiedName"
+	} else {
+		creator.Param = "parentQualifiedName"
+	}
+	return creator, nil
+}
+
+// lowerFirst returns the name with its first letter in lower case, for example schema for Schema.
+func lowerFirst(name string) string {
+	if name == "" || name[0] < 'A' || name[0] > 'Z' {
+		return name
+	}
+	return string(name[0]+'a'-'A') + name[1:]
+}
+
+var creatorTemplate = template.Must(typesTemplate.New("creator").Parse(`
+{{- if eq .Kind "connection"}}
+// Creator sets the attributes required to create a new connection, named name, for the given
+// type of connector. At least one admin user or group is required.
+func (e *{{.Name}}) Creator(name string, connectorType atlan.AtlanConnectorType, adminUsers, adminGroups []string) error {
+	if name == "" || connectorType.Value == "" {
+		return errors.New("name and connectorType are required fields")
+	}
+	if len(adminUsers) == 0 && len(adminGroups) == 0 {
+		return errors.New("at least one admin user or group is required")
+	}
+	e.TypeName = structs.StringPtr("{{.TypeName}}")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(connectorType.ToQualifiedName())
+	e.ConnectorName = structs.StringPtr(connectorType.Value)
+	e.Category = structs.StringPtr(connectorType.Category.Name)
+	if len(adminUsers) > 0 {
+		e.AdminUsers = &adminUsers
+	}
+	if len(adminGroups) > 0 {
+		e.AdminGroups = &adminGroups
+	}
+	return nil
+}
+{{- else if eq .Kind "glossary"}}
+// Creator sets the attributes required to create a new glossary, named name.
+func (e *{{.Name}}) Creator(name string) error {
+	if name == "" {
+		return errors.New("name is a required field")
+	}
+	e.TypeName = structs.StringPtr("{{.TypeName}}")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(name)
+	return nil
+}
+{{- else if eq .Kind "anchored"}}
+// Creator sets the attributes required to create a new {{.TypeName}}, named name, in the
+// glossary with the given GUID.
+func (e *{{.Name}}) Creator(name, glossaryGuid string) error {
+	if name == "" || glossaryGuid == "" {
+		return errors.New("name and glossaryGuid are required fields")
+	}
+	e.TypeName = structs.StringPtr("{{.TypeName}}")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(name)
+	e.Anchor = RefByGuid("AtlasGlossary", glossaryGuid)
+	return nil
+}
+{{- else}}
+{{- $param := .Param}}
+{{- $parentIndex := len .Ancestors}}
+{{- if gt (len .Parents) 1}}
+// Creator sets the attributes required to create a new {{.TypeName}}, named name, in the
+// parent of the given type ({{range $i, $p := .Parents}}{{if $i}}, {{end}}{{$p.TypeName}}{{end}}) and qualified name
+{{- if .OrderField}},
+// at the given position in the parent (starting at 1){{end}}.
+func (e *{{.Name}}) Creator(name, parentType, {{$param}} string{{if .OrderField}}, order int{{end}}) error {
+	if name == "" || parentType == "" || {{$param}} == "" {
+		return errors.New("name, parentType and {{$param}} are required fields")
+	}
+{{- else if .Parents}}
+// Creator sets the attributes required to create a new {{.TypeName}}, named name, in the
+// {{(index .Parents 0).Description}} with the given qualified name
+{{- if .OrderField}},
+// at the given position in the {{(index .Parents 0).Description}} (starting at 1){{end}}.
+func (e *{{.Name}}) Creator(name, {{$param}} string{{if .OrderField}}, order int{{end}}) error {
+	if name == "" || {{$param}} == "" {
+		return errors.New("name and {{$param}} are required fields")
+	}
+{{- else}}
+// Creator sets the attributes required to create a new {{.TypeName}}, named name, in the
+// connection with the given qualified name.
+func (e *{{.Name}}) Creator(name, {{$param}} string) error {
+	if name == "" || {{$param}} == "" {
+		return errors.New("name and {{$param}} are required fields")
+	}
+{{- end}}
+{{- if .OrderField}}
+	if order < 1 {
+		return errors.New("order must be a positive integer")
+	}
+{{- end}}
+	hierarchy, err := parseHierarchy({{$param}}, {{.Levels}})
+	if err != nil {
+		return err
+	}
+{{- if gt (len .Parents) 1}}
+	switch parentType {
+{{- range .Parents}}
+	case "{{.TypeName}}":
+		e.{{.NameField}} = structs.StringPtr(hierarchy.names[{{$parentIndex}}])
+		e.{{.QualifiedNameField}} = structs.StringPtr({{$param}})
+		e.{{.Relationship}} = RefByQualifiedName("{{.TypeName}}", {{$param}})
+{{- end}}
+	default:
+		return fmt.Errorf("a {{.TypeName}} can't be created in a %s", parentType)
+	}
+{{- else}}
+{{- range .Parents}}
+	e.{{.NameField}} = structs.StringPtr(hierarchy.names[{{$parentIndex}}])
+	e.{{.QualifiedNameField}} = structs.StringPtr({{$param}})
+	e.{{.Relationship}} = RefByQualifiedName("{{.TypeName}}", {{$param}})
+{{- end}}
+{{- end}}
+	e.TypeName = structs.StringPtr("{{.TypeName}}")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr({{$param}} + "/{{if .Segment}}{{.Segment}}/{{end}}" + name)
+	e.ConnectorName = structs.StringPtr(hierarchy.connectorName)
+	e.ConnectionQualifiedName = structs.StringPtr(hierarchy.connectionQualifiedName)
+{{- if .OrderField}}
+	e.{{.OrderField}} = &order
+{{- end}}
+{{- range .Ancestors}}
+	e.{{.NameField}} = structs.StringPtr(hierarchy.names[{{.Index}}])
+	e.{{.QualifiedNameField}} = structs.StringPtr(hierarchy.qualifiedNames[{{.Index}}])
+{{- end}}
+	return nil
+}
+{{- end}}`))
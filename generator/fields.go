@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// searchField is a field of an entity type that can be searched on, built with the
+// constructor of its kind (one of the field types of atlan_fields.go).
+type searchField struct {
+	Constant    string
+	Kind        string
+	Args        []string
+	Description string
+}
+
+// ConstructorArgs returns the arguments to the constructor of the field, as Go source.
+func (f searchField) ConstructorArgs() string {
+	quoted := make([]string, len(f.Args))
+	for i, arg := range f.Args {
+		quoted[i] = strconv.Quote(arg)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// internalFields are the fields that every entity has, which are indexed under internal names
+// rather than as attributes of the root of the metamodel.
+var internalFields = []searchField{
+	{"TYPE_NAME", "KeywordTextField", []string{"typeName", "__typeName.keyword", "__typeName"}, "Type of the asset. For example Table, Column, and so on."},
+	{"GUID", "KeywordField", []string{"guid", "__guid"}, "Globally unique identifier (GUID) of any object in Atlan."},
+	{"CREATED_BY", "KeywordField", []string{"createdBy", "__createdBy"}, "Atlan user who created this asset."},
+	{"UPDATED_BY", "KeywordField", []string{"updatedBy", "__modifiedBy"}, "Atlan user who last updated the asset."},
+	{"STATUS", "KeywordField", []string{"status", "__state"}, "Asset status in Atlan (active vs deleted)."},
+	{"ATLAN_TAGS", "KeywordTextField", []string{"classificationNames", "__traitNames", "__classificationsText"}, "All directly-assigned Atlan tags that exist on an asset, searchable by internal hashed-string ID of the Atlan tag."},
+	{"PROPAGATED_ATLAN_TAGS", "KeywordTextField", []string{"classificationNames", "__propagatedTraitNames", "__classificationsText"}, "All propagated Atlan tags that exist on an asset, searchable by internal hashed-string ID of the Atlan tag."},
+	{"ASSIGNED_TERMS", "KeywordTextField", []string{"meanings", "__meanings", "__meaningsText"}, "All terms attached to an asset, searchable by the term's qualifiedName."},
+	{"SUPER_TYPE_NAMES", "KeywordTextField", []string{"typeName", "__superTypeNames.keyword", "__superTypeNames"}, "All super types of an asset."},
+	{"CREATE_TIME", "NumericField", []string{"createTime", "__timestamp"}, "Time (in milliseconds) when the asset was created."},
+	{"UPDATE_TIME", "NumericField", []string{"updateTime", "__modificationTimestamp"}, "Time (in milliseconds) when the asset was last updated."},
+}
+
+var numericTypes = map[string]bool{
+	"byte": true, "short": true, "int": true, "long": true, "float": true, "double": true,
+	"biginteger": true, "bigdecimal": true, "date": true,
+}
+
+// searchFieldFor returns the searchable field for an attribute, or false if the attribute
+// can't be searched on directly (for example, attributes that hold structs).
+func (m *metamodel) searchFieldFor(attribute attributeDef) (searchField, bool) {
+	name := attribute.Name
+	field := searchField{Constant: constantName(name), Description: oneLine(attribute.Description)}
+	typeName := elementType(attribute.TypeName)
+	switch {
+	case m.structs[typeName]:
+		return field, false
+	case m.entities[typeName] != nil:
+		field.Kind, field.Args = "RelationField", []string{name}
+	case typeName == "boolean":
+		field.Kind, field.Args = "BooleanField", []string{name, name}
+	case numericTypes[typeName]:
+		field.Kind, field.Args = "NumericField", []string{name, name}
+	case typeName == "string" || m.enums[typeName] || strings.HasPrefix(typeName, "map<"):
+		field.Kind, field.Args = keywordField(attribute)
+	default:
+		return field, false
+	}
+	return field, true
+}
+
+// keywordField returns the kind of field and constructor arguments for a string attribute,
+// depending on whether it is indexed primarily as a keyword or as text, and its other indexes.
+func keywordField(attribute attributeDef) (string, []string) {
+	name := attribute.Name
+	_, hasKeyword := attribute.IndexTypeESFields["keyword"]
+	_, hasText := attribute.IndexTypeESFields["text"]
+	_, hasStemmed := attribute.IndexTypeESFields["stemmed"]
+	textPrimary := attribute.IndexTypeESConfig["analyzer"] != ""
+	switch {
+	case textPrimary && hasKeyword && hasStemmed:
+		return "KeywordTextStemmedField", []string{name, name + ".keyword", name, name + ".stemmed"}
+	case textPrimary && hasKeyword:
+		return "KeywordTextField", []string{name, name + ".keyword", name}
+	case textPrimary:
+		return "TextField", []string{name, name}
+	case hasText && hasStemmed:
+		return "KeywordTextStemmedField", []string{name, name, name + ".text", name + ".stemmed"}
+	case hasText:
+		return "KeywordTextField", []string{name, name, name + ".text"}
+	default:
+		return "KeywordField", []string{name, name}
+	}
+}
+
+// ownFields returns the searchable fields declared by the entity type itself, including the
+// internal fields for the roots of the metamodel.
+func (m *metamodel) ownFields(entity *entityType) []searchField {
+	var fields []searchField
+	if len(entity.superTypes) == 0 {
+		fields = append(fields, internalFields...)
+	}
+	seen := make(map[string]bool)
+	for _, attribute := range entity.def.AttributeDefs {
+		seen[attribute.Name] = true
+		if field, ok := m.searchFieldFor(attribute); ok {
+			fields = append(fields, field)
+		}
+	}
+	for _, attribute := range entity.def.RelationshipAttributeDefs {
+		if seen[attribute.Name] {
+			// Attributes can also be listed as relationship attributes
+			continue
+		}
+		seen[attribute.Name] = true
+		fields = append(fields, searchField{
+			Constant:    constantName(attribute.Name),
+			Kind:        "RelationField",
+			Args:        []string{attribute.Name},
+			Description: oneLine(attribute.Description),
+		})
+	}
+	return fields
+}
+
+// allFields returns every searchable field of the entity type, including inherited ones.
+func (m *metamodel) allFields(entity *entityType) []searchField {
+	return allMembers(entity, m.ownFields, searchFieldKey)
+}
+
+func searchFieldKey(field searchField) string {
+	return field.Constant
+}
+
+// fieldsLayout is how the fields of an entity type are laid out in its generated struct.
+type fieldsLayout struct {
+	Name        string
+	TypeName    string
+	Description string
+	Embedded    string
+	Fields      []searchField
+}
+
+func (m *metamodel) layout(entity *entityType) fieldsLayout {
+	layout := fieldsLayout{
+		Name:        goTypeName(entity.def.Name),
+		TypeName:    entity.def.Name,
+		Description: oneLine(entity.def.Description),
+		Fields:      declaredMembers(entity, m.ownFields, searchFieldKey),
+	}
+	if len(entity.superTypes) > 0 {
+		layout.Embedded = goTypeName(entity.superTypes[0].def.Name)
+	}
+	return layout
+}
+
+// oneLine collapses the whitespace of a description, so that it fits in a line comment.
+func oneLine(description string) string {
+	return strings.Join(strings.Fields(description), " ")
+}
+
+var fieldsTemplate = template.Must(template.New("fields").Parse(`// Code generated by go run ./generator; DO NOT EDIT.
+
+package fields
+
+import "github.com/atlanhq/atlan-go/atlan/assets"
+{{range .}}
+// {{.Name}}Fields holds the searchable fields of {{.TypeName}} assets.{{if .Description}}
+// {{.TypeName}}: {{.Description}}{{end}}
+type {{.Name}}Fields struct {
+{{- if .Embedded}}
+	{{.Embedded}}Fields
+{{- end}}
+{{- range .Fields}}{{if .Description}}
+	// {{.Constant}} {{.Description}}{{end}}
+	{{.Constant}} *assets.{{.Kind}}
+{{- end}}
+}
+
+func new{{.Name}}Fields() {{.Name}}Fields {
+	return {{.Name}}Fields{
+{{- if .Embedded}}
+		{{.Embedded}}Fields: new{{.Embedded}}Fields(),
+{{- end}}
+{{- range .Fields}}
+		{{.Constant}}: assets.New{{.Kind}}({{.ConstructorArgs}}),
+{{- end}}
+	}
+}
+{{end}}
+var (
+{{- range .}}
+	// {{.Name}} holds the searchable fields of {{.TypeName}} assets.
+	{{.Name}} = new{{.Name}}Fields()
+{{- end}}
+)
+`))
+
+// generateFields returns the Go source of the fields package for the metamodel.
+func generateFields(m *metamodel) ([]byte, error) {
+	entities := m.sortedEntities()
+	layouts := make([]fieldsLayout, len(entities))
+	for i, entity := range entities {
+		layouts[i] = m.layout(entity)
+	}
+	var source bytes.Buffer
+	if err := fieldsTemplate.Execute(&source, layouts); err != nil {
+		return nil, fmt.Errorf("generating fields: %w", err)
+	}
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated fields: %w", err)
+	}
+	return formatted, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTypeDefs = `{
+	"enumDefs": [{"name": "certificate_status"}],
+	"structDefs": [{"name": "AwsTag"}],
+	"entityDefs": [
+		{"name": "Referenceable", "superTypes": [], "attributeDefs": [
+			{"name": "qualifiedName", "typeName": "string", "indexTypeESFields": {"text": {"type": "text"}}}
+		]},
+		{"name": "Asset", "description": "Base class for all assets.", "superTypes": ["Referenceable"], "attributeDefs": [
+			{"name": "name", "typeName": "string", "indexTypeESConfig": {"analyzer": "atlan_text_analyzer"},
+				"indexTypeESFields": {"keyword": {"type": "keyword"}, "stemmed": {"type": "text"}}},
+			{"name": "displayName", "typeName": "string", "indexTypeESConfig": {"analyzer": "atlan_text_analyzer"},
+				"indexTypeESFields": {"keyword": {"type": "keyword"}}},
+			{"name": "certificateStatus", "typeName": "certificate_status", "description": "Status of this asset's\ncertification."},
+			{"name": "ownerUsers", "typeName": "array<string>"}
+		], "relationshipAttributeDefs": [
+			{"name": "meanings", "typeName": "array<AtlasGlossaryTerm>"}
+		]},
+		{"name": "Catalog", "superTypes": ["Asset"], "attributeDefs": [], "relationshipAttributeDefs": [
+			{"name": "inputToProcesses", "typeName": "array<Process>"}
+		]},
+		{"name": "AWS", "superTypes": ["Asset"], "attributeDefs": [
+			{"name": "awsArn", "typeName": "string"},
+			{"name": "awsTags", "typeName": "array<AwsTag>"}
+		]},
+		{"name": "S3", "superTypes": ["Catalog", "AWS"], "attributeDefs": [
+			{"name": "s3ETag", "typeName": "string"}
+		]},
+		{"name": "Process", "superTypes": ["Asset"], "attributeDefs": [
+			{"name": "inputs", "typeName": "array<Catalog>"},
+			{"name": "isActive", "typeName": "boolean"},
+			{"name": "lastRunAt", "typeName": "date"}
+		], "relationshipAttributeDefs": [
+			{"name": "inputs", "typeName": "array<Catalog>"}
+		]}
+	]
+}`
+
+func TestGenerateFields(t *testing.T) {
+	m, err := parseTypeDefs([]byte(testTypeDefs))
+	require.NoError(t, err)
+	source, err := generateFields(m)
+	require.NoError(t, err)
+	_, err = parser.ParseFile(token.NewFileSet(), "fields_gen.go", source, parser.ParseComments)
+	require.NoError(t, err)
+
+	// Compare ignoring the alignment of the generated code
+	generated := normalizeSpace(string(source))
+	for _, expected := range []string{
+		`TYPE_NAME: assets.NewKeywordTextField("typeName", "__typeName.keyword", "__typeName"),`,
+		`QUALIFIED_NAME: assets.NewKeywordTextField("qualifiedName", "qualifiedName", "qualifiedName.text"),`,
+		`NAME:              assets.NewKeywordTextStemmedField("name", "name.keyword", "name", "name.stemmed"),`,
+		`DISPLAY_NAME:      assets.NewKeywordTextField("displayName", "displayName.keyword", "displayName"),`,
+		`CERTIFICATE_STATUS: assets.NewKeywordField("certificateStatus", "certificateStatus"),`,
+		"// CERTIFICATE_STATUS Status of this asset's certification.\n",
+		`MEANINGS:          assets.NewRelationField("meanings"),`,
+		`INPUTS:       assets.NewRelationField("inputs"),`,
+		`IS_ACTIVE:    assets.NewBooleanField("isActive", "isActive"),`,
+		`LAST_RUN_AT:  assets.NewNumericField("lastRunAt", "lastRunAt"),`,
+		"type S3Fields struct {\n\tCatalogFields\n",
+		`S3_E_TAG:      assets.NewKeywordField("s3ETag", "s3ETag"),`,
+		`AWS_ARN:       assets.NewKeywordField("awsArn", "awsArn"),`,
+		"S3 = newS3Fields()",
+	} {
+		assert.Contains(t, generated, normalizeSpace(expected))
+	}
+	// Struct attributes can't be searched on, and inherited fields are only declared once
+	assert.NotContains(t, generated, "AWS_TAGS")
+	assert.Equal(t, 1, strings.Count(generated, " INPUTS *assets.RelationField"))
+	assert.Equal(t, 1, strings.Count(generated, " NAME *assets.KeywordTextStemmedField"))
+}
+
+func TestParseTypeDefsRejectsUnknownSupertypes(t *testing.T) {
+	_, err := parseTypeDefs([]byte(`{"entityDefs": [{"name": "Table", "superTypes": ["SQL"]}]}`))
+	assert.EqualError(t, err, "entity type Table has unknown supertype SQL")
+}
+
+func TestConstantName(t *testing.T) {
+	for attribute, expected := range map[string]string{
+		"columnCount":     "COLUMN_COUNT",
+		"sqlDBTSources":   "SQL_DBT_SOURCES",
+		"powerBIIsHidden": "POWER_BI_IS_HIDDEN",
+		"sourceURL":       "SOURCE_URL",
+		"s3ETag":          "S3_E_TAG",
+		"__hasLineage":    "HAS_LINEAGE",
+	} {
+		assert.Equal(t, expected, constantName(attribute), attribute)
+	}
+}
+
+// TestGeneratedFieldsAreUpToDate checks that the generated fields match the snapshot of the type
+// definitions, so that changes to either are always made by re-running the generator.
+func TestGeneratedFieldsAreUpToDate(t *testing.T) {
+	data, err := os.ReadFile("typedefs.json")
+	require.NoError(t, err)
+	m, err := parseTypeDefs(data)
+	require.NoError(t, err)
+	expected, err := generateFields(m)
+	require.NoError(t, err)
+	actual, err := os.ReadFile("../atlan/assets/fields/fields_gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual), "run go generate ./atlan/assets/fields")
+}
+
+func TestSnapshotRelatesOnlyTypesItDefines(t *testing.T) {
+	data, err := os.ReadFile("typedefs.json")
+	require.NoError(t, err)
+	m, err := parseTypeDefs(data)
+	require.NoError(t, err)
+	for _, entity := range m.sortedEntities() {
+		for _, attribute := range entity.def.RelationshipAttributeDefs {
+			_, ok := m.entities[elementType(attribute.TypeName)]
+			assert.True(t, ok, "%s.%s relates %s, which the snapshot doesn't define", entity.def.Name, attribute.Name, attribute.TypeName)
+		}
+	}
+}
+
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
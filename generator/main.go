@@ -0,0 +1,93 @@
+// Command generator generates code for the asset types of Atlan from their type definitions,
+// as returned by GET_ALL_TYPE_DEFS.
+//
+// The type definitions are read from a snapshot, so that the generated code is reproducible.
+// To pick up changes to the metamodel, refresh the snapshot from a tenant (using the
+// ATLAN_API_KEY and ATLAN_BASE_URL environment variables) with -fetch and re-run:
+//
+//	go run ./generator -fetch
+//
+// Without -fetch, the generated code is only rewritten from the existing snapshot:
+//
+//	go run ./generator
+//
+// The committed snapshot was not fetched: it holds only the asset types the SDK models by hand,
+// so it needs a -fetch against a tenant to cover the whole metamodel.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/atlanhq/atlan-go/atlan/assets"
+)
+
+func main() {
+	typeDefsPath := flag.String("typedefs", "generator/typedefs.json", "snapshot of the type definitions to generate from")
+	fetch := flag.Bool("fetch", false, "refresh the snapshot of the type definitions from Atlan before generating")
+	fieldsPath := flag.String("fields", "atlan/assets/fields/fields_gen.go", "file to write the searchable fields of each asset type to, or empty to skip them")
+	typesPath := flag.String("types", "atlan/model/entities/entities_gen.go", "file to write the type of each asset type to, or empty to skip them")
+	flag.Parse()
+
+	if *fetch {
+		if err := fetchTypeDefs(*typeDefsPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := generate(*typeDefsPath, *fieldsPath, *typesPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// fetchTypeDefs saves the type definitions of the tenant to path, indented so that changes
+// to the metamodel are easy to review.
+func fetchTypeDefs(path string) error {
+	ctx := assets.NewContext()
+	data, err := ctx.CallAPI(&assets.GET_ALL_TYPE_DEFS, nil, nil)
+	if err != nil {
+		return fmt.Errorf("fetching type definitions: %w", err)
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "  "); err != nil {
+		return fmt.Errorf("formatting type definitions: %w", err)
+	}
+	indented.WriteByte('\n')
+	return os.WriteFile(path, indented.Bytes(), 0o644)
+}
+
+// generate writes the code generated from the type definitions at typeDefsPath to each of the
+// output paths that is set.
+func generate(typeDefsPath, fieldsPath, typesPath string) error {
+	data, err := os.ReadFile(typeDefsPath)
+	if err != nil {
+		return err
+	}
+	m, err := parseTypeDefs(data)
+	if err != nil {
+		return err
+	}
+	outputs := []struct {
+		path     string
+		generate func(*metamodel) ([]byte, error)
+	}{
+		{fieldsPath, generateFields},
+		{typesPath, generateTypes},
+	}
+	for _, output := range outputs {
+		if output.path == "" {
+			continue
+		}
+		source, err := output.generate(m)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(output.path, source, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
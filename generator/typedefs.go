@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// typeDefs is the part of the response of GET_ALL_TYPE_DEFS that the generator uses.
+type typeDefs struct {
+	EnumDefs   []namedDef  `json:"enumDefs"`
+	StructDefs []namedDef  `json:"structDefs"`
+	EntityDefs []entityDef `json:"entityDefs"`
+}
+
+type namedDef struct {
+	Name string `json:"name"`
+}
+
+type entityDef struct {
+	Name                      string         `json:"name"`
+	Description               string         `json:"description"`
+	SuperTypes                []string       `json:"superTypes"`
+	AttributeDefs             []attributeDef `json:"attributeDefs"`
+	RelationshipAttributeDefs []attributeDef `json:"relationshipAttributeDefs"`
+}
+
+type attributeDef struct {
+	Name              string                            `json:"name"`
+	TypeName          string                            `json:"typeName"`
+	Description       string                            `json:"description"`
+	IsOptional        bool                              `json:"isOptional"`
+	IndexTypeESConfig map[string]string                 `json:"indexTypeESConfig"`
+	IndexTypeESFields map[string]map[string]interface{} `json:"indexTypeESFields"`
+}
+
+// metamodel is the resolved hierarchy of entity types described by a set of type definitions.
+type metamodel struct {
+	entities map[string]*entityType
+	enums    map[string]bool
+	structs  map[string]bool
+}
+
+// entityType is an entity type of the metamodel, with its supertypes resolved.
+type entityType struct {
+	def        entityDef
+	superTypes []*entityType
+}
+
+// parseTypeDefs builds the metamodel from the JSON returned by GET_ALL_TYPE_DEFS.
+func parseTypeDefs(data []byte) (*metamodel, error) {
+	var defs typeDefs
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return nil, fmt.Errorf("parsing type definitions: %w", err)
+	}
+	m := &metamodel{
+		entities: make(map[string]*entityType, len(defs.EntityDefs)),
+		enums:    make(map[string]bool, len(defs.EnumDefs)),
+		structs:  make(map[string]bool, len(defs.StructDefs)),
+	}
+	for _, def := range defs.EnumDefs {
+		m.enums[def.Name] = true
+	}
+	for _, def := range defs.StructDefs {
+		m.structs[def.Name] = true
+	}
+	for _, def := range defs.EntityDefs {
+		m.entities[def.Name] = &entityType{def: def}
+	}
+	for _, entity := range m.entities {
+		for _, name := range entity.def.SuperTypes {
+			superType, ok := m.entities[name]
+			if !ok {
+				return nil, fmt.Errorf("entity type %s has unknown supertype %s", entity.def.Name, name)
+			}
+			entity.superTypes = append(entity.superTypes, superType)
+		}
+	}
+	for _, entity := range m.entities {
+		if err := m.checkAcyclic(entity, map[string]bool{}); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *metamodel) checkAcyclic(entity *entityType, visiting map[string]bool) error {
+	if visiting[entity.def.Name] {
+		return fmt.Errorf("entity type %s inherits from itself", entity.def.Name)
+	}
+	visiting[entity.def.Name] = true
+	defer delete(visiting, entity.def.Name)
+	for _, superType := range entity.superTypes {
+		if err := m.checkAcyclic(superType, visiting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortedEntities returns the entity types of the metamodel, sorted by name.
+func (m *metamodel) sortedEntities() []*entityType {
+	entities := make([]*entityType, 0, len(m.entities))
+	for _, entity := range m.entities {
+		entities = append(entities, entity)
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].def.Name < entities[j].def.Name
+	})
+	return entities
+}
+
+// declaredMembers returns the members (fields or attributes) that the generated struct of an
+// entity type declares directly. The struct of its first supertype is embedded, so any member
+// inherited through it is left out; the members of any other supertypes are declared along
+// with its own, as embedding them too would make the members they share ambiguous.
+func declaredMembers[T any](entity *entityType, own func(*entityType) []T, key func(T) string) []T {
+	inherited := make(map[string]bool)
+	candidates := own(entity)
+	if len(entity.superTypes) > 0 {
+		for _, member := range allMembers(entity.superTypes[0], own, key) {
+			inherited[key(member)] = true
+		}
+		for _, superType := range entity.superTypes[1:] {
+			candidates = append(candidates, allMembers(superType, own, key)...)
+		}
+	}
+	var declared []T
+	for _, member := range candidates {
+		if !inherited[key(member)] {
+			inherited[key(member)] = true
+			declared = append(declared, member)
+		}
+	}
+	return declared
+}
+
+// allMembers returns every member of the generated struct of an entity type, including
+// those it inherits.
+func allMembers[T any](entity *entityType, own func(*entityType) []T, key func(T) string) []T {
+	members := declaredMembers(entity, own, key)
+	if len(entity.superTypes) > 0 {
+		members = append(allMembers(entity.superTypes[0], own, key), members...)
+	}
+	return members
+}
+
+// elementType returns the type of the elements of a collection type (array<T> or set<T>), or the type itself.
+func elementType(typeName string) string {
+	for _, prefix := range []string{"array<", "set<"} {
+		if strings.HasPrefix(typeName, prefix) && strings.HasSuffix(typeName, ">") {
+			return strings.TrimSuffix(strings.TrimPrefix(typeName, prefix), ">")
+		}
+	}
+	return typeName
+}
+
+// goTypeName returns the exported Go identifier for a type name of the metamodel.
+func goTypeName(typeName string) string {
+	var b strings.Builder
+	upperNext := true
+	for _, r := range typeName {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9':
+			if upperNext && r >= 'a' && r <= 'z' {
+				r -= 'a' - 'A'
+			}
+			b.WriteRune(r)
+			upperNext = false
+		default:
+			upperNext = true
+		}
+	}
+	name := b.String()
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "Type" + name
+	}
+	return name
+}
+
+// constantName returns the upper snake case name for an attribute, for example
+// COLUMN_COUNT for columnCount and SQL_DBT_SOURCES for sqlDBTSources.
+func constantName(attributeName string) string {
+	runes := []rune(strings.TrimLeft(attributeName, "_"))
+	var b strings.Builder
+	for i, r := range runes {
+		isUpper := r >= 'A' && r <= 'Z'
+		if i > 0 && isUpper {
+			previous := runes[i-1]
+			previousLower := previous >= 'a' && previous <= 'z' || previous >= '0' && previous <= '9'
+			nextLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
+			previousUpper := previous >= 'A' && previous <= 'Z'
+			if previousLower || previousUpper && nextLower {
+				b.WriteRune('_')
+			}
+		}
+		switch {
+		case r >= 'a' && r <= 'z':
+			b.WriteRune(r - ('a' - 'A'))
+		case isUpper || r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// goField is an attribute of an entity type, as a field of its generated struct.
+type goField struct {
+	Name        string
+	Type        string
+	Attribute   string
+	Description string
+}
+
+func goFieldKey(field goField) string {
+	return field.Attribute
+}
+
+// entityFields are the fields of Entity, which the generated types embed, so attributes with
+// the same name are renamed to stay accessible.
+var entityFields = map[string]bool{
+	"TypeName": true, "Guid": true, "Status": true, "CreatedBy": true, "UpdatedBy": true,
+	"CreateTime": true, "UpdateTime": true, "Version": true, "IsIncomplete": true,
+	"AtlanTags": true, "AddOrUpdateAtlanTags": true, "RemoveAtlanTags": true, "BusinessAttributes": true, "CustomMetadata": true, "Labels": true, "Depth": true,
+	"UnknownAttributes": true, "UnknownRelationshipAttributes": true,
+}
+
+// goFieldName returns the exported Go identifier for an attribute, for example ColumnCount for
+// columnCount.
+func goFieldName(attributeName string) string {
+	name := strings.TrimLeft(attributeName, "_")
+	if name == "" {
+		return "Attribute"
+	}
+	name = strings.ToUpper(name[:1]) + name[1:]
+	if entityFields[name] {
+		name += "Attribute"
+	}
+	return name
+}
+
+var goScalarTypes = map[string]string{
+	"string": "string", "boolean": "bool",
+	"byte": "int", "short": "int", "int": "int", "long": "int64", "date": "int64", "biginteger": "int64",
+	"float": "float64", "double": "float64", "bigdecimal": "float64",
+}
+
+// goType returns the Go type that holds values of a type of the metamodel. Enumerations are
+// held as their string values, entities as references and structs by their attributes.
+func (m *metamodel) goType(typeName string) string {
+	if element := elementType(typeName); element != typeName {
+		return "[]" + m.goType(element)
+	}
+	if strings.HasPrefix(typeName, "map<") && strings.HasSuffix(typeName, ">") {
+		keyAndValue := strings.SplitN(typeName[len("map<"):len(typeName)-1], ",", 2)
+		if len(keyAndValue) == 2 {
+			return "map[string]" + m.goType(strings.TrimSpace(keyAndValue[1]))
+		}
+	}
+	switch {
+	case goScalarTypes[typeName] != "":
+		return goScalarTypes[typeName]
+	case m.enums[typeName]:
+		return "string"
+	case m.entities[typeName] != nil:
+		return "Reference"
+	case m.structs[typeName]:
+		return "Struct"
+	default:
+		return "interface{}"
+	}
+}
+
+func (m *metamodel) goField(attribute attributeDef, goType string) goField {
+	if goType != "interface{}" {
+		goType = "*" + goType
+	}
+	return goField{
+		Name:        goFieldName(attribute.Name),
+		Type:        goType,
+		Attribute:   attribute.Name,
+		Description: oneLine(attribute.Description),
+	}
+}
+
+// ownAttributes returns the attributes declared by the entity type itself.
+func (m *metamodel) ownAttributes(entity *entityType) []goField {
+	fields := make([]goField, 0, len(entity.def.AttributeDefs))
+	for _, attribute := range entity.def.AttributeDefs {
+		fields = append(fields, m.goField(attribute, m.goType(attribute.TypeName)))
+	}
+	return fields
+}
+
+// ownRelationshipAttributes returns the relationship attributes declared by the entity type
+// itself, other than those that are also attributes. They always refer to other entities,
+// even when the type of the entity is not part of the metamodel.
+func (m *metamodel) ownRelationshipAttributes(entity *entityType) []goField {
+	attributes := make(map[string]bool)
+	for _, attribute := range entity.def.AttributeDefs {
+		attributes[attribute.Name] = true
+	}
+	var fields []goField
+	for _, attribute := range entity.def.RelationshipAttributeDefs {
+		if attributes[attribute.Name] {
+			continue
+		}
+		attributes[attribute.Name] = true
+		goType := "Reference"
+		if elementType(attribute.TypeName) != attribute.TypeName {
+			goType = "[]Reference"
+		}
+		fields = append(fields, m.goField(attribute, goType))
+	}
+	return fields
+}
+
+// typeLayout is how an entity type is laid out in its generated types: the type itself, and
+// the structs of its attributes and relationship attributes, which embed those of its first
+// supertype.
+type typeLayout struct {
+	Name                   string
+	TypeName               string
+	Description            string
+	Embedded               string
+	Attributes             []goField
+	RelationshipAttributes []goField
+	Updatable              bool
+	Anchored               bool // updated within the glossary that anchors it
+	Creator                *creatorLayout
+}
+
+func (m *metamodel) typeLayout(entity *entityType) (typeLayout, error) {
+	layout := typeLayout{
+		Name:                   goTypeName(entity.def.Name),
+		TypeName:               entity.def.Name,
+		Description:            oneLine(entity.def.Description),
+		Attributes:             declaredMembers(entity, m.ownAttributes, goFieldKey),
+		RelationshipAttributes: declaredMembers(entity, m.ownRelationshipAttributes, goFieldKey),
+	}
+	if len(entity.superTypes) > 0 {
+		layout.Embedded = goTypeName(entity.superTypes[0].def.Name)
+	}
+	attributes := m.allAttributes(entity)
+	layout.Updatable = attributes["name"] != "" && attributes["qualifiedName"] != ""
+	if spec, ok := creatorSpecs[entity.def.Name]; ok {
+		creator, err := m.creatorLayout(entity, spec)
+		if err != nil {
+			return layout, err
+		}
+		layout.Creator = creator
+		layout.Anchored = spec.kind == anchoredCreator
+	}
+	return layout, nil
+}
+
+// allAttributes returns the Go field name of every attribute and relationship attribute of the
+// entity type, including inherited ones, by the name of the attribute.
+func (m *metamodel) allAttributes(entity *entityType) map[string]string {
+	names := make(map[string]string)
+	for _, own := range []func(*entityType) []goField{m.ownAttributes, m.ownRelationshipAttributes} {
+		for _, field := range allMembers(entity, own, goFieldKey) {
+			names[field.Attribute] = field.Name
+		}
+	}
+	return names
+}
+
+var typesTemplate = template.Must(template.New("types").Parse(`// Code generated by go run ./generator; DO NOT EDIT.
+
+package entities
+
+import (
+{{- range .StandardImports}}
+	"{{.}}"
+{{- end}}
+{{range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Types}}
+// {{.Name}} is an entity of type {{.TypeName}}.{{if .Description}}
+// {{.TypeName}}: {{.Description}}{{end}}
+type {{.Name}} struct {
+	Entity
+	{{.Name}}Attributes
+	{{.Name}}RelationshipAttributes
+}
+
+// {{.Name}}Attributes holds the attributes of {{.TypeName}} entities.
+type {{.Name}}Attributes struct {
+{{- if .Embedded}}
+	{{.Embedded}}Attributes
+{{- end}}
+{{- range .Attributes}}{{if .Description}}
+	// {{.Description}}{{end}}
+	{{.Name}} {{.Type}} ` + "`" + `json:"{{.Attribute}},omitempty"` + "`" + `
+{{- end}}
+}
+
+// {{.Name}}RelationshipAttributes holds the relationship attributes of {{.TypeName}} entities.
+type {{.Name}}RelationshipAttributes struct {
+{{- if .Embedded}}
+	{{.Embedded}}RelationshipAttributes
+{{- end}}
+{{- range .RelationshipAttributes}}{{if .Description}}
+	// {{.Description}}{{end}}
+	{{.Name}} {{.Type}} ` + "`" + `json:"{{.Attribute}},omitempty"` + "`" + `
+{{- end}}
+}
+
+// MarshalJSON encodes the {{.TypeName}} with the attributes and relationship attributes that are set.
+func (e *{{.Name}}) MarshalJSON() ([]byte, error) {
+	return marshalEntity("{{.TypeName}}", e.Entity, e.{{.Name}}Attributes, e.{{.Name}}RelationshipAttributes)
+}
+
+// UnmarshalJSON decodes a {{.TypeName}} with its attributes and relationship attributes.
+func (e *{{.Name}}) UnmarshalJSON(data []byte) error {
+	return unmarshalEntity(data, &e.Entity, &e.{{.Name}}Attributes, &e.{{.Name}}RelationshipAttributes)
+}
+
+// ToJSON encodes the {{.TypeName}} as indented JSON.
+func (e *{{.Name}}) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// FromJSON decodes a {{.TypeName}}, either on its own or as returned by GET_ENTITY_BY_GUID.
+func (e *{{.Name}}) FromJSON(data []byte) error {
+	return fromJSON(data, e)
+}
+{{- with .Creator}}
+{{template "creator" .}}
+{{- end}}
+{{- if .Updatable}}
+
+{{- if .Anchored}}
+// Updater sets the attributes required to update the {{.TypeName}} with the given name and qualified name,
+// in the glossary with the given GUID.
+func (e *{{.Name}}) Updater(name, qualifiedName, glossaryGuid string) error {
+	if name == "" || qualifiedName == "" || glossaryGuid == "" {
+		return errors.New("name, qualifiedName and glossaryGuid are required fields")
+	}
+	e.TypeName = structs.StringPtr("{{.TypeName}}")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	e.Anchor = RefByGuid("AtlasGlossary", glossaryGuid)
+	return nil
+}
+
+// TrimToRequired returns a copy of the {{.TypeName}} with only the attributes required to update it.
+func (e *{{.Name}}) TrimToRequired() (*{{.Name}}, error) {
+	if e.Name == nil || e.QualifiedName == nil || e.Anchor == nil || e.Anchor.Guid == "" {
+		return nil, errors.New("name, qualifiedName and the GUID of the anchor are required to trim a {{.TypeName}}")
+	}
+	trimmed := &{{.Name}}{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName, e.Anchor.Guid); err != nil {
+		return nil, err
+	}
+{{- else}}
+// Updater sets the attributes required to update the {{.TypeName}} with the given name and qualified name.
+func (e *{{.Name}}) Updater(name, qualifiedName string) error {
+	if name == "" || qualifiedName == "" {
+		return errors.New("name and qualifiedName are required fields")
+	}
+	e.TypeName = structs.StringPtr("{{.TypeName}}")
+	e.Name = structs.StringPtr(name)
+	e.QualifiedName = structs.StringPtr(qualifiedName)
+	return nil
+}
+
+// TrimToRequired returns a copy of the {{.TypeName}} with only the attributes required to update it.
+func (e *{{.Name}}) TrimToRequired() (*{{.Name}}, error) {
+	if e.Name == nil || e.QualifiedName == nil {
+		return nil, errors.New("name and qualifiedName are required to trim a {{.TypeName}}")
+	}
+	trimmed := &{{.Name}}{}
+	if err := trimmed.Updater(*e.Name, *e.QualifiedName); err != nil {
+		return nil, err
+	}
+{{- end}}
+	trimmed.Guid = e.Guid
+	return trimmed, nil
+}
+{{- end}}
+{{end}}
+// generatedTypes are the generated types of the entity types, by type name.
+var generatedTypes = map[string]func() Object{
+{{- range .Types}}
+	"{{.TypeName}}": func() Object { return &{{.Name}}{} },
+{{- end}}
+}
+`))
+
+// generateTypes returns the Go source of the entities package for the metamodel.
+func generateTypes(m *metamodel) ([]byte, error) {
+	entities := m.sortedEntities()
+	data := struct {
+		StandardImports []string
+		Imports         []string
+		Types           []typeLayout
+	}{}
+	imports := map[string]bool{"encoding/json": true}
+	for _, entity := range entities {
+		layout, err := m.typeLayout(entity)
+		if err != nil {
+			return nil, err
+		}
+		if layout.Updatable || layout.Creator != nil {
+			imports["errors"] = true
+			imports["github.com/atlanhq/atlan-go/atlan/model/structs"] = true
+		}
+		if layout.Creator != nil {
+			for _, imported := range layout.Creator.imports() {
+				imports[imported] = true
+			}
+		}
+		data.Types = append(data.Types, layout)
+	}
+	for imported := range imports {
+		if strings.Contains(strings.Split(imported, "/")[0], ".") {
+			data.Imports = append(data.Imports, imported)
+		} else {
+			data.StandardImports = append(data.StandardImports, imported)
+		}
+	}
+	sort.Strings(data.StandardImports)
+	sort.Strings(data.Imports)
+	var source bytes.Buffer
+	if err := typesTemplate.Execute(&source, data); err != nil {
+		return nil, fmt.Errorf("generating types: %w", err)
+	}
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated types: %w", err)
+	}
+	return formatted, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTypes(t *testing.T) {
+	m, err := parseTypeDefs([]byte(testTypeDefs))
+	require.NoError(t, err)
+	source, err := generateTypes(m)
+	require.NoError(t, err)
+	_, err = parser.ParseFile(token.NewFileSet(), "entities_gen.go", source, parser.ParseComments)
+	require.NoError(t, err)
+
+	generated := normalizeSpace(string(source))
+	for _, expected := range []string{
+		"type Process struct {\n\tEntity\n\tProcessAttributes\n\tProcessRelationshipAttributes\n}",
+		"type ProcessAttributes struct {\n\tAssetAttributes\n",
+		"type ProcessRelationshipAttributes struct {\n\tAssetRelationshipAttributes\n}",
+		"// Status of this asset's certification.\n\tCertificateStatus *string `json:\"certificateStatus,omitempty\"`",
+		"OwnerUsers *[]string `json:\"ownerUsers,omitempty\"`",
+		"Meanings *[]Reference `json:\"meanings,omitempty\"`",
+		"Inputs *[]Reference `json:\"inputs,omitempty\"`",
+		"IsActive *bool `json:\"isActive,omitempty\"`",
+		"LastRunAt *int64 `json:\"lastRunAt,omitempty\"`",
+		"AwsTags *[]Struct `json:\"awsTags,omitempty\"`",
+		"type S3Attributes struct {\n\tCatalogAttributes\n\t",
+		"return marshalEntity(\"S3\", e.Entity, e.S3Attributes, e.S3RelationshipAttributes)",
+		"func (e *Asset) Updater(name, qualifiedName string) error {",
+		"func (e *Asset) TrimToRequired() (*Asset, error) {",
+	} {
+		assert.Contains(t, generated, normalizeSpace(expected))
+	}
+	// Attributes of other supertypes are declared again (S3 only embeds Catalog), and the root
+	// has no name to update by
+	assert.Equal(t, 2, strings.Count(generated, " AwsArn *string"))
+	assert.NotContains(t, generated, "func (e *Referenceable) Updater")
+	assert.NotContains(t, generated, "Creator")
+}
+
+func TestGenerateTypesChecksCreators(t *testing.T) {
+	m, err := parseTypeDefs([]byte(`{"entityDefs": [
+		{"name": "Referenceable", "attributeDefs": [{"name": "qualifiedName", "typeName": "string"}]},
+		{"name": "Table", "superTypes": ["Referenceable"], "attributeDefs": [{"name": "name", "typeName": "string"}]}
+	]}`))
+	require.NoError(t, err)
+	_, err = generateTypes(m)
+	assert.EqualError(t, err, "entity type Table has no attribute connectorName for its Creator")
+}
+
+func TestGoType(t *testing.T) {
+	m, err := parseTypeDefs([]byte(testTypeDefs))
+	require.NoError(t, err)
+	for typeName, expected := range map[string]string{
+		"string":                      "string",
+		"certificate_status":          "string",
+		"int":                         "int",
+		"long":                        "int64",
+		"float":                       "float64",
+		"array<string>":               "[]string",
+		"map<string,int>":             "map[string]int",
+		"array<map<string,string>>":   "[]map[string]string",
+		"array<Catalog>":              "[]Reference",
+		"AwsTag":                      "Struct",
+		"array<UnknownToTheSnapshot>": "[]interface{}",
+	} {
+		assert.Equal(t, expected, m.goType(typeName), typeName)
+	}
+}
+
+// TestGeneratedTypesAreUpToDate checks that the generated types match the snapshot of the type
+// definitions, so that changes to either are always made by re-running the generator.
+func TestGeneratedTypesAreUpToDate(t *testing.T) {
+	data, err := os.ReadFile("typedefs.json")
+	require.NoError(t, err)
+	m, err := parseTypeDefs(data)
+	require.NoError(t, err)
+	expected, err := generateTypes(m)
+	require.NoError(t, err)
+	actual, err := os.ReadFile("../atlan/model/entities/entities_gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual), "run go generate ./atlan/model/entities")
+}
@@ -7,6 +7,12 @@ require (
 	github.com/matoous/go-nanoid v1.5.0
 	github.com/schollz/progressbar/v3 v3.14.4
 	github.com/stretchr/testify v1.9.0
+	// NOTE: OpenTelemetry is pinned to the last releases that support Go 1.19
+	go.opentelemetry.io/otel v1.16.0
+	go.opentelemetry.io/otel/metric v1.16.0
+	go.opentelemetry.io/otel/sdk v1.16.0
+	go.opentelemetry.io/otel/sdk/metric v0.39.0
+	go.opentelemetry.io/otel/trace v1.16.0
 	// NOTE: We need to pin this experimental version of "slog" since it is compatible with Go 1.19
 	// This is required because atlan-heracles uses go-sdk, which currently supports Go 1.19
 	golang.org/x/exp v0.0.0-20240707233637-46b078467d37
@@ -14,6 +20,8 @@ require (
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/kr/pretty v0.3.1 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mitchellh/colorstring v0.0.0-20190213212951-d06e56a500db // indirect